@@ -0,0 +1,65 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package algo
+
+import (
+	"sort"
+
+	"github.com/dgraph-io/dgraph/v25/codec"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+)
+
+// mergeBitmaps merges the packed lists as bitmaps.
+func mergeBitmaps(lists []*pb.UidPack) *pb.UidPack {
+	out := &codec.Bitmap{}
+	blockSize := 0
+	for _, l := range lists {
+		if l == nil {
+			continue
+		}
+		if blockSize == 0 {
+			blockSize = int(l.BlockSize)
+		}
+		out = out.Or(codec.BitmapFromPack(l))
+	}
+	return out.ToPack(blockSize)
+}
+
+// intersectWithBitmaps intersects v with a packed list that has bitmap blocks, and writes the
+// output to o. The uids of v are looked up in the bitmap blocks directly, and only the blocks of
+// deltas that v reaches are decoded.
+func intersectWithBitmaps(pack *pb.UidPack, afterUID uint64, v []uint64, o *[]uint64) {
+	dec := codec.Decoder{Pack: pack}
+	blocks := pack.Blocks
+	idx, decoded := 0, -1
+	var uids []uint64
+	for _, uid := range v {
+		if uid < afterUID {
+			continue
+		}
+		for idx+1 < len(blocks) && blocks[idx+1].Base <= uid {
+			idx++
+		}
+		block := blocks[idx]
+		if uid < block.Base {
+			continue
+		}
+		if codec.IsBitmapBlock(block) {
+			if codec.BitmapBlockContains(block, uid) {
+				*o = append(*o, uid)
+			}
+			continue
+		}
+		if decoded != idx {
+			uids = dec.UnpackBlockAt(idx)
+			decoded = idx
+		}
+		if i := sort.Search(len(uids), func(i int) bool { return uids[i] >= uid }); i < len(uids) &&
+			uids[i] == uid {
+			*o = append(*o, uid)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package algo
 
 import (
 	"container/heap"
+	"slices"
 	"sort"
 
 	"github.com/dgraph-io/dgraph/v25/codec"
@@ -36,6 +37,9 @@ func IntersectWithLinPacked(u, v *pb.UidPack) *pb.UidPack {
 	if u == nil || v == nil {
 		return nil
 	}
+	if codec.HasBitmaps(u) || codec.HasBitmaps(v) {
+		return codec.BitmapFromPack(u).And(codec.BitmapFromPack(v)).ToPack(int(u.BlockSize))
+	}
 
 	uDec := codec.NewDecoder(u)
 	uuids := uDec.Uids()
@@ -147,6 +151,9 @@ func DifferencePacked(u, v *pb.UidPack) *pb.UidPack {
 
 		return nil
 	}
+	if codec.HasBitmaps(u) || codec.HasBitmaps(v) {
+		return codec.BitmapFromPack(u).AndNot(codec.BitmapFromPack(v)).ToPack(int(u.BlockSize))
+	}
 
 	result := codec.Encoder{BlockSize: int(u.BlockSize)}
 
@@ -223,6 +230,9 @@ func MergeSortedPacked(lists []*pb.UidPack) *pb.UidPack {
 	if len(lists) == 0 {
 		return nil
 	}
+	if slices.ContainsFunc(lists, codec.HasBitmaps) {
+		return mergeBitmaps(lists)
+	}
 
 	h := &uint64Heap{}
 	heap.Init(h)
@@ -7,6 +7,7 @@ package algo
 
 import (
 	"math"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -344,3 +345,47 @@ func TestApplyFilterUintPacked(t *testing.T) {
 	res := ApplyFilterPacked(u, func(a uint64, idx int) bool { return (l[idx] % 2) == 1 })
 	require.Equal(t, []uint64{1, 3, 5, 7, 9}, codec.Decode(res, 0))
 }
+
+func TestBitmapPacked(t *testing.T) {
+	var dense, sparse []uint64
+	for uid := uint64(1 << 16); uid < 2<<16; uid += 2 {
+		dense = append(dense, uid)
+	}
+	for uid := uint64(1); uid < 3<<16; uid += 999 {
+		sparse = append(sparse, uid)
+	}
+
+	encode := func(uids []uint64) *pb.UidPack {
+		enc := codec.Encoder{BlockSize: 10, Bitmaps: true}
+		for _, uid := range uids {
+			enc.Add(uid)
+		}
+		return enc.Done()
+	}
+	u, v := encode(dense), encode(sparse)
+	require.True(t, codec.HasBitmaps(u))
+	require.False(t, codec.HasBitmaps(v))
+
+	var and, andNot, or []uint64
+	for _, uid := range sparse {
+		if uid >= 1<<16 && uid < 2<<16 && uid%2 == 0 {
+			and = append(and, uid)
+		}
+	}
+	for _, uid := range dense {
+		if (uid-1)%999 != 0 {
+			andNot = append(andNot, uid)
+		}
+	}
+	or = append(append(or, dense...), sparse...)
+	slices.Sort(or)
+	or = slices.Compact(or)
+
+	require.Equal(t, and, codec.Decode(IntersectWithLinPacked(u, v), 0))
+	require.Equal(t, andNot, codec.Decode(DifferencePacked(u, v), 0))
+	require.Equal(t, or, codec.Decode(MergeSortedPacked([]*pb.UidPack{u, v}), 0))
+
+	out := &pb.List{}
+	IntersectCompressedWith(u, 0, &pb.List{Uids: sparse}, out)
+	require.Equal(t, and, out.Uids)
+}
@@ -34,6 +34,12 @@ func IntersectCompressedWith(pack *pb.UidPack, afterUID uint64, v, o *pb.List) {
 	if pack == nil {
 		return
 	}
+	if codec.HasBitmaps(pack) {
+		dst := o.Uids[:0]
+		intersectWithBitmaps(pack, afterUID, v.Uids, &dst)
+		o.Uids = dst
+		return
+	}
 	dec := codec.Decoder{Pack: pack}
 	dec.Seek(afterUID, codec.SeekStart)
 	n := dec.ApproxLen()
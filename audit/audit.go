@@ -6,12 +6,19 @@
 package audit
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"math"
 	"os"
+	"sort"
+	"strings"
 	"sync/atomic"
 
 	"github.com/golang/glog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/dgraph-io/dgraph/v25/worker"
 	"github.com/dgraph-io/dgraph/v25/x"
@@ -36,6 +43,8 @@ type AuditEvent struct {
 	Req         string
 	Status      string
 	QueryParams map[string][]string
+	// Nodes is the number of nodes read of each predicate, set for the reads only.
+	Nodes map[string]uint64
 }
 
 const (
@@ -46,12 +55,36 @@ const (
 	Grpc             = "Grpc"
 	Http             = "Http"
 	WebSocket        = "Websocket"
+	Read             = "Read"
 )
 
 var auditor = &auditLogger{}
 
 type auditLogger struct {
 	log *x.Logger
+	// key is the key the audit log is encrypted with.
+	key x.Sensitive
+	// reads tells whether the predicates and the nodes read by the queries are audited.
+	reads bool
+}
+
+func init() {
+	worker.OnKeyRotation(rotateAuditKey)
+}
+
+// rotateAuditKey switches the audit log to the new encryption key, if it was encrypted with
+// the encryption key of the Alpha. An audit log with its own key keeps it.
+func rotateAuditKey(old, new x.Sensitive) {
+	if atomic.LoadUint32(&auditEnabled) == 0 || auditor.log == nil ||
+		len(auditor.key) == 0 || !bytes.Equal(auditor.key, old) {
+		return
+	}
+	if err := auditor.log.RotateKey(new); err != nil {
+		glog.Errorf("Unable to rotate the encryption key of the audit log: %v", err)
+		return
+	}
+	auditor.key = new
+	glog.Infoln("Rotated the encryption key of the audit log.")
 }
 
 func GetAuditConf(conf string) *x.LoggerConf {
@@ -63,7 +96,9 @@ func GetAuditConf(conf string) *x.LoggerConf {
 	if out != "stdout" {
 		out = auditFlag.GetPath("output")
 	}
-	x.AssertTruef(out != "", "out flag is not provided for the audit logs")
+	syslogAddr, webhook := auditFlag.GetString("syslog"), auditFlag.GetString("webhook")
+	x.AssertTruef(out != "" || syslogAddr != "" || webhook != "",
+		"out flag is not provided for the audit logs")
 	encBytes, err := readAuditEncKey(auditFlag)
 	x.Check(err)
 	return &x.LoggerConf{
@@ -73,6 +108,9 @@ func GetAuditConf(conf string) *x.LoggerConf {
 		Days:          auditFlag.GetInt64("days"),
 		Size:          auditFlag.GetInt64("size"),
 		MessageKey:    "endpoint",
+		Syslog:        syslogAddr,
+		Webhook:       webhook,
+		Reads:         auditFlag.GetBool("reads"),
 	}
 }
 
@@ -102,11 +140,16 @@ func InitAuditor(conf *x.LoggerConf, gId, nId uint64) error {
 	if gId == 0 {
 		ntype = NodeTypeZero
 	}
-	var err error
+	sinks, err := newSinks(conf.Syslog, conf.Webhook)
+	if err != nil {
+		return err
+	}
 	filename := fmt.Sprintf(defaultAuditFilenameF, ntype, gId, nId)
-	if auditor.log, err = x.InitLogger(conf, filename); err != nil {
+	if auditor.log, err = x.InitLogger(conf, filename, sinks...); err != nil {
 		return err
 	}
+	auditor.key = conf.EncryptionKey
+	auditor.reads = conf.Reads
 	atomic.StoreUint32(&auditEnabled, 1)
 	glog.Infoln("audit logs are enabled")
 	return nil
@@ -124,8 +167,57 @@ func Close() {
 	glog.Infoln("audit logs are closed.")
 }
 
+// AuditRead audits the predicates read by a query and the number of nodes read of each, if the
+// audit of reads is enabled. The nodes are the metrics of the query, whose keys starting with
+// an underscore, like _total, aren't predicates.
+func AuditRead(ctx context.Context, query string, graphql bool, nodes map[string]uint64,
+	err error) {
+	if atomic.LoadUint32(&auditEnabled) == 0 || !auditor.reads {
+		return
+	}
+
+	user := getUser("", false)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if t := md.Get("accessJwt"); len(t) > 0 {
+			user = getUser(t[0], false)
+		} else if t := md.Get("auth-token"); len(t) > 0 {
+			user = getUser(t[0], true)
+		}
+	}
+	namespace, nsErr := x.ExtractNamespace(ctx)
+	if nsErr != nil {
+		namespace = UnknownNamespace
+	}
+	clientHost := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		clientHost = p.Addr.String()
+	}
+	endpoint := "dql"
+	if graphql {
+		endpoint = "graphql"
+	}
+
+	read := make(map[string]uint64, len(nodes))
+	for pred, n := range nodes {
+		if !strings.HasPrefix(pred, "_") {
+			read[pred] = n
+		}
+	}
+	auditor.Audit(&AuditEvent{
+		User:       user,
+		Namespace:  namespace,
+		ServerHost: x.WorkerConfig.MyAddr,
+		ClientHost: clientHost,
+		Endpoint:   endpoint,
+		ReqType:    Read,
+		Req:        truncate(query, maxReqLength),
+		Status:     status.Code(err).String(),
+		Nodes:      read,
+	})
+}
+
 func (a *auditLogger) Audit(event *AuditEvent) {
-	a.log.AuditI(event.Endpoint,
+	args := []interface{}{
 		"level", "AUDIT",
 		"user", event.User,
 		"namespace", event.Namespace,
@@ -134,5 +226,17 @@ func (a *auditLogger) Audit(event *AuditEvent) {
 		"req_type", event.ReqType,
 		"req_body", event.Req,
 		"query_param", event.QueryParams,
-		"status", event.Status)
+		"status", event.Status,
+	}
+	if event.Nodes != nil {
+		preds := make([]string, 0, len(event.Nodes))
+		var total uint64
+		for pred, n := range event.Nodes {
+			preds = append(preds, pred)
+			total += n
+		}
+		sort.Strings(preds)
+		args = append(args, "predicates", preds, "nodes", event.Nodes, "total_nodes", total)
+	}
+	a.log.AuditI(event.Endpoint, args...)
 }
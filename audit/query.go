@@ -0,0 +1,305 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// auditTimeFormat is the format of the ts field of the audit entries.
+const auditTimeFormat = "2006-01-02T15:04:05.000Z0700"
+
+var queryCmd x.SubCommand
+
+func initQueryCmd() *x.SubCommand {
+	queryCmd.Cmd = &cobra.Command{
+		Use:   "query",
+		Short: "Filter the entries of audit files, and print them as JSON lines",
+		Long: `Reads the audit files, decrypting and decompressing the rotated ones if needed,
+and prints the entries matching all the filters as JSON lines, oldest file first.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runQuery(); err != nil {
+				fmt.Printf("%v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	flags := queryCmd.Cmd.Flags()
+	flags.String("in", "", "Comma separated list of audit files, or of directories of "+
+		"audit files.")
+	flags.String("out", "", "Output file for the entries. They're printed if not set.")
+	flags.String("encryption_key_file", "", "Comma separated list of paths to the keys of the "+
+		"encrypted audit files. Each file is decrypted with the key it was written with.")
+	flags.String("user", "", "Only the entries of this user.")
+	flags.String("namespace", "", "Only the entries of this namespace.")
+	flags.String("endpoint", "", "Only the entries whose endpoint contains this value, "+
+		"like /query, /dgraph.Dgraph/Query or dql for the reads.")
+	flags.String("since", "", "Only the entries from this time, in RFC3339 format or as a "+
+		"duration before now, like 24h.")
+	flags.String("until", "", "Only the entries until this time, in RFC3339 format or as a "+
+		"duration before now.")
+	flags.String("status", "", "Only the entries with this status, like OK or "+
+		"PermissionDenied.")
+	return &queryCmd
+}
+
+// auditFilter matches the audit entries with all the set fields.
+type auditFilter struct {
+	user      string
+	namespace *uint64
+	endpoint  string
+	since     time.Time
+	until     time.Time
+	status    string
+}
+
+func parseAuditTime(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected RFC3339 or a duration", s)
+	}
+	return t, nil
+}
+
+func newAuditFilter() (*auditFilter, error) {
+	conf := queryCmd.Conf
+	f := &auditFilter{
+		user:     conf.GetString("user"),
+		endpoint: conf.GetString("endpoint"),
+		status:   conf.GetString("status"),
+	}
+	if ns := conf.GetString("namespace"); ns != "" {
+		n, err := strconv.ParseUint(ns, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace %q: %w", ns, err)
+		}
+		f.namespace = &n
+	}
+	var err error
+	now := time.Now()
+	if f.since, err = parseAuditTime(conf.GetString("since"), now); err != nil {
+		return nil, err
+	}
+	if f.until, err = parseAuditTime(conf.GetString("until"), now); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+type auditEntry struct {
+	Ts        string  `json:"ts"`
+	Endpoint  string  `json:"endpoint"`
+	User      string  `json:"user"`
+	Namespace *uint64 `json:"namespace"`
+	Status    string  `json:"status"`
+}
+
+func (f *auditFilter) match(line []byte) (bool, error) {
+	var e auditEntry
+	if err := json.Unmarshal(line, &e); err != nil {
+		return false, err
+	}
+	if f.user != "" && e.User != f.user {
+		return false, nil
+	}
+	if f.namespace != nil && (e.Namespace == nil || *e.Namespace != *f.namespace) {
+		return false, nil
+	}
+	if f.endpoint != "" && !strings.Contains(e.Endpoint, f.endpoint) {
+		return false, nil
+	}
+	if f.status != "" && !strings.EqualFold(e.Status, f.status) {
+		return false, nil
+	}
+	if !f.since.IsZero() || !f.until.IsZero() {
+		ts, err := time.Parse(auditTimeFormat, e.Ts)
+		if err != nil {
+			return false, fmt.Errorf("invalid time of entry %q: %w", e.Ts, err)
+		}
+		if (!f.since.IsZero() && ts.Before(f.since)) || (!f.until.IsZero() && ts.After(f.until)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// auditFiles returns the audit files of the paths, oldest first. The files of the directories
+// are those written by the audit log, including the rotated ones.
+func auditFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		entries, err := os.ReadDir(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if !e.IsDir() && strings.Contains(e.Name(), "_audit_") {
+				files = append(files, filepath.Join(p, e.Name()))
+			}
+		}
+	}
+
+	modTimes := make(map[string]time.Time, len(files))
+	for _, f := range files {
+		info, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[f] = info.ModTime()
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		return modTimes[files[i]].Before(modTimes[files[j]])
+	})
+	return files, nil
+}
+
+// readAuditFile returns the entries of the audit file, decompressed and decrypted. An encrypted
+// file is decrypted with whichever of the keys it was written with.
+func readAuditFile(path string, keys [][]byte) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	name := path
+	if strings.HasSuffix(name, ".gz") {
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = io.ReadAll(gz); err != nil {
+			return nil, err
+		}
+		name = strings.TrimSuffix(name, ".gz")
+	}
+	// The rotated files keep the .enc extension, after the time of the rotation.
+	if !strings.HasSuffix(name, ".enc") || len(data) == 0 {
+		return data, nil
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("the file is encrypted, set --encryption_key_file")
+	}
+	for _, key := range keys {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		var out bytes.Buffer
+		if err := decrypt(bytes.NewReader(data), &out, block, int64(len(data))); err == nil {
+			return out.Bytes(), nil
+		}
+	}
+	return nil, errors.New("none of the encryption keys can decrypt the file")
+}
+
+// queryAuditFiles writes the entries of the files matching the filter to the writer.
+func queryAuditFiles(files []string, keys [][]byte, f *auditFilter, w io.Writer) error {
+	for _, file := range files {
+		data, err := readAuditFile(file, keys)
+		if err != nil {
+			return fmt.Errorf("while reading audit file %s: %w", file, err)
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(make([]byte, 0, 64<<10), 16<<20)
+		for scanner.Scan() {
+			line := scanner.Bytes()
+			if len(bytes.TrimSpace(line)) == 0 {
+				continue
+			}
+			ok, err := f.match(line)
+			if err != nil {
+				// The tail of a file may be cut, if it wasn't closed.
+				fmt.Fprintf(os.Stderr, "Skipping invalid entry of %s: %v\n", file, err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+			if _, err := w.Write(line); err != nil {
+				return err
+			}
+			if _, err := w.Write([]byte{'\n'}); err != nil {
+				return err
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return fmt.Errorf("while reading audit file %s: %w", file, err)
+		}
+	}
+	return nil
+}
+
+func runQuery() error {
+	conf := queryCmd.Conf
+	if conf.GetString("in") == "" {
+		return errors.New("--in is required")
+	}
+	f, err := newAuditFilter()
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for _, p := range strings.Split(conf.GetString("encryption_key_file"), ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		key, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+	files, err := auditFiles(strings.Split(conf.GetString("in"), ","))
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if out := conf.GetString("out"); out != "" {
+		file, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	bw := bufio.NewWriter(w)
+	if err := queryAuditFiles(files, keys, f, bw); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,95 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+func TestQueryAuditFiles(t *testing.T) {
+	dir := t.TempDir()
+	k1, k2 := []byte("0123456789abcdef"), []byte("fedcba9876543210")
+	conf := &x.LoggerConf{Output: dir, EncryptionKey: k1, Days: 10, Size: 100,
+		MessageKey: "endpoint", Reads: true}
+	require.NoError(t, InitAuditor(conf, 1, 1))
+
+	auditor.Audit(&AuditEvent{User: "alice", Namespace: 0, Endpoint: "/dgraph.Dgraph/Query",
+		ReqType: Grpc, Status: "OK"})
+	// The files written before the rotation of the key keep the old key.
+	rotateAuditKey(k1, k2)
+	auditor.Audit(&AuditEvent{User: "bob", Namespace: 2, Endpoint: "/alter",
+		ReqType: Http, Status: "Unauthorized"})
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("namespace", "2"))
+	AuditRead(ctx, "{ q(func: uid(1)) { name } }", false,
+		map[string]uint64{"name": 3, "age": 2, "_total": 5}, nil)
+	AuditRead(ctx, "{ q(func: uid(1)) { name } }", false, nil, errors.New("failed"))
+	Close()
+
+	files, err := auditFiles([]string{dir})
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	_, err = readAuditFile(files[0], [][]byte{k2})
+	require.ErrorContains(t, err, "none of the encryption keys")
+
+	query := func(f *auditFilter) []map[string]interface{} {
+		var out bytes.Buffer
+		require.NoError(t, queryAuditFiles(files, [][]byte{k2, k1}, f, &out))
+		var entries []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var e map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(line), &e))
+			entries = append(entries, e)
+		}
+		return entries
+	}
+
+	require.Len(t, query(&auditFilter{}), 4)
+	entries := query(&auditFilter{user: "alice"})
+	require.Len(t, entries, 1)
+	require.Equal(t, "/dgraph.Dgraph/Query", entries[0]["endpoint"])
+
+	ns := uint64(2)
+	require.Len(t, query(&auditFilter{namespace: &ns}), 3)
+	require.Len(t, query(&auditFilter{namespace: &ns, status: "unauthorized"}), 1)
+	require.Len(t, query(&auditFilter{since: time.Now().Add(time.Hour)}), 0)
+	require.Len(t, query(&auditFilter{until: time.Now().Add(time.Hour)}), 4)
+
+	// The reads have the predicates and the number of nodes read of each.
+	entries = query(&auditFilter{endpoint: "dql", status: "OK"})
+	require.Len(t, entries, 1)
+	require.Equal(t, Read, entries[0]["req_type"])
+	require.Equal(t, []interface{}{"age", "name"}, entries[0]["predicates"])
+	require.Equal(t, map[string]interface{}{"age": 2.0, "name": 3.0}, entries[0]["nodes"])
+	require.Equal(t, 5.0, entries[0]["total_nodes"])
+	require.Len(t, query(&auditFilter{endpoint: "dql", status: "Unknown"}), 1)
+}
+
+func TestParseAuditTime(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	ts, err := parseAuditTime("2h", now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-2*time.Hour), ts)
+	ts, err = parseAuditTime("2026-01-01T00:00:00Z", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), ts)
+	_, err = parseAuditTime("yesterday", now)
+	require.Error(t, err)
+}
@@ -65,7 +65,7 @@ func initSubcommands() []*x.SubCommand {
 	decFlags.String("out", "audit_log_out.log",
 		"output file to which decrypted output will be dumped.")
 	decFlags.String("encryption_key_file", "", "path to encrypt files.")
-	return []*x.SubCommand{&decryptCmd}
+	return []*x.SubCommand{&decryptCmd, initQueryCmd()}
 }
 
 func run() error {
@@ -0,0 +1,145 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+const (
+	webhookQueueSize     = 10000
+	webhookBatchSize     = 500
+	webhookFlushInterval = time.Second
+	webhookTimeout       = 10 * time.Second
+)
+
+// newSinks returns the sinks the audit entries are sent to, besides the output of the conf.
+func newSinks(syslogAddr, webhookURL string) ([]io.Writer, error) {
+	var sinks []io.Writer
+	if syslogAddr != "" {
+		s, err := newSyslogSink(syslogAddr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to connect to syslog at %s: %w", syslogAddr, err)
+		}
+		sinks = append(sinks, s)
+	}
+	if webhookURL != "" {
+		sinks = append(sinks, newWebhookSink(webhookURL))
+	}
+	return sinks, nil
+}
+
+// webhookSink posts the audit entries to a URL in batches of JSON lines. The requests aren't
+// slowed down by the webhook: the entries are dropped if it can't keep up.
+type webhookSink struct {
+	url     string
+	client  *http.Client
+	entries chan []byte
+	dropped atomic.Uint64
+	closer  *z.Closer
+}
+
+func newWebhookSink(url string) *webhookSink {
+	s := &webhookSink{
+		url:     url,
+		client:  &http.Client{Timeout: webhookTimeout},
+		entries: make(chan []byte, webhookQueueSize),
+		closer:  z.NewCloser(1),
+	}
+	go s.run()
+	return s
+}
+
+func (s *webhookSink) Write(p []byte) (int, error) {
+	entry := make([]byte, len(p))
+	copy(entry, p)
+	select {
+	case s.entries <- entry:
+	default:
+		s.dropped.Add(1)
+	}
+	return len(p), nil
+}
+
+func (s *webhookSink) Close() error {
+	s.closer.SignalAndWait()
+	return nil
+}
+
+func (s *webhookSink) run() {
+	defer s.closer.Done()
+	ticker := time.NewTicker(webhookFlushInterval)
+	defer ticker.Stop()
+
+	var batch bytes.Buffer
+	var n int
+	flush := func() {
+		if dropped := s.dropped.Swap(0); dropped > 0 {
+			glog.Warningf("Dropped %d audit entries as the webhook %s couldn't keep up",
+				dropped, s.url)
+		}
+		if n == 0 {
+			return
+		}
+		if err := s.post(batch.Bytes()); err != nil {
+			glog.Errorf("Unable to send %d audit entries to the webhook %s: %v", n, s.url, err)
+		}
+		batch.Reset()
+		n = 0
+	}
+	for {
+		select {
+		case entry := <-s.entries:
+			batch.Write(entry)
+			if n++; n >= webhookBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.closer.HasBeenClosed():
+			for {
+				select {
+				case entry := <-s.entries:
+					batch.Write(entry)
+					n++
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *webhookSink) post(body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("got status %s", resp.Status)
+	}
+	return nil
+}
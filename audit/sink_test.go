@@ -0,0 +1,43 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSink(t *testing.T) {
+	var mu sync.Mutex
+	var lines []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		mu.Lock()
+		lines = append(lines, strings.Split(strings.TrimSpace(string(body)), "\n")...)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	s := newWebhookSink(srv.URL)
+	for _, entry := range []string{`{"user":"alice"}`, `{"user":"bob"}`} {
+		n, err := s.Write([]byte(entry + "\n"))
+		require.NoError(t, err)
+		require.Equal(t, len(entry)+1, n)
+	}
+	// The pending entries are sent when the sink is closed.
+	require.NoError(t, s.Close())
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{`{"user":"alice"}`, `{"user":"bob"}`}, lines)
+}
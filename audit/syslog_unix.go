@@ -0,0 +1,31 @@
+//go:build !windows
+// +build !windows
+
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"io"
+	"log/syslog"
+	"strings"
+)
+
+const syslogTag = "dgraph-audit"
+
+// newSyslogSink connects to the local syslog daemon if the address is "local", or else to the
+// remote one at an address like "udp://host:514" or "tcp://host:514".
+func newSyslogSink(addr string) (io.WriteCloser, error) {
+	priority := syslog.LOG_INFO | syslog.LOG_AUTH
+	if addr == "local" {
+		return syslog.New(priority, syslogTag)
+	}
+	network, raddr := "udp", addr
+	if i := strings.Index(addr, "://"); i >= 0 {
+		network, raddr = addr[:i], addr[i+3:]
+	}
+	return syslog.Dial(network, raddr, priority, syslogTag)
+}
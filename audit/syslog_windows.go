@@ -0,0 +1,18 @@
+//go:build windows
+// +build windows
+
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"errors"
+	"io"
+)
+
+func newSyslogSink(addr string) (io.WriteCloser, error) {
+	return nil, errors.New("syslog isn't supported on Windows")
+}
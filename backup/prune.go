@@ -0,0 +1,102 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/worker"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+var pruneCmd x.SubCommand
+
+func initPrune() {
+	pruneCmd.Cmd = &cobra.Command{
+		Use:   "prune",
+		Short: "Delete the backups that a retention policy doesn't keep",
+		Long: `
+Prune deletes the backups in the given location that the retention policy doesn't keep.
+The policy keeps the latest backup series whole, the latest backup of each of the last days
+and the latest backup of each of the last months. The latest series is always kept, and so
+are the previous backups of the series of a kept incremental backup, as it's restored on top
+of them. The master manifest is updated before any file is deleted. The segments of the WAL
+archive older than the kept backups are deleted too.
+
+Backups can also be pruned after each backup by giving the retention policy to the backup
+request. The backups and the prunings of a location hold a lease on it, stored in the file
+backup.lease, so the pruning fails while a backup is written to the location. A lease that
+isn't renewed by its holder expires after 5 minutes.
+
+Usage examples:
+# Keep the last 3 series, plus the last backup of each of the last 7 days and 12 months:
+$ dgraph backup prune -l /var/backups/dgraph --keep-last 3 --keep-daily 7 --keep-monthly 12
+
+# Show what would be deleted:
+$ dgraph backup prune -l s3://s3.us-west-2.amazonaws.com/srfrog/dgraph --keep-last 2 --dry-run
+		`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			defer x.StartProfile(pruneCmd.Conf).Stop()
+			if err := runPruneCmd(); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		},
+	}
+	pruneCmd.Cmd.SetHelpTemplate(x.NonRootTemplate)
+	flag := pruneCmd.Cmd.Flags()
+	flag.StringVarP(&opt.location, "location", "l", "",
+		"Sets the source location URI (required).")
+	flag.Uint32Var(&opt.keepLast, "keep-last", 0,
+		"Number of latest backup series kept whole.")
+	flag.Uint32Var(&opt.keepDaily, "keep-daily", 0,
+		"Number of days, including today, for which the latest backup of each day is kept.")
+	flag.Uint32Var(&opt.keepMonthly, "keep-monthly", 0,
+		"Number of months, including the current one, for which the latest backup of each "+
+			"month is kept.")
+	flag.BoolVar(&opt.dryRun, "dry-run", false,
+		"Only print the backups that would be deleted.")
+	_ = pruneCmd.Cmd.MarkFlagRequired("location")
+}
+
+func runPruneCmd() error {
+	policy := &pb.BackupRetention{
+		KeepLast:    opt.keepLast,
+		KeepDaily:   opt.keepDaily,
+		KeepMonthly: opt.keepMonthly,
+	}
+	res, err := worker.ProcessPruneBackups(opt.location, nil, policy, opt.dryRun)
+	if err != nil {
+		return fmt.Errorf("while pruning the backups: %w", err)
+	}
+
+	type pruneOutput struct {
+		DryRun     bool     `json:"dry_run"`
+		Kept       []string `json:"kept"`
+		Deleted    []string `json:"deleted"`
+		DeletedWAL []string `json:"deleted_wal,omitempty"`
+	}
+	output := pruneOutput{DryRun: opt.dryRun, DeletedWAL: res.DeletedWAL}
+	for _, m := range res.Kept {
+		output.Kept = append(output.Kept, m.Path)
+	}
+	for _, m := range res.Deleted {
+		output.Deleted = append(output.Deleted, m.Path)
+	}
+	b, err := json.MarshalIndent(output, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, _ = os.Stdout.Write(b)
+	fmt.Println()
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
@@ -39,6 +40,9 @@ var LsBackup x.SubCommand
 
 var ExportBackup x.SubCommand
 
+// Backup is the sub-command grouping the tools that check and maintain the backups in a location.
+var Backup x.SubCommand
+
 var opt struct {
 	backupId    string
 	badger      string
@@ -55,12 +59,41 @@ var opt struct {
 	untilDate   string // date-range filter upper bound
 	lastNDays   int    // shorthand: last N calendar days
 	summary     bool   // print summary stats after the listing
+	keepLast    uint32 // retention policy of the prune command
+	keepDaily   uint32
+	keepMonthly uint32
+	dryRun      bool
 }
 
 func init() {
 	initRestore()
 	initBackupLs()
 	initExportBackup()
+	initBackup()
+}
+
+func initBackup() {
+	Backup.Cmd = &cobra.Command{
+		Use:         "backup",
+		Short:       "Check and maintain the backups in a given location",
+		Annotations: map[string]string{"group": "tool"},
+	}
+	Backup.Cmd.SetHelpTemplate(x.NonRootTemplate)
+
+	initVerify()
+	initPrune()
+	for _, sc := range []*x.SubCommand{&verifyCmd, &pruneCmd} {
+		Backup.Cmd.AddCommand(sc.Cmd)
+		sc.Conf = viper.New()
+		if err := sc.Conf.BindPFlags(sc.Cmd.Flags()); err != nil {
+			glog.Fatalf("Unable to bind flags for command %v: %v", sc, err)
+		}
+		if err := sc.Conf.BindPFlags(Backup.Cmd.PersistentFlags()); err != nil {
+			glog.Fatalf(
+				"Unable to bind persistent flags from backup for command %v: %v", sc, err)
+		}
+		sc.Conf.SetEnvPrefix(sc.EnvPrefix)
+	}
 }
 
 func initRestore() {
@@ -80,8 +113,8 @@ Source URI formats:
   /[path]?[args] (only for local or NFS)
 
 Source URI parts:
-  scheme - service handler, one of: "s3", "minio", "file"
-    host - remote address. ex: "dgraph.s3.amazonaws.com"
+  scheme - service handler, one of: "s3", "minio", "azure", "gs", "file"
+    host - remote address or bucket. ex: "dgraph.s3.amazonaws.com"
     path - directory, bucket or container at target. ex: "/dgraph/backups/"
     args - specific arguments that are ok to appear in logs.
 
@@ -101,6 +134,10 @@ $ dgraph restore -p . -l /var/backups/dgraph
 # Restore from S3:
 $ dgraph restore -p /var/db/dgraph -l s3://s3.us-west-2.amazonaws.com/srfrog/dgraph
 
+# Restore from Azure Blob Storage or Google Cloud Storage:
+$ dgraph restore -p /var/db/dgraph -l azure://account.blob.core.windows.net/container/dgraph
+$ dgraph restore -p /var/db/dgraph -l gs://bucket/dgraph
+
 # Restore from dir and update Ts:
 $ dgraph restore -p . -l /var/backups/dgraph -z localhost:5080
 		`,
@@ -0,0 +1,453 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package codec
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+// UIDs are split into containers of 2^16 UIDs by their 48 MSBs, like in roaring bitmaps. A dense
+// container is stored in a UidPack as a single bitmap block, and the other ones as blocks of
+// deltas.
+const (
+	containerBits = 16
+	bitmapWords   = (1 << containerBits) / 64
+	bitmapBytes   = bitmapWords * 8
+)
+
+// MinBitmapUids is the number of UIDs from which a container is stored as a bitmap. A bitmap
+// takes 8 KiB, which is what 4096 UIDs take as an array of 16-bit offsets.
+var MinBitmapUids = 4096
+
+func containerKey(uid uint64) uint64 {
+	return uid >> containerBits
+}
+
+func setBit(bitmap []byte, offset uint16) {
+	bitmap[offset/8] |= 1 << (offset % 8)
+}
+
+func hasBit(bitmap []byte, offset uint16) bool {
+	return bitmap[offset/8]&(1<<(offset%8)) != 0
+}
+
+// appendBitmapUids appends the UIDs of the bitmap block to uids.
+func appendBitmapUids(uids []uint64, block *pb.UidBlock) []uint64 {
+	base := containerKey(block.Base) << containerBits
+	for i := range bitmapWords {
+		word := binary.LittleEndian.Uint64(block.Deltas[i*8:])
+		for word != 0 {
+			uids = append(uids, base+uint64(i*64+bits.TrailingZeros64(word)))
+			word &= word - 1
+		}
+	}
+	return uids
+}
+
+// IsBitmapBlock returns whether the block stores its UIDs as a bitmap.
+func IsBitmapBlock(block *pb.UidBlock) bool {
+	return block.GetEncoding() == pb.UidBlock_BITMAP
+}
+
+// CheckPack returns an error if the pack has blocks that this version can't read: blocks with an
+// encoding it doesn't know, written by a newer version, or bitmap blocks of the wrong size.
+func CheckPack(pack *pb.UidPack) error {
+	for _, block := range pack.GetBlocks() {
+		switch block.Encoding {
+		case pb.UidBlock_DELTAS:
+		case pb.UidBlock_BITMAP:
+			if len(block.Deltas) != bitmapBytes {
+				return errors.Errorf("Bitmap block with base %#x has %d bytes, expected %d",
+					block.Base, len(block.Deltas), bitmapBytes)
+			}
+		default:
+			return errors.Errorf("Block with base %#x has unknown encoding %d, it may have been "+
+				"written by a newer version", block.Base, block.Encoding)
+		}
+	}
+	return nil
+}
+
+// BitmapBlockContains returns whether the bitmap block contains the uid.
+func BitmapBlockContains(block *pb.UidBlock, uid uint64) bool {
+	return containerKey(uid) == containerKey(block.Base) && hasBit(block.Deltas, uint16(uid))
+}
+
+// HasBitmaps returns whether the pack has bitmap blocks.
+func HasBitmaps(pack *pb.UidPack) bool {
+	for _, block := range pack.GetBlocks() {
+		if IsBitmapBlock(block) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandBitmaps returns the pack with its bitmap blocks stored as blocks of deltas, using the
+// allocator of the pack.
+func ExpandBitmaps(pack *pb.UidPack) *pb.UidPack {
+	if !HasBitmaps(pack) {
+		return pack
+	}
+	enc := Encoder{BlockSize: int(pack.BlockSize)}
+	if pack.AllocRef != 0 {
+		enc.Alloc = z.AllocatorFrom(pack.AllocRef)
+	}
+	for _, uid := range Decode(pack, 0) {
+		enc.Add(uid)
+	}
+	return enc.Done()
+}
+
+// Bitmap is a roaring bitmap of UIDs. Each container holds the 16 LSBs of its UIDs as a sorted
+// array while it has fewer than MinBitmapUids of them, and as a bitmap otherwise. Set operations
+// work a container at a time, on the words of the bitmaps where they can.
+type Bitmap struct {
+	keys       []uint64
+	containers []*container
+}
+
+type container struct {
+	// array holds the sorted offsets of the UIDs when words is nil.
+	array []uint16
+	words []uint64
+	card  int
+}
+
+// NewBitmap returns a bitmap of the sorted UIDs.
+func NewBitmap(uids []uint64) *Bitmap {
+	b := &Bitmap{}
+	for len(uids) > 0 {
+		key := containerKey(uids[0])
+		n := sort.Search(len(uids), func(i int) bool { return containerKey(uids[i]) != key })
+		c := &container{array: make([]uint16, n), card: n}
+		for i, uid := range uids[:n] {
+			c.array[i] = uint16(uid)
+		}
+		b.keys = append(b.keys, key)
+		b.containers = append(b.containers, c.normalize())
+		uids = uids[n:]
+	}
+	return b
+}
+
+// BitmapFromPack returns a bitmap of the UIDs of the pack. The bitmap blocks of the pack become
+// bitmap containers without being decoded.
+func BitmapFromPack(pack *pb.UidPack) *Bitmap {
+	b := &Bitmap{}
+	dec := Decoder{Pack: pack}
+	var uids []uint64
+	flush := func() {
+		if len(uids) > 0 {
+			other := NewBitmap(uids)
+			b.keys = append(b.keys, other.keys...)
+			b.containers = append(b.containers, other.containers...)
+			uids = uids[:0]
+		}
+	}
+	for i, block := range pack.GetBlocks() {
+		if !IsBitmapBlock(block) {
+			uids = append(uids, dec.UnpackBlockAt(i)...)
+			continue
+		}
+		flush()
+		c := &container{words: make([]uint64, bitmapWords), card: int(block.NumUids)}
+		for j := range c.words {
+			c.words[j] = binary.LittleEndian.Uint64(block.Deltas[j*8:])
+		}
+		b.keys = append(b.keys, containerKey(block.Base))
+		b.containers = append(b.containers, c)
+	}
+	flush()
+	return b
+}
+
+// Cardinality returns the number of UIDs in the bitmap.
+func (b *Bitmap) Cardinality() int {
+	var n int
+	for _, c := range b.containers {
+		n += c.card
+	}
+	return n
+}
+
+// Contains returns whether the bitmap contains the uid.
+func (b *Bitmap) Contains(uid uint64) bool {
+	key := containerKey(uid)
+	i := sort.Search(len(b.keys), func(i int) bool { return b.keys[i] >= key })
+	return i < len(b.keys) && b.keys[i] == key && b.containers[i].contains(uint16(uid))
+}
+
+// ToUids returns the sorted UIDs of the bitmap.
+func (b *Bitmap) ToUids() []uint64 {
+	uids := make([]uint64, 0, b.Cardinality())
+	for i, c := range b.containers {
+		base := b.keys[i] << containerBits
+		if c.words == nil {
+			for _, off := range c.array {
+				uids = append(uids, base+uint64(off))
+			}
+			continue
+		}
+		for j, word := range c.words {
+			for word != 0 {
+				uids = append(uids, base+uint64(j*64+bits.TrailingZeros64(word)))
+				word &= word - 1
+			}
+		}
+	}
+	return uids
+}
+
+// ToPack encodes the bitmap into a UidPack, with its dense containers as bitmap blocks. The
+// UidPack MUST BE FREED via a call to FreePack.
+func (b *Bitmap) ToPack(blockSize int) *pb.UidPack {
+	enc := Encoder{BlockSize: blockSize, Bitmaps: true}
+	for _, uid := range b.ToUids() {
+		enc.Add(uid)
+	}
+	return enc.Done()
+}
+
+// And returns the intersection of the bitmaps.
+func (b *Bitmap) And(o *Bitmap) *Bitmap {
+	out := &Bitmap{}
+	for i, j := 0, 0; i < len(b.keys) && j < len(o.keys); {
+		switch {
+		case b.keys[i] < o.keys[j]:
+			i++
+		case b.keys[i] > o.keys[j]:
+			j++
+		default:
+			if c := b.containers[i].and(o.containers[j]); c.card > 0 {
+				out.keys = append(out.keys, b.keys[i])
+				out.containers = append(out.containers, c)
+			}
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+// Or returns the union of the bitmaps.
+func (b *Bitmap) Or(o *Bitmap) *Bitmap {
+	out := &Bitmap{}
+	i, j := 0, 0
+	for i < len(b.keys) || j < len(o.keys) {
+		switch {
+		case j == len(o.keys) || (i < len(b.keys) && b.keys[i] < o.keys[j]):
+			out.keys = append(out.keys, b.keys[i])
+			out.containers = append(out.containers, b.containers[i].clone())
+			i++
+		case i == len(b.keys) || b.keys[i] > o.keys[j]:
+			out.keys = append(out.keys, o.keys[j])
+			out.containers = append(out.containers, o.containers[j].clone())
+			j++
+		default:
+			out.keys = append(out.keys, b.keys[i])
+			out.containers = append(out.containers, b.containers[i].or(o.containers[j]))
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+// AndNot returns the UIDs of b that aren't in o.
+func (b *Bitmap) AndNot(o *Bitmap) *Bitmap {
+	out := &Bitmap{}
+	j := 0
+	for i, key := range b.keys {
+		for j < len(o.keys) && o.keys[j] < key {
+			j++
+		}
+		c := b.containers[i]
+		if j < len(o.keys) && o.keys[j] == key {
+			c = c.andNot(o.containers[j])
+		} else {
+			c = c.clone()
+		}
+		if c.card > 0 {
+			out.keys = append(out.keys, key)
+			out.containers = append(out.containers, c)
+		}
+	}
+	return out
+}
+
+func (c *container) contains(off uint16) bool {
+	if c.words != nil {
+		return c.words[off/64]&(1<<(off%64)) != 0
+	}
+	i := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= off })
+	return i < len(c.array) && c.array[i] == off
+}
+
+func (c *container) clone() *container {
+	out := &container{card: c.card}
+	if c.words != nil {
+		out.words = append([]uint64(nil), c.words...)
+	} else {
+		out.array = append([]uint16(nil), c.array...)
+	}
+	return out
+}
+
+// normalize switches the container to the representation that suits its cardinality.
+func (c *container) normalize() *container {
+	switch {
+	case c.words == nil && c.card >= MinBitmapUids:
+		c.words = make([]uint64, bitmapWords)
+		for _, off := range c.array {
+			c.words[off/64] |= 1 << (off % 64)
+		}
+		c.array = nil
+	case c.words != nil && c.card < MinBitmapUids:
+		c.array = make([]uint16, 0, c.card)
+		for j, word := range c.words {
+			for word != 0 {
+				c.array = append(c.array, uint16(j*64+bits.TrailingZeros64(word)))
+				word &= word - 1
+			}
+		}
+		c.words = nil
+	}
+	return c
+}
+
+func (c *container) and(o *container) *container {
+	switch {
+	case c.words != nil && o.words != nil:
+		out := &container{words: make([]uint64, bitmapWords)}
+		for i := range out.words {
+			out.words[i] = c.words[i] & o.words[i]
+			out.card += bits.OnesCount64(out.words[i])
+		}
+		return out.normalize()
+	case c.words != nil:
+		return o.filter(c, true)
+	case o.words != nil:
+		return c.filter(o, true)
+	}
+	out := &container{}
+	for i, j := 0, 0; i < len(c.array) && j < len(o.array); {
+		switch {
+		case c.array[i] < o.array[j]:
+			i++
+		case c.array[i] > o.array[j]:
+			j++
+		default:
+			out.array = append(out.array, c.array[i])
+			i++
+			j++
+		}
+	}
+	out.card = len(out.array)
+	return out
+}
+
+// filter returns the offsets of the array container that o contains, or doesn't contain if keep
+// is false.
+func (c *container) filter(o *container, keep bool) *container {
+	out := &container{}
+	for _, off := range c.array {
+		if o.contains(off) == keep {
+			out.array = append(out.array, off)
+		}
+	}
+	out.card = len(out.array)
+	return out
+}
+
+func (c *container) or(o *container) *container {
+	if c.words == nil && o.words == nil {
+		out := &container{array: make([]uint16, 0, len(c.array)+len(o.array))}
+		i, j := 0, 0
+		for i < len(c.array) && j < len(o.array) {
+			switch {
+			case c.array[i] < o.array[j]:
+				out.array = append(out.array, c.array[i])
+				i++
+			case c.array[i] > o.array[j]:
+				out.array = append(out.array, o.array[j])
+				j++
+			default:
+				out.array = append(out.array, c.array[i])
+				i++
+				j++
+			}
+		}
+		out.array = append(out.array, c.array[i:]...)
+		out.array = append(out.array, o.array[j:]...)
+		out.card = len(out.array)
+		return out.normalize()
+	}
+
+	if c.words == nil {
+		c, o = o, c
+	}
+	out := c.clone()
+	if o.words != nil {
+		out.card = 0
+		for i := range out.words {
+			out.words[i] |= o.words[i]
+			out.card += bits.OnesCount64(out.words[i])
+		}
+		return out
+	}
+	for _, off := range o.array {
+		if out.words[off/64]&(1<<(off%64)) == 0 {
+			out.words[off/64] |= 1 << (off % 64)
+			out.card++
+		}
+	}
+	return out
+}
+
+func (c *container) andNot(o *container) *container {
+	switch {
+	case c.words == nil && o.words == nil:
+		out := &container{}
+		j := 0
+		for _, off := range c.array {
+			for j < len(o.array) && o.array[j] < off {
+				j++
+			}
+			if j == len(o.array) || o.array[j] != off {
+				out.array = append(out.array, off)
+			}
+		}
+		out.card = len(out.array)
+		return out
+	case c.words == nil:
+		return c.filter(o, false)
+	}
+
+	out := c.clone()
+	if o.words != nil {
+		out.card = 0
+		for i := range out.words {
+			out.words[i] &^= o.words[i]
+			out.card += bits.OnesCount64(out.words[i])
+		}
+		return out.normalize()
+	}
+	for _, off := range o.array {
+		if out.words[off/64]&(1<<(off%64)) != 0 {
+			out.words[off/64] &^= 1 << (off % 64)
+			out.card--
+		}
+	}
+	return out.normalize()
+}
@@ -0,0 +1,127 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package codec
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// denseUids returns sorted uids with dense containers, sparse containers and containers that
+// differ in their 32 MSBs.
+func denseUids(r *rand.Rand) []uint64 {
+	var uids []uint64
+	for _, base := range []uint64{1 << 16, 3 << 16, 1<<32 + 5<<16, 1<<40 + 7<<16} {
+		// Every other container is dense.
+		step := 1 + r.Intn(4)
+		if r.Intn(2) == 0 {
+			step = 50 + r.Intn(200)
+		}
+		for uid := base + uint64(r.Intn(10)); uid < base+1<<16; uid += uint64(1 + r.Intn(step)) {
+			uids = append(uids, uid)
+		}
+	}
+	return uids
+}
+
+func TestBitmapBlocks(t *testing.T) {
+	uids := []uint64{1, 5, 100}
+	for uid := uint64(1 << 16); uid < 2<<16; uid += 3 {
+		uids = append(uids, uid)
+	}
+	uids = append(uids, 2<<16+1, 2<<16+8)
+
+	enc := Encoder{BlockSize: 256, Bitmaps: true}
+	for _, uid := range uids {
+		enc.Add(uid)
+	}
+	pack := enc.Done()
+	defer FreePack(pack)
+
+	require.True(t, HasBitmaps(pack))
+	require.Equal(t, 3, len(pack.Blocks))
+	require.False(t, IsBitmapBlock(pack.Blocks[0]))
+	require.True(t, IsBitmapBlock(pack.Blocks[1]))
+	require.Equal(t, uint64(1<<16), pack.Blocks[1].Base)
+	require.True(t, BitmapBlockContains(pack.Blocks[1], 1<<16+3))
+	require.False(t, BitmapBlockContains(pack.Blocks[1], 1<<16+4))
+	require.False(t, BitmapBlockContains(pack.Blocks[1], 2<<16+1))
+
+	require.Equal(t, len(uids), ExactLen(pack))
+	require.Equal(t, uids, Decode(pack, 0))
+	require.Equal(t, uids[4:], Decode(pack, uids[4]))
+	require.Equal(t, uids, Decode(CopyUidPack(pack), 0))
+
+	dec := NewDecoder(pack)
+	require.Equal(t, []uint64{1<<16 + 3, 1<<16 + 6}, dec.Seek(1<<16+1, SeekStart)[:2])
+	require.Equal(t, []uint64{2<<16 + 1, 2<<16 + 8}, dec.Seek(2<<16, SeekStart))
+
+	// The bitmap block is counted with its UIDs, not with the block size.
+	numUids := int(pack.Blocks[1].NumUids)
+	require.Equal(t, 256+numUids+256, ApproxLen(pack))
+	dec.Seek(1<<16, SeekStart)
+	require.Equal(t, numUids+256, dec.ApproxLen())
+}
+
+func TestCheckPack(t *testing.T) {
+	enc := Encoder{BlockSize: 256, Bitmaps: true}
+	for uid := uint64(1 << 16); uid < 2<<16; uid += 2 {
+		enc.Add(uid)
+	}
+	pack := enc.Done()
+	defer FreePack(pack)
+	require.NoError(t, CheckPack(pack))
+
+	short := CopyUidPack(pack)
+	short.Blocks[0].Deltas = short.Blocks[0].Deltas[:8]
+	require.ErrorContains(t, CheckPack(short), "Bitmap block with base 0x10000 has 8 bytes")
+
+	unknown := CopyUidPack(pack)
+	unknown.Blocks[0].Encoding = 7
+	require.ErrorContains(t, CheckPack(unknown), "unknown encoding 7")
+}
+
+func TestBitmapOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for range 10 {
+		a, b := denseUids(r), denseUids(r)
+		ba, bb := NewBitmap(a), NewBitmap(b)
+		require.Equal(t, a, ba.ToUids())
+		require.Equal(t, len(a), ba.Cardinality())
+		require.True(t, ba.Contains(a[len(a)/2]))
+
+		var and, or, andNot []uint64
+		for _, uid := range a {
+			if slices.Contains(b, uid) {
+				and = append(and, uid)
+			} else {
+				andNot = append(andNot, uid)
+			}
+		}
+		or = append(append(or, a...), b...)
+		slices.Sort(or)
+		or = slices.Compact(or)
+
+		require.Equal(t, and, nilIfEmpty(ba.And(bb).ToUids()))
+		require.Equal(t, or, ba.Or(bb).ToUids())
+		require.Equal(t, andNot, nilIfEmpty(ba.AndNot(bb).ToUids()))
+
+		pack := ba.ToPack(256)
+		require.Equal(t, a, Decode(pack, 0))
+		require.Equal(t, a, BitmapFromPack(pack).ToUids())
+		FreePack(pack)
+	}
+}
+
+func nilIfEmpty(uids []uint64) []uint64 {
+	if len(uids) == 0 {
+		return nil
+	}
+	return uids
+}
@@ -35,8 +35,12 @@ var (
 // Encoder is used to convert a list of UIDs into a pb.UidPack object.
 type Encoder struct {
 	BlockSize int
+	// Bitmaps makes the encoder store the dense containers of UIDs, the ones with at least
+	// MinBitmapUids UIDs, as bitmap blocks instead of blocks of deltas.
+	Bitmaps   bool
 	pack      *pb.UidPack
 	uids      []uint64
+	container []uint64
 	Alloc     *z.Allocator
 	buf       *bytes.Buffer
 }
@@ -112,7 +116,47 @@ func (e *Encoder) Add(uid uint64) {
 	if e.Alloc == nil {
 		e.Alloc = z.NewAllocator(1024, tagEncoder)
 	}
+	if e.Bitmaps {
+		// The UIDs of a container are held until it ends, to choose how to store them.
+		if len(e.container) > 0 && containerKey(e.container[0]) != containerKey(uid) {
+			e.packContainer()
+		}
+		e.container = append(e.container, uid)
+		return
+	}
+	e.add(uid)
+}
+
+// packContainer stores the UIDs of the current container as a bitmap block if they are dense
+// enough, or as blocks of deltas otherwise.
+func (e *Encoder) packContainer() {
+	if len(e.container) < MinBitmapUids {
+		for _, uid := range e.container {
+			e.add(uid)
+		}
+		e.container = e.container[:0]
+		return
+	}
+
+	// Finish the block of deltas of the previous UIDs first, to keep the blocks sorted.
+	e.packBlock()
+	e.uids = e.uids[:0]
+
+	b := e.Alloc.AllocateAligned(blockSize)
+	block := (*pb.UidBlock)(unsafe.Pointer(&b[0]))
+	block.Base = e.container[0]
+	block.NumUids = uint32(len(e.container))
+	block.Encoding = pb.UidBlock_BITMAP
+	block.Deltas = e.Alloc.Allocate(bitmapBytes)
+	clear(block.Deltas)
+	for _, uid := range e.container {
+		setBit(block.Deltas, uint16(uid))
+	}
+	e.pack.Blocks = append(e.pack.Blocks, block)
+	e.container = e.container[:0]
+}
 
+func (e *Encoder) add(uid uint64) {
 	size := len(e.uids)
 	if size > 0 && !match32MSB(e.uids[size-1], uid) {
 		e.packBlock()
@@ -128,6 +172,9 @@ func (e *Encoder) Add(uid uint64) {
 
 // Done returns the final output of the encoder. This UidPack MUST BE FREED via a call to FreePack.
 func (e *Encoder) Done() *pb.UidPack {
+	if len(e.container) > 0 {
+		e.packContainer()
+	}
 	e.packBlock()
 	if e.pack != nil && e.Alloc != nil {
 		e.pack.AllocRef = e.Alloc.Ref
@@ -163,6 +210,10 @@ func (d *Decoder) UnpackBlock() []uint64 {
 		return d.uids
 	}
 	block := d.Pack.Blocks[d.blockIdx]
+	if IsBitmapBlock(block) {
+		d.uids = appendBitmapUids(d.uids, block)
+		return d.uids
+	}
 
 	last := block.Base
 	d.uids = append(d.uids, last)
@@ -207,7 +258,7 @@ func (d *Decoder) ApproxLen() int {
 	if d.Pack == nil {
 		return 0
 	}
-	return int(d.Pack.BlockSize) * (len(d.Pack.Blocks) - d.blockIdx)
+	return approxLen(d.Pack, d.blockIdx)
 }
 
 type searchFunc func(int) bool
@@ -383,6 +434,12 @@ func (d *Decoder) BlockIdx() int {
 	return d.blockIdx
 }
 
+// UnpackBlockAt moves the decoder to the block at the index and unpacks it.
+func (d *Decoder) UnpackBlockAt(idx int) []uint64 {
+	d.blockIdx = idx
+	return d.UnpackBlock()
+}
+
 // Encode takes in a list of uids and a block size. It would pack these uids into blocks of the
 // given size, with the last block having fewer uids. Within each block, it stores the first uid as
 // base. For each next uid, a delta = uids[i] - uids[i-1] is stored. Protobuf uses Varint encoding,
@@ -419,7 +476,21 @@ func ApproxLen(pack *pb.UidPack) int {
 	if pack == nil {
 		return 0
 	}
-	return len(pack.Blocks) * int(pack.BlockSize)
+	return approxLen(pack, 0)
+}
+
+// approxLen returns the approximate number of UIDs in the blocks of the pack from blockIdx on.
+// The bitmap blocks hold more UIDs than the block size, so their exact count is used.
+func approxLen(pack *pb.UidPack, blockIdx int) int {
+	var n int
+	for _, block := range pack.Blocks[min(blockIdx, len(pack.Blocks)):] {
+		if IsBitmapBlock(block) {
+			n += int(block.NumUids)
+		} else {
+			n += int(pack.BlockSize)
+		}
+	}
+	return n
 }
 
 // ExactLen would calculate the total number of UIDs. Instead of using a UidPack, it accepts blocks,
@@ -486,6 +557,7 @@ func CopyUidPack(pack *pb.UidPack) *pb.UidPack {
 		packCopy.Blocks[i].NumUids = block.NumUids
 		packCopy.Blocks[i].Deltas = make([]byte, len(block.Deltas))
 		copy(packCopy.Blocks[i].Deltas, block.Deltas)
+		packCopy.Blocks[i].Encoding = block.Encoding
 	}
 
 	return packCopy
@@ -242,6 +242,10 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := context.WithValue(r.Context(), query.DebugKey, isDebugMode)
 	ctx = x.AttachAccessJwt(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
+	// as_of runs the query against the data as it was at the given timestamp or RFC3339 time.
+	ctx = x.AttachAsOf(ctx, r.URL.Query().Get("as_of"))
+	// stored_query runs the stored query with the given name, the body only carries variables.
+	ctx = x.AttachStoredQuery(ctx, r.URL.Query().Get("stored_query"))
 
 	if queryTimeout != 0 {
 		var cancel context.CancelFunc
@@ -646,6 +650,12 @@ func alterHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	op.RunInBackground = runInBackground
 
+	dryRun, err := parseBool(r, "dryRun")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+
 	glog.Infof("Got alter request via HTTP from %s\n", r.RemoteAddr)
 	fwd := r.Header.Get("X-Forwarded-For")
 	if len(fwd) > 0 {
@@ -656,10 +666,24 @@ func alterHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := x.AttachAuthToken(context.Background(), r)
 	ctx = x.AttachAccessJwt(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
-	if _, err := (&edgraph.Server{}).Alter(ctx, op); err != nil {
+	if dryRun {
+		ctx = x.AttachDryRun(ctx)
+	}
+	payload, err := (&edgraph.Server{}).Alter(ctx, op)
+	if err != nil {
 		x.SetStatus(w, x.Error, err.Error())
 		return
 	}
+	if dryRun {
+		// The impact report of the schema change.
+		js, err := json.Marshal(map[string]json.RawMessage{"data": payload.GetData()})
+		if err != nil {
+			x.SetStatus(w, x.Error, err.Error())
+			return
+		}
+		_, _ = x.WriteResponse(w, r, js)
+		return
+	}
 
 	writeSuccessResponse(w, r)
 }
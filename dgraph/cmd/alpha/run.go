@@ -108,7 +108,15 @@ they form a Raft group and provide synchronous replication.
 
 	// Custom plugins.
 	flag.String("custom_tokenizers", "",
-		"Comma separated list of tokenizer plugins for custom indices.")
+		"Comma separated list of tokenizer plugins for custom indices. Files with the .wasm "+
+			"extension are loaded as WebAssembly modules, others as Go plugins.")
+	flag.String("wasm_tokenizer", tok.WasmDefaults, z.NewSuperFlagHelp(tok.WasmDefaults).
+		Head("Limits of the custom tokenizers loaded from WebAssembly modules").
+		Flag("memory-mb",
+			"The maximum memory (in MB) of an instance of a WASM tokenizer.").
+		Flag("timeout",
+			"The maximum duration of a call of a WASM tokenizer.").
+		String())
 
 	flag.Bool("mcp", false, "run MCP server along with alpha.")
 
@@ -209,6 +217,9 @@ they form a Raft group and provide synchronous replication.
 			" vs searched via type index. If the number of elements are too low, then querying the"+
 			" index might be slower. This would allow people to set their limit according to"+
 			" their use case.").
+		Flag("namespace-quotas", "When set to true, the guardians of the galaxy can limit the "+
+			"storage, query and mutation rates, query edges and query timeout of each namespace "+
+			"through the updateNamespaceQuota admin mutation.").
 		String())
 
 	flag.String("graphql", worker.GraphQLDefaults, z.NewSuperFlagHelp(worker.GraphQLDefaults).
@@ -246,6 +257,16 @@ they form a Raft group and provide synchronous replication.
 			"The path to client key file for TLS encryption.").
 		String())
 
+	flag.String("wal-archive", worker.WALArchiveDefaults, z.NewSuperFlagHelp(
+		worker.WALArchiveDefaults).
+		Head("Options to archive the commits to a backup location for point-in-time restores").
+		Flag("destination",
+			"The backup location (a directory, or a minio or s3 URL) where the commits are "+
+				"archived. Archiving is disabled if empty.").
+		Flag("interval",
+			"The interval at which the group leaders write the new commits to the destination.").
+		String())
+
 	flag.String("audit", worker.AuditDefaults, z.NewSuperFlagHelp(worker.AuditDefaults).
 		Head("Audit options").
 		Flag("output",
@@ -260,6 +281,13 @@ they form a Raft group and provide synchronous replication.
 			"The number of days audit logs will be preserved.").
 		Flag("size",
 			"The audit log max size in MB after which it will be rolled over.").
+		Flag("syslog",
+			`[local, udp://host:514, tcp://host:514] Also sends the audit logs to the local
+			syslog daemon, or to a remote one.`).
+		Flag("webhook",
+			"Also posts the audit logs to this URL, in batches of JSON lines.").
+		Flag("reads",
+			"Audits the predicates read by each query, and the number of nodes read of each.").
 		String())
 
 	flag.String("feature-flags", worker.FeatureFlagsDefaults, z.NewSuperFlagHelp(worker.FeatureFlagsDefaults).
@@ -272,8 +300,31 @@ they form a Raft group and provide synchronous replication.
 			"with structured fields including trace ID for correlation with distributed traces. "+
 			"Disabled by default (0). Note: enabling this logs query text which may contain "+
 			"sensitive data; do not enable in deployments with strict data privacy requirements.").
+		Flag("uid-bitmaps", "Store the dense containers of uids of the posting lists as bitmaps. "+
+			"Older versions can't read them, so enable it only once every Alpha of the cluster, "+
+			"and every tool reading its p directory, runs this version.").
 		String())
 
+	flag.String("history", worker.HistoryDefaults, z.NewSuperFlagHelp(worker.HistoryDefaults).
+		Head("History options").
+		Flag("retention",
+			"The duration for which older versions of the data are kept around, so that they "+
+				"can be queried via the as_of query parameter. Set to 0 to disable.").
+		Flag("predicates",
+			"A comma separated list of <predicate>:<duration> pairs overriding the retention "+
+				`for individual predicates (e.g. "predicates=name:30d,email:12h").`).
+		String())
+
+	flag.String("stored-queries", worker.StoredQueryDefaults,
+		z.NewSuperFlagHelp(worker.StoredQueryDefaults).
+			Head("Stored query options").
+			Flag("enabled",
+				"Allow named DQL queries to be registered via the admin API and invoked by name.").
+			Flag("only",
+				"Reject ad-hoc DQL queries and mutations from users other than guardians. Only "+
+					"stored queries can be run. Implies enabled.").
+			String())
+
 	RegisterFlags(flag)
 }
 
@@ -282,6 +333,7 @@ func setupCustomTokenizers() {
 	if customTokenizers == "" {
 		return
 	}
+	tok.SetWasmLimits(Alpha.Conf.GetString("wasm_tokenizer"))
 	for _, soFile := range strings.Split(customTokenizers, ",") {
 		tok.LoadCustomTokenizer(soFile)
 	}
@@ -636,6 +688,13 @@ func run() {
 
 	enableMcp := Alpha.Conf.GetBool("mcp")
 
+	history := z.NewSuperFlag(Alpha.Conf.GetString("history")).MergeAndCheckDefault(
+		worker.HistoryDefaults)
+	predicateHistory, err := worker.ParseHistoryPredicates(history.GetString("predicates"))
+	x.Check(err)
+	storedQueries := z.NewSuperFlag(Alpha.Conf.GetString("stored-queries")).MergeAndCheckDefault(
+		worker.StoredQueryDefaults)
+
 	opts := worker.Options{
 		PostingDir:      Alpha.Conf.GetString("postings"),
 		WALDir:          Alpha.Conf.GetString("wal"),
@@ -647,7 +706,10 @@ func run() {
 		AuthToken:          security.GetString("token"),
 		Audit:              conf,
 		ChangeDataConf:     Alpha.Conf.GetString("cdc"),
+		WALArchiveConf:     Alpha.Conf.GetString("wal-archive"),
 		TypeFilterUidLimit: x.Config.Limit.GetUint64("type-filter-uid-limit"),
+		HistoryRetention:   history.GetDuration("retention"),
+		PredicateHistory:   predicateHistory,
 	}
 
 	keys, err := x.GetEncAclKeys(Alpha.Conf)
@@ -703,6 +765,9 @@ func run() {
 		AclPublicKey:        keys.AclPublicKey,
 		Audit:               opts.Audit != nil,
 		Badger:              bopts,
+		StoredQueries: storedQueries.GetBool("enabled") ||
+			storedQueries.GetBool("only"),
+		StoredQueriesOnly: storedQueries.GetBool("only"),
 	}
 	x.WorkerConfig.Parse(Alpha.Conf)
 
@@ -710,6 +775,15 @@ func run() {
 	z.SetTmpDir(x.WorkerConfig.TmpDir)
 
 	x.WorkerConfig.EncryptionKey = keys.EncKey
+	x.WorkerConfig.FieldKeys = keys.FieldKeys
+	// The key is read again to rotate it, after the key file or the Vault secret is updated.
+	worker.EncryptionKeySource = func() (x.Sensitive, error) {
+		keys, err := x.GetEncAclKeys(Alpha.Conf)
+		if err != nil {
+			return nil, err
+		}
+		return keys.EncKey, nil
+	}
 
 	setupCustomTokenizers()
 	x.Config.PortOffset = Alpha.Conf.GetInt("port_offset")
@@ -720,6 +794,7 @@ func run() {
 	x.Config.QueryTimeout = x.Config.Limit.GetDuration("query-timeout")
 	x.Config.MaxRetries = x.Config.Limit.GetInt64("max-retries")
 	x.Config.SharedInstance = x.Config.Limit.GetBool("shared-instance")
+	x.Config.NamespaceQuotas = x.Config.Limit.GetBool("namespace-quotas")
 
 	x.Config.GraphQL = z.NewSuperFlag(Alpha.Conf.GetString("graphql")).MergeAndCheckDefault(
 		worker.GraphQLDefaults)
@@ -744,6 +819,7 @@ func run() {
 	x.Config.NormalizeCompatibilityMode = featureFlagsConf.GetString("normalize-compatibility-mode")
 	enableDetailedMetrics := featureFlagsConf.GetBool("enable-detailed-metrics")
 	x.WorkerConfig.SlowQueryLogThreshold = featureFlagsConf.GetDuration("log-slow-query-threshold")
+	posting.Config.BitmapBlocks = featureFlagsConf.GetBool("uid-bitmaps")
 
 	x.PrintVersion()
 	glog.Infof("x.Config: %+v", x.Config)
@@ -802,7 +878,7 @@ func run() {
 		}
 	}()
 
-	updaters := z.NewCloser(2)
+	updaters := z.NewCloser(3)
 	go func() {
 		worker.StartRaftNodes(worker.State.WALstore, bindall)
 		atomic.AddUint32(&initDone, 1)
@@ -811,6 +887,8 @@ func run() {
 		// and health check passes
 		edgraph.InitializeAcl(updaters)
 		edgraph.RefreshACLs(updaters.Ctx())
+		edgraph.RefreshQuotas(updaters.Ctx())
+		go edgraph.SubscribeForQuotaUpdates(updaters)
 		edgraph.SubscribeForAclUpdates(updaters)
 	}()
 
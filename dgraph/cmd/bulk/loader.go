@@ -63,6 +63,7 @@ type BulkOptions struct {
 	LogErrors        bool
 	ErrorLogPath     string
 	CustomTokenizers string
+	WasmTokenizer    string
 	NewUids          bool
 	ClientDir        string
 	Encrypted        bool
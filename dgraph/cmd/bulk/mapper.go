@@ -439,9 +439,9 @@ func (m *mapper) addIndexMapEntries(nq dql.NQuad, de *pb.DirectedEdge) {
 	sch := m.schema.getSchema(x.NamespaceAttr(nq.GetNamespace(), nq.GetPredicate()))
 	for _, tokerName := range sch.GetTokenizer() {
 		// Find tokeniser.
-		toker, ok := tok.GetTokenizer(tokerName)
-		if !ok {
-			log.Fatalf("unknown tokenizer %q", tokerName)
+		toker, err := tok.GetTokenizerWithAnalyzer(tokerName, sch.GetAnalyzers()[tokerName])
+		if err != nil {
+			log.Fatalf("unknown tokenizer %q: %v", tokerName, err)
 		}
 
 		// Create storage value.
@@ -104,7 +104,15 @@ func init() {
 			"cluster. Increasing this potentially decreases the reduce stage runtime by using "+
 			"more parallelism, but increases memory usage.")
 	flag.String("custom_tokenizers", "",
-		"Comma separated list of tokenizer plugins")
+		"Comma separated list of tokenizer plugins. Files with the .wasm extension are loaded "+
+			"as WebAssembly modules, others as Go plugins.")
+	flag.String("wasm_tokenizer", tok.WasmDefaults, z.NewSuperFlagHelp(tok.WasmDefaults).
+		Head("Limits of the custom tokenizers loaded from WebAssembly modules").
+		Flag("memory-mb",
+			"The maximum memory (in MB) of an instance of a WASM tokenizer.").
+		Flag("timeout",
+			"The maximum duration of a call of a WASM tokenizer.").
+		String())
 	flag.Bool("new_uids", false,
 		"Ignore UIDs in load files and assign new ones.")
 	flag.Uint64("force-namespace", math.MaxUint64,
@@ -136,6 +144,8 @@ func run() {
 		FromSuperFlag(Bulk.Conf.GetString("badger"))
 	keys, err := x.GetEncAclKeys(Bulk.Conf)
 	x.Check(err)
+	// The values of the encrypted predicates are encrypted like the Alphas do.
+	x.WorkerConfig.FieldKeys = keys.FieldKeys
 
 	opt := BulkOptions{
 		DataFiles:        Bulk.Conf.GetString("files"),
@@ -165,6 +175,7 @@ func run() {
 		MapShards:        Bulk.Conf.GetInt("map_shards"),
 		ReduceShards:     Bulk.Conf.GetInt("reduce_shards"),
 		CustomTokenizers: Bulk.Conf.GetString("custom_tokenizers"),
+		WasmTokenizer:    Bulk.Conf.GetString("wasm_tokenizer"),
 		NewUids:          Bulk.Conf.GetBool("new_uids"),
 		ClientDir:        Bulk.Conf.GetString("xidmap"),
 		Namespace:        Bulk.Conf.GetUint64("force-namespace"),
@@ -260,6 +271,7 @@ func RunBulkLoader(opt BulkOptions) {
 		opt.CleanupTmp = false
 	}
 	if opt.CustomTokenizers != "" {
+		tok.SetWasmLimits(opt.WasmTokenizer)
 		for _, soFile := range strings.Split(opt.CustomTokenizers, ",") {
 			tok.LoadCustomTokenizer(soFile)
 		}
@@ -154,6 +154,9 @@ func (s *schemaStore) validateType(de *pb.DirectedEdge, objectIsUID bool) {
 	if err != nil {
 		log.Fatalf("RDF doesn't match schema: %v", err)
 	}
+	if err := wk.EncryptEdge(de, sch); err != nil {
+		log.Fatalf("Unable to encrypt value: %v", err)
+	}
 }
 
 func (s *schemaStore) getPredicates(db *badger.DB) []string {
@@ -32,6 +32,7 @@ import (
 	"github.com/dgraph-io/dgraph/v25/posting"
 	"github.com/dgraph-io/dgraph/v25/protos/pb"
 	"github.com/dgraph-io/dgraph/v25/raftwal"
+	"github.com/dgraph-io/dgraph/v25/schema"
 	"github.com/dgraph-io/dgraph/v25/types"
 	"github.com/dgraph-io/dgraph/v25/x"
 	"github.com/dgraph-io/ristretto/v2/z"
@@ -60,6 +61,8 @@ type flagOptions struct {
 	key           x.Sensitive
 	onlySummary   bool
 	parseKey      string
+	consistency   bool
+	repair        bool
 
 	// Options related to the WAL.
 	wdir           string
@@ -105,6 +108,11 @@ func init() {
 		"Set snapshot term,index,readts to this. Value must be comma-separated list containing"+
 			" the value for these vars in that order.")
 	flag.StringVar(&opt.parseKey, "parse_key", "", "Parse hex key.")
+	flag.BoolVar(&opt.consistency, "check-consistency", false,
+		"Check the index, reverse and count keys of the predicate given by --pred, in the root"+
+			" namespace, against its data at the --at timestamp.")
+	flag.BoolVar(&opt.repair, "repair", false,
+		"Write the deltas that fix the keys found by --check-consistency. Needs --readonly=false.")
 	x.RegisterEncFlag(flag)
 }
 
@@ -481,6 +489,40 @@ func rollupKey(db *badger.DB) {
 	x.Check(wb.Flush())
 }
 
+func checkConsistency(db *badger.DB) {
+	if len(opt.predicate) == 0 {
+		log.Fatal("--check-consistency needs a predicate, set with --pred")
+	}
+	if opt.repair && opt.readOnly {
+		log.Fatal("--repair needs the DB to be opened with --readonly=false")
+	}
+	schema.Init(db)
+	x.Check(schema.LoadFromDb(context.Background()))
+
+	attr := x.AttrInRootNamespace(opt.predicate)
+	report, err := posting.CheckConsistency(context.Background(), db, attr, opt.readTs)
+	x.Check(err)
+	fmt.Printf("Checked %d data keys and %d derived keys of %s, found %d inconsistent keys\n",
+		report.DataKeys, report.Keys, opt.predicate, len(report.Inconsistencies))
+	for _, inc := range report.Inconsistencies {
+		pk, err := x.Parse(inc.Key)
+		x.Check(err)
+		fmt.Printf("Key: %x %+v missing: %#x extra: %#x\n", inc.Key, pk, inc.Missing, inc.Extra)
+	}
+	if !opt.repair || len(report.Inconsistencies) == 0 {
+		return
+	}
+
+	// The deltas go on top of the latest version, as we can't get a new timestamp in debug mode.
+	version := db.MaxVersion() + 1
+	kvs, err := report.RepairKVs(version)
+	x.Check(err)
+	wb := db.NewManagedWriteBatch()
+	x.Check(wb.WriteList(&bpb.KVList{Kv: kvs}))
+	x.Check(wb.Flush())
+	fmt.Printf("Repaired %d keys at %d\n", len(kvs), version)
+}
+
 func lookup(db *badger.DB) {
 	txn := db.NewTransactionAt(opt.readTs, false)
 	defer txn.Discard()
@@ -987,7 +1029,9 @@ func run() {
 	}
 	keys, err := x.GetEncAclKeys(Debug.Conf)
 	x.Check(err)
-	opt.key = keys.EncKey
+	// The stores of the Alpha are encrypted with the storage key in its keyring.
+	opt.key, err = x.StorageKey(dir, keys.EncKey)
+	x.Check(err)
 
 	if isWal {
 		store, err := raftwal.InitEncrypted(dir, opt.key)
@@ -1027,6 +1071,8 @@ func run() {
 	switch {
 	case len(opt.rollupKey) > 0:
 		rollupKey(db)
+	case opt.consistency:
+		checkConsistency(db)
 	case len(opt.keyLookup) > 0:
 		lookup(db)
 	case len(opt.jepsen) > 0:
@@ -230,6 +230,11 @@ func (o *Oracle) sendDeltasToSubscribers() {
 			// Don't goto slurp_loop, because it would break from select immediately.
 		}
 
+		// The time at which the timestamps are sent lets the Alphas derive the same time from a
+		// read timestamp, e.g. to expire the data with a TTL.
+		if wait := waitFor(); wait > 0 {
+			delta.Times = map[uint64]int64{wait: time.Now().Unix()}
+		}
 		if glog.V(3) {
 			glog.Infof("DoneUntil: %d. Sending delta: %+v\n", o.doneUntil.DoneUntil(), delta)
 		}
@@ -132,6 +132,11 @@ instances to achieve high-availability.
 			"The number of days audit logs will be preserved.").
 		Flag("size",
 			"The audit log max size in MB after which it will be rolled over.").
+		Flag("syslog",
+			`[local, udp://host:514, tcp://host:514] Also sends the audit logs to the local
+			syslog daemon, or to a remote one.`).
+		Flag("webhook",
+			"Also posts the audit logs to this URL, in batches of JSON lines.").
 		String())
 }
 
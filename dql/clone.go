@@ -0,0 +1,132 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package dql
+
+import (
+	"maps"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+)
+
+// Clone returns a deep copy of the parsed result. Query processing and ACL filtering modify the
+// parsed blocks in place, so a cached result must be cloned before it is executed.
+func (res *Result) Clone() Result {
+	out := Result{Schema: res.Schema}
+	if res.Query != nil {
+		out.Query = make([]*GraphQuery, len(res.Query))
+		for i, gq := range res.Query {
+			out.Query[i] = gq.Clone()
+		}
+	}
+	if res.QueryVars != nil {
+		out.QueryVars = make([]*Vars, len(res.QueryVars))
+		for i, v := range res.QueryVars {
+			out.QueryVars[i] = &Vars{
+				Defines: slices.Clone(v.Defines),
+				Needs:   slices.Clone(v.Needs),
+			}
+		}
+	}
+	return out
+}
+
+// Clone returns a deep copy of the query block.
+func (gq *GraphQuery) Clone() *GraphQuery {
+	if gq == nil {
+		return nil
+	}
+	out := *gq
+	out.UID = slices.Clone(gq.UID)
+	out.Langs = slices.Clone(gq.Langs)
+	out.NeedsVar = slices.Clone(gq.NeedsVar)
+	out.Func = gq.Func.Clone()
+	out.Args = maps.Clone(gq.Args)
+	if gq.Order != nil {
+		out.Order = make([]*pb.Order, len(gq.Order))
+		for i, o := range gq.Order {
+			out.Order[i] = proto.Clone(o).(*pb.Order)
+		}
+	}
+	if gq.Children != nil {
+		out.Children = make([]*GraphQuery, len(gq.Children))
+		for i, child := range gq.Children {
+			out.Children[i] = child.Clone()
+		}
+	}
+	out.Filter = gq.Filter.Clone()
+	out.MathExp = gq.MathExp.Clone()
+	out.RecurseArgs.varMap = maps.Clone(gq.RecurseArgs.varMap)
+	out.ShortestPathArgs.From = gq.ShortestPathArgs.From.Clone()
+	out.ShortestPathArgs.To = gq.ShortestPathArgs.To.Clone()
+	out.Cascade = slices.Clone(gq.Cascade)
+	if gq.Facets != nil {
+		out.Facets = proto.Clone(gq.Facets).(*pb.FacetParams)
+	}
+	out.FacetsFilter = gq.FacetsFilter.Clone()
+	if gq.GroupbyAttrs != nil {
+		out.GroupbyAttrs = make([]GroupByAttr, len(gq.GroupbyAttrs))
+		for i, attr := range gq.GroupbyAttrs {
+			attr.Langs = slices.Clone(attr.Langs)
+			out.GroupbyAttrs[i] = attr
+		}
+	}
+	out.FacetVar = maps.Clone(gq.FacetVar)
+	if gq.FacetsOrder != nil {
+		out.FacetsOrder = make([]*FacetOrder, len(gq.FacetsOrder))
+		for i, o := range gq.FacetsOrder {
+			fo := *o
+			out.FacetsOrder[i] = &fo
+		}
+	}
+	out.AllowedPreds = slices.Clone(gq.AllowedPreds)
+	return &out
+}
+
+// Clone returns a deep copy of the filter tree.
+func (f *FilterTree) Clone() *FilterTree {
+	if f == nil {
+		return nil
+	}
+	out := &FilterTree{Op: f.Op, Func: f.Func.Clone()}
+	if f.Child != nil {
+		out.Child = make([]*FilterTree, len(f.Child))
+		for i, child := range f.Child {
+			out.Child[i] = child.Clone()
+		}
+	}
+	return out
+}
+
+// Clone returns a deep copy of the function.
+func (f *Function) Clone() *Function {
+	if f == nil {
+		return nil
+	}
+	out := *f
+	out.Args = slices.Clone(f.Args)
+	out.UID = slices.Clone(f.UID)
+	out.NeedsVar = slices.Clone(f.NeedsVar)
+	return &out
+}
+
+// Clone returns a deep copy of the math tree. The values of variables are shared, they are only
+// filled in during query processing.
+func (t *MathTree) Clone() *MathTree {
+	if t == nil {
+		return nil
+	}
+	out := &MathTree{Fn: t.Fn, Var: t.Var, Const: t.Const, Val: t.Val}
+	if t.Child != nil {
+		out.Child = make([]*MathTree, len(t.Child))
+		for i, child := range t.Child {
+			out.Child[i] = child.Clone()
+		}
+	}
+	return out
+}
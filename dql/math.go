@@ -55,7 +55,9 @@ type MathTree struct {
 
 func isUnary(f string) bool {
 	return f == "exp" || f == "ln" || f == "u-" || f == "sqrt" ||
-		f == "floor" || f == "ceil" || f == "since"
+		f == "floor" || f == "ceil" || f == "since" ||
+		f == "lower" || f == "upper" || f == "len" ||
+		f == "year" || f == "month" || f == "day"
 }
 
 func isBinaryMath(f string) bool {
@@ -63,7 +65,20 @@ func isBinaryMath(f string) bool {
 }
 
 func isTernary(f string) bool {
-	return f == "cond"
+	return f == "cond" || f == "substr" || f == "replace" || f == "date_diff" || f == "geodist"
+}
+
+// isValueFunc returns true for the string, datetime and geo functions.
+func isValueFunc(f string) bool {
+	return f == "concat" || f == "lower" || f == "upper" || f == "substr" ||
+		f == "len" || f == "replace" ||
+		f == "now" || f == "date_trunc" || f == "date_diff" || f == "add_duration" ||
+		f == "year" || f == "month" || f == "day" || f == "geodist" || f == "length"
+}
+
+// isNullary returns true for the functions which don't take any argument, like now().
+func isNullary(f string) bool {
+	return f == "now"
 }
 
 func isZero(f string, rval types.Val) bool {
@@ -153,7 +168,7 @@ func isMathFunc(f string) bool {
 		f == "==" || f == "!=" ||
 		f == "min" || f == "max" || f == "sqrt" ||
 		f == "pow" || f == "logbase" || f == "floor" || f == "ceil" ||
-		f == "since" || f == "dot"
+		f == "since" || f == "dot" || isValueFunc(f)
 }
 
 func parseMathFunc(gq *GraphQuery, it *lex.ItemIterator, again bool) (*MathTree, bool, error) {
@@ -175,7 +190,27 @@ loop:
 	for it.Next() {
 		item := it.Item()
 		lval := strings.ToLower(item.Val)
+		// The string and datetime functions have names which are also likely to be used for
+		// value variables, e.g. year or len. Treat them as functions only when they are called.
+		isCall := false
+		if peekIt, err := it.Peek(1); err == nil {
+			isCall = peekIt[0].Typ == itemLeftRound
+		}
+		if isValueFunc(lval) && !isCall {
+			lval = ""
+		}
 		switch {
+		case isNullary(lval):
+			// Functions without arguments are values by themselves, e.g. now().
+			it.Next()
+			if it.Item().Typ != itemLeftRound {
+				return nil, false, errors.Errorf("Expected ( after %s", lval)
+			}
+			it.Next()
+			if it.Item().Typ != itemRightRound {
+				return nil, false, errors.Errorf("Function %s doesn't take any arguments", lval)
+			}
+			valueStack.push(&MathTree{Fn: lval})
 		case isMathFunc(lval):
 			op := lval
 			it.Prev()
@@ -240,6 +275,15 @@ loop:
 				}
 				continue
 			}
+			if strings.HasPrefix(item.Val, `"`) {
+				// A quoted string constant, used by the string and datetime functions.
+				str, err := strconv.Unquote(item.Val)
+				if err != nil {
+					return nil, false, errors.Wrapf(err, "while parsing string %s in math", item.Val)
+				}
+				valueStack.push(&MathTree{Const: types.Val{Tid: types.StringID, Value: str}})
+				continue
+			}
 			// We will try to parse the constant as an Int first, if that fails we move to float
 			child := &MathTree{}
 			i, err := strconv.ParseInt(item.Val, 10, 64)
@@ -392,6 +436,8 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 				t.Const.Value.(float64), 'E', -1, 64))
 		case types.IntID:
 			leafStr, err = buf.WriteString(strconv.FormatInt(t.Const.Value.(int64), 10))
+		case types.StringID:
+			leafStr, err = buf.WriteString(strconv.Quote(t.Const.Value.(string)))
 		}
 		x.Check2(leafStr, err)
 		return
@@ -401,7 +447,9 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 	switch t.Fn {
 	case "+", "-", "/", "*", "%", "exp", "ln", "cond", "min",
 		"sqrt", "max", "<", ">", "<=", ">=", "==", "!=", "u-",
-		"logbase", "pow", "dot":
+		"logbase", "pow", "dot", "concat", "lower", "upper", "substr", "len", "replace",
+		"now", "date_trunc", "date_diff", "add_duration", "year", "month", "day", "geodist",
+		"length":
 		x.Check2(buf.WriteString(t.Fn))
 	default:
 		x.Fatalf("Unknown operator: %q", t.Fn)
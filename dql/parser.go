@@ -29,6 +29,7 @@ const (
 	countFunc   = "count"
 	uidInFunc   = "uid_in"
 	similarToFn = "similar_to"
+	geoDistFunc = "geodist"
 )
 
 var (
@@ -186,18 +187,32 @@ var filterOpPrecedence = map[string]int{
 	"or":  1,
 }
 var mathOpPrecedence = map[string]int{
-	"u-":      500,
-	"floor":   105,
-	"ceil":    104,
-	"since":   103,
-	"exp":     100,
-	"ln":      99,
-	"sqrt":    98,
-	"cond":    90,
-	"pow":     89,
-	"logbase": 88,
-	"max":     85,
-	"min":     84,
+	"u-":           500,
+	"lower":        108,
+	"upper":        108,
+	"len":          108,
+	"year":         107,
+	"month":        107,
+	"day":          107,
+	"concat":       106,
+	"substr":       106,
+	"replace":      106,
+	"date_trunc":   106,
+	"date_diff":    106,
+	"add_duration": 106,
+	"geodist":      106,
+	"length":       106,
+	"floor":        105,
+	"ceil":         104,
+	"since":        103,
+	"exp":          100,
+	"ln":           99,
+	"sqrt":         98,
+	"cond":         90,
+	"pow":          89,
+	"logbase":      88,
+	"max":          85,
+	"min":          84,
 
 	// NOTE: Previously, we had "/" at precedence 50 and "*" at precedence 49.
 	//       This is problematic because it would evaluate:
@@ -378,7 +393,7 @@ func checkValueType(vm varMap) error {
 		// Ensure value is not nil if the variable is required.
 		if typ[len(typ)-1] == '!' {
 			if v.Value == "" {
-				return errors.Errorf("Variable %v should be initialised", k)
+				return &uninitialisedVarError{name: k, typ: typ[:len(typ)-1]}
 			}
 			typ = typ[:len(typ)-1]
 		}
@@ -420,6 +435,44 @@ func checkValueType(vm varMap) error {
 	return nil
 }
 
+// uninitialisedVarError is returned when no value is given for a required variable.
+type uninitialisedVarError struct {
+	name string
+	typ  string
+}
+
+func (e *uninitialisedVarError) Error() string {
+	return fmt.Sprintf("Variable %v should be initialised", e.name)
+}
+
+// placeholderValues are used in place of the required variables while validating a query.
+var placeholderValues = map[string]string{
+	"int":           "1",
+	"float":         "1",
+	"bool":          "true",
+	"float32vector": "[1]",
+	"string":        "0x1",
+}
+
+// ParseWithPlaceholders parses the query without requiring values for its variables, which is
+// useful to validate queries that are stored to be run later. Placeholder values are used for the
+// required variables.
+func ParseWithPlaceholders(query string) (Result, error) {
+	vars := make(map[string]string)
+	for {
+		res, err := Parse(Request{Str: query, Variables: vars})
+		var uerr *uninitialisedVarError
+		if !errors.As(err, &uerr) {
+			return res, err
+		}
+		val, ok := placeholderValues[uerr.typ]
+		if _, seen := vars[uerr.name]; seen || !ok {
+			return res, err
+		}
+		vars[uerr.name] = val
+	}
+}
+
 func substituteVar(f string, res *string, vmap varMap) error {
 	if len(f) > 0 && f[0] == '$' {
 		va, ok := vmap[f]
@@ -1701,7 +1754,7 @@ func validFuncName(name string) bool {
 
 	switch name {
 	case "regexp", "anyofterms", "allofterms", "alloftext", "anyoftext", "ngram",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to", "facet":
 		return true
 	}
 	return false
@@ -3242,6 +3295,26 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 				gq.Children = append(gq.Children, child)
 				curp = nil
 				continue
+			case valLower == geoDistFunc:
+				// The distance computed by the near or knn_geo function of the block.
+				if varName == "" && alias == "" {
+					return it.Errorf("geodist should be used with a variable or have an alias")
+				}
+				if peekIt, err := it.Peek(1); err == nil && peekIt[0].Typ == itemLeftRound {
+					return it.Errorf("geodist doesn't take arguments inside a block, use " +
+						"math(geodist(...)) for the distance to another point")
+				}
+				child := &GraphQuery{
+					Attr:       val,
+					Alias:      alias,
+					Args:       make(map[string]string),
+					Var:        varName,
+					IsInternal: true,
+				}
+				varName, alias = "", ""
+				gq.Children = append(gq.Children, child)
+				curp = nil
+				continue
 			case isMathBlock(valLower):
 				if varName == "" && alias == "" {
 					return it.Errorf("Function math should be used with a variable or have an alias")
@@ -3506,7 +3579,8 @@ func isMathBlock(name string) bool {
 }
 
 func isGeoFunc(name string) bool {
-	return name == "near" || name == "contains" || name == "within" || name == "intersects"
+	return name == "near" || name == "contains" || name == "within" || name == "intersects" ||
+		name == "knn_geo" || name == "dwithin_line"
 }
 
 func IsInequalityFn(name string) bool {
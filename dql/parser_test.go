@@ -551,6 +551,44 @@ func TestParseQueryWithVarValAggNested4(t *testing.T) {
 		res.Query[1].Children[0].Children[3].MathExp.debugString())
 }
 
+func TestParseMathValueFunctions(t *testing.T) {
+	query := `
+	{
+		me(func: uid(0x0a)) {
+			val(b)
+			val(c)
+			val(d)
+			val(e)
+			val(f)
+		}
+
+		var(func: uid(0x0a)) {
+			a as name
+			year as birthday
+			b as math(concat(concat(upper(substr(a, 0, 1)), lower(a)), concat(" ", len(a))))
+			c as math(date_diff("day", year, now()) + year(year))
+			d as math(date_trunc("month", add_duration(year, "30d")))
+			e as math(replace(a, "\"", "'"))
+			l as loc
+			f as math(geodist(l, "[-122.08, 37.42]", "km"))
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := res.Query[1].Children
+	require.EqualValues(t,
+		`(concat (concat (upper (substr a 0 1)) (lower a)) (concat " " (len a)))`,
+		children[2].MathExp.debugString())
+	require.EqualValues(t, `(+ (date_diff "day" year (now)) (year year))`,
+		children[3].MathExp.debugString())
+	require.EqualValues(t, `(date_trunc "month" (add_duration year "30d"))`,
+		children[4].MathExp.debugString())
+	require.EqualValues(t, `(replace a "\"" "'")`, children[5].MathExp.debugString())
+	require.EqualValues(t, `(geodist l "[-122.08, 37.42]" "km")`,
+		children[7].MathExp.debugString())
+}
+
 func TestParseQueryWithVarValAggNested5(t *testing.T) {
 	query := `
 	{
@@ -2271,6 +2309,26 @@ func TestParseFuncNested(t *testing.T) {
 	require.Equal(t, res.Query[0].Func.IsCount, true)
 }
 
+func TestParseFacetFunc(t *testing.T) {
+	query := `
+	query {
+		me(func: facet(follows, since, between, "2020-01-01", "2021-01-01")) {
+			name
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.NotNil(t, res.Query[0].Func)
+	require.Equal(t, "facet", res.Query[0].Func.Name)
+	require.Equal(t, "follows", res.Query[0].Func.Attr)
+	var args []string
+	for _, arg := range res.Query[0].Func.Args {
+		args = append(args, arg.Value)
+	}
+	require.Equal(t, []string{"since", "between", "2020-01-01", "2021-01-01"}, args)
+}
+
 func TestParseFuncNested2(t *testing.T) {
 	query := `
 	query {
@@ -2729,6 +2787,108 @@ func TestParseFilter_Geo4(t *testing.T) {
 	require.Contains(t, err.Error(), "\"]\"")
 }
 
+func TestParseFilter_GeoUnit(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x0a)) {
+			friends @filter(near(loc, [-1.12, 2.0123], 5, "km")) {
+				name
+			}
+		}
+	}
+`
+	resp, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	args := resp.Query[0].Children[0].Filter.Func.Args
+	require.Len(t, args, 3)
+	require.Equal(t, "[-1.12,2.0123]", args[0].Value)
+	require.Equal(t, "5", args[1].Value)
+	require.Equal(t, "km", args[2].Value)
+}
+
+func TestParseFilter_GeoLine(t *testing.T) {
+	query := `
+	{
+		me(func: dwithin_line(loc, [[-122.09, 37.42], [-122.07, 37.43]], 500)) {
+			name
+			r as route
+			l as math(length(r, "km"))
+			val(l)
+		}
+	}
+`
+	resp, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	fn := resp.Query[0].Func
+	require.Equal(t, "dwithin_line", fn.Name)
+	require.Len(t, fn.Args, 2)
+	require.Equal(t, "[[-122.09,37.42],[-122.07,37.43]]", fn.Args[0].Value)
+	require.Equal(t, "500", fn.Args[1].Value)
+	require.Equal(t, `(length r "km")`, resp.Query[0].Children[2].MathExp.debugString())
+}
+
+func TestParseKnnGeo(t *testing.T) {
+	query := `
+	{
+		me(func: knn_geo(loc, [-122.08, 37.42], 3)) {
+			name
+		}
+	}
+`
+	resp, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	fn := resp.Query[0].Func
+	require.Equal(t, "knn_geo", fn.Name)
+	require.Equal(t, "loc", fn.Attr)
+	require.Len(t, fn.Args, 2)
+	require.Equal(t, "[-122.08,37.42]", fn.Args[0].Value)
+	require.Equal(t, "3", fn.Args[1].Value)
+}
+
+func TestParseGeoDist(t *testing.T) {
+	query := `
+	{
+		me(func: knn_geo(loc, [-122.08, 37.42], 3, "km")) {
+			d as geodist
+			dist: geodist
+		}
+		you(func: uid(d), orderasc: val(d)) {
+			name
+		}
+	}
+`
+	resp, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Len(t, resp.Query[0].Func.Args, 3)
+	children := resp.Query[0].Children
+	require.Len(t, children, 2)
+	require.Equal(t, "geodist", children[0].Attr)
+	require.Equal(t, "d", children[0].Var)
+	require.True(t, children[0].IsInternal)
+	require.Equal(t, "dist", children[1].Alias)
+	require.True(t, children[1].IsInternal)
+
+	query = `
+	{
+		me(func: knn_geo(loc, [-122.08, 37.42], 3)) {
+			geodist
+		}
+	}
+`
+	_, err = Parse(Request{Str: query})
+	require.ErrorContains(t, err, "geodist should be used with a variable or have an alias")
+
+	query = `
+	{
+		me(func: knn_geo(loc, [-122.08, 37.42], 3)) {
+			d as geodist(loc, "[-122.08, 37.42]", "km")
+		}
+	}
+`
+	_, err = Parse(Request{Str: query})
+	require.ErrorContains(t, err, "geodist doesn't take arguments inside a block")
+}
+
 // Test if empty brackets will lead to errors.
 func TestParseFilter_emptyargument(t *testing.T) {
 	query := `
@@ -5689,3 +5849,23 @@ func TestEmptyId(t *testing.T) {
 	_, err := Parse(r)
 	require.Error(t, err, "ID cannot be empty")
 }
+
+func TestParseWithPlaceholders(t *testing.T) {
+	res, err := ParseWithPlaceholders(`query q($id: string!, $first: int!, $name: string = "x") {
+		q(func: uid($id), first: $first) @filter(eq(name, $name)) {
+			name
+		}
+	}`)
+	require.NoError(t, err)
+	require.Len(t, res.Query, 1)
+
+	_, err = ParseWithPlaceholders(`query q($first: int!) { q(func: has(name), first: $first) { `)
+	require.Error(t, err)
+
+	_, err = ParseWithPlaceholders(`query q($first: date!) { q(func: has(name)) { name } }`)
+	require.Error(t, err)
+
+	// Parse still requires values for the required variables.
+	_, err = Parse(Request{Str: `query q($first: int!) { q(func: has(name), first: $first) { name } }`})
+	require.EqualError(t, err, "Variable $first should be initialised")
+}
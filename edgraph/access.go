@@ -868,7 +868,8 @@ func parsePredsFromQuery(dqls []*dql.GraphQuery) predsAndvars {
 		if len(gq.Var) > 0 {
 			varsMap[gq.Var] = gq.Attr
 		}
-		if len(gq.Attr) > 0 && gq.Attr != "uid" && gq.Attr != "expand" && gq.Attr != "val" {
+		if len(gq.Attr) > 0 && gq.Attr != "uid" && gq.Attr != "expand" && gq.Attr != "val" &&
+			gq.Attr != "geodist" {
 			predsMap[gq.Attr] = struct{}{}
 
 		}
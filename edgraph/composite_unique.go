@@ -0,0 +1,584 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/query"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/worker"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// A @unique tuple of a type, like @unique(tenant, email), requires the combined values of its
+// fields to be unique across the nodes of the type. Each tuple is backed by a derived predicate,
+// named by schema.CompositeUniquePredicate, that holds the encoded values of the tuple for every
+// node of the type that has all of them. The predicate has a hash index, so checking a tuple is a
+// single index lookup, and the upsert directive, so two transactions that index the same values
+// conflict at commit like they do for a single @unique predicate.
+
+// compositeIndex is the derived predicate that indexes a @unique tuple of a type.
+type compositeIndex struct {
+	typ   string
+	tuple *pb.UniqueTuple
+	attr  string
+	// nodes is the number of stored nodes whose tuple is indexed when the tuple is added.
+	nodes int
+}
+
+func (idx *compositeIndex) fields() string {
+	preds := make([]string, 0, len(idx.tuple.Predicates))
+	for _, pred := range idx.tuple.Predicates {
+		preds = append(preds, x.ParseAttr(pred))
+	}
+	return strings.Join(preds, ", ")
+}
+
+// encodeTuple encodes the values of the fields of a tuple into the value of its index.
+func encodeTuple(values []string) string {
+	b, err := json.Marshal(values)
+	x.Check(err)
+	return string(b)
+}
+
+// tupleEntry is the value of a @unique tuple of a node that the mutation changes.
+type tupleEntry struct {
+	*compositeIndex
+	node *changedNode
+	// kept is set if the node still has the type once the mutation is applied.
+	kept bool
+	// value is the new value of the tuple, empty if the node no longer has all of its fields.
+	value string
+}
+
+// touchesTuple returns whether the edges of the node may change the values of the tuple.
+func touchesTuple(n *changedNode, tuple *pb.UniqueTuple) bool {
+	for _, e := range n.edges {
+		if e.Attr == x.Star || slices.Contains(tuple.Predicates, x.NamespaceAttr(n.ns, e.Attr)) {
+			return true
+		}
+	}
+	return false
+}
+
+// indexTuples checks that the @unique tuples of the types of the changed nodes stay unique, and
+// returns the edges that update their indexes.
+func (c *constraintChecker) indexTuples() ([]*pb.DirectedEdge, error) {
+	var entries []*tupleEntry
+	for _, n := range c.nodes {
+		if len(n.edges) == 0 {
+			continue
+		}
+		// A node that loses a type leaves the indexes of its tuples.
+		names := slices.Concat(n.types, n.oldTypes)
+		slices.Sort(names)
+		for _, name := range slices.Compact(names) {
+			typ, ok := schema.State().ConstrainedType(x.NamespaceAttr(n.ns, name))
+			if !ok {
+				continue
+			}
+			kept := slices.Contains(n.types, name)
+			added := !slices.Contains(n.oldTypes, name)
+			for _, tuple := range typ.Unique {
+				if kept && !added && !touchesTuple(n, tuple) {
+					continue
+				}
+				entries = append(entries, &tupleEntry{
+					compositeIndex: &compositeIndex{
+						typ:   typ.TypeName,
+						tuple: tuple,
+						attr:  schema.CompositeUniquePredicate(typ.TypeName, tuple),
+					},
+					node: n,
+					kept: kept,
+				})
+			}
+		}
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	if err := c.tupleValues(entries); err != nil {
+		return nil, err
+	}
+	if err := c.checkTuples(entries); err != nil {
+		return nil, err
+	}
+
+	edges := make([]*pb.DirectedEdge, 0, len(entries))
+	for _, entry := range entries {
+		edge := &pb.DirectedEdge{
+			Entity:    entry.node.uid,
+			Attr:      x.ParseAttr(entry.attr),
+			Namespace: entry.node.ns,
+		}
+		if entry.value == "" {
+			edge.Op = pb.DirectedEdge_DEL
+			edge.Value = []byte(x.Star)
+		} else {
+			edge.Op = pb.DirectedEdge_SET
+			edge.Value = []byte(entry.value)
+			edge.ValueType = pb.Posting_STRING
+			edge.ExpiresAt = entry.node.expiresAt
+		}
+		edges = append(edges, edge)
+	}
+	return edges, nil
+}
+
+// tupleValues finds the values of the tuples of the entries once the mutation is applied.
+func (c *constraintChecker) tupleValues(entries []*tupleEntry) error {
+	uids := make(map[string][]uint64)
+	for _, entry := range entries {
+		if !entry.kept {
+			continue
+		}
+		for _, pred := range entry.tuple.Predicates {
+			uids[pred] = append(uids[pred], entry.node.uid)
+		}
+	}
+	stored := make(map[string]map[uint64][]string, len(uids))
+	for attr, list := range uids {
+		slices.Sort(list)
+		values, err := c.fetch(attr, slices.Compact(list))
+		if err != nil {
+			return err
+		}
+		stored[attr] = values
+	}
+
+	for _, entry := range entries {
+		if !entry.kept {
+			continue
+		}
+		n := entry.node
+		values := make([]string, 0, len(entry.tuple.Predicates))
+		for _, pred := range entry.tuple.Predicates {
+			vals := applyEdges(stored[pred][n.uid], n.edges, x.ParseAttr(pred), n.ns)
+			if len(vals) > 1 {
+				return errors.Errorf("Field %s of type %s on node %#x has %d values, but the "+
+					"fields of @unique(%s) must have a single value", x.ParseAttr(pred),
+					x.ParseAttr(entry.typ), n.uid, len(vals), entry.fields())
+			}
+			if len(vals) == 0 {
+				// Nodes that don't have all the fields of the tuple aren't indexed.
+				values = nil
+				break
+			}
+			values = append(values, vals[0])
+		}
+		if values != nil {
+			entry.value = encodeTuple(values)
+		}
+	}
+	return nil
+}
+
+// checkTuples rejects the new values of the tuples that other nodes already have. A value may
+// move between nodes within the mutation.
+func (c *constraintChecker) checkTuples(entries []*tupleEntry) error {
+	changed := make(map[string]map[uint64]struct{})
+	claimed := make(map[string]map[string]uint64)
+	for _, entry := range entries {
+		if changed[entry.attr] == nil {
+			changed[entry.attr] = make(map[uint64]struct{})
+			claimed[entry.attr] = make(map[string]uint64)
+		}
+		changed[entry.attr][entry.node.uid] = struct{}{}
+		if entry.value == "" {
+			continue
+		}
+		if _, ok := claimed[entry.attr][entry.value]; ok {
+			return duplicateTupleError(entry)
+		}
+		claimed[entry.attr][entry.value] = entry.node.uid
+	}
+
+	for _, entry := range entries {
+		if entry.value == "" {
+			continue
+		}
+		uids, err := c.lookup(entry.attr, entry.value)
+		if err != nil {
+			return err
+		}
+		for _, uid := range uids {
+			if _, ok := changed[entry.attr][uid]; !ok {
+				return duplicateTupleError(entry)
+			}
+		}
+	}
+	return nil
+}
+
+func duplicateTupleError(entry *tupleEntry) error {
+	return errors.Errorf("could not insert duplicate value %s for fields (%s) of type %s",
+		entry.value, entry.fields(), x.ParseAttr(entry.typ))
+}
+
+// lookupValue returns the nodes that have the value for the predicate at readTs, using its index.
+func lookupValue(ctx context.Context, attr, value string, readTs uint64) ([]uint64, error) {
+	res, err := worker.ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    attr,
+		SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{value}},
+		ReadTs:  readTs,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while looking up %s", x.ParseAttr(attr))
+	}
+	if len(res.UidMatrix) == 0 {
+		return nil, nil
+	}
+	return res.UidMatrix[0].GetUids(), nil
+}
+
+// prepareCompositeUnique adds the predicates that index the @unique tuples of the types to the
+// schema, and checks that the existing nodes of the types satisfy the tuples added to them. It
+// returns the added tuples, whose indexes must be built before the types are published with them,
+// and the predicates of the tuples removed from the types.
+func prepareCompositeUnique(ctx context.Context, result *schema.ParsedSchema) (
+	[]*compositeIndex, []string, error) {
+
+	ctx = context.WithValue(ctx, schema.IsWrite, false)
+	preds := make(map[string]*pb.SchemaUpdate, len(result.Preds))
+	for _, update := range result.Preds {
+		preds[update.Predicate] = update
+	}
+
+	var added []*compositeIndex
+	var removed []string
+	for _, typ := range result.Types {
+		current := make(map[string]struct{})
+		for _, tuple := range typ.Unique {
+			idx := &compositeIndex{
+				typ:   typ.TypeName,
+				tuple: tuple,
+				attr:  schema.CompositeUniquePredicate(typ.TypeName, tuple),
+			}
+			for _, pred := range tuple.Predicates {
+				update, ok := preds[pred]
+				if !ok {
+					su, found := schema.State().Get(ctx, pred)
+					if !found {
+						return nil, nil, errors.Errorf("@unique of type %s names %s, which has "+
+							"no schema", x.ParseAttr(typ.TypeName), x.ParseAttr(pred))
+					}
+					update = &su
+				}
+				if update.List {
+					return nil, nil, errors.Errorf("@unique of type %s names %s, which is a list",
+						x.ParseAttr(typ.TypeName), x.ParseAttr(pred))
+				}
+			}
+			current[idx.attr] = struct{}{}
+			if _, ok := preds[idx.attr]; !ok {
+				preds[idx.attr] = schema.CompositeUniqueSchema(idx.attr)
+				result.Preds = append(result.Preds, preds[idx.attr])
+			}
+			if !hasTuple(typ.TypeName, idx.attr) {
+				added = append(added, idx)
+			}
+		}
+
+		old, ok := schema.State().GetType(typ.TypeName)
+		if !ok {
+			continue
+		}
+		for _, tuple := range old.Unique {
+			attr := schema.CompositeUniquePredicate(old.TypeName, tuple)
+			if _, ok := current[attr]; !ok {
+				removed = append(removed, attr)
+			}
+		}
+	}
+
+	// Refuse the tuples that the existing data already violates before changing the schema.
+	if len(added) > 0 {
+		readTs := worker.State.GetTimestamp(true)
+		for _, idx := range added {
+			values, err := indexValues(ctx, idx, readTs)
+			if err != nil {
+				return nil, nil, err
+			}
+			idx.nodes = len(values)
+		}
+	}
+	return added, removed, nil
+}
+
+// hasTuple returns whether the type in the schema already has the @unique tuple indexed by attr.
+func hasTuple(typeName, attr string) bool {
+	typ, ok := schema.State().GetType(typeName)
+	if !ok {
+		return false
+	}
+	for _, tuple := range typ.Unique {
+		if schema.CompositeUniquePredicate(typ.TypeName, tuple) == attr {
+			return true
+		}
+	}
+	return false
+}
+
+// indexValues returns the values of the tuple for the nodes of the type at readTs. It fails if two
+// nodes have the same values.
+func indexValues(ctx context.Context, idx *compositeIndex, readTs uint64) (
+	map[uint64]string, error) {
+
+	ns, name := x.ParseNamespaceAttr(idx.typ)
+	res, err := worker.ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    x.NamespaceAttr(ns, "dgraph.type"),
+		SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{name}},
+		ReadTs:  readTs,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the nodes of type %s", name)
+	}
+	var uids []uint64
+	if len(res.UidMatrix) > 0 {
+		uids = res.UidMatrix[0].GetUids()
+	}
+	if len(uids) == 0 {
+		return nil, nil
+	}
+
+	fields := make([]map[uint64][]string, 0, len(idx.tuple.Predicates))
+	for _, pred := range idx.tuple.Predicates {
+		values, err := fetchValues(ctx, pred, uids, readTs)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, values)
+	}
+
+	tuples := make(map[uint64]string, len(uids))
+	owners := make(map[string]uint64, len(uids))
+	for _, uid := range uids {
+		values := make([]string, 0, len(fields))
+		for _, field := range fields {
+			if len(field[uid]) == 0 {
+				break
+			}
+			values = append(values, field[uid][0])
+		}
+		if len(values) < len(fields) {
+			continue
+		}
+		value := encodeTuple(values)
+		if other, ok := owners[value]; ok {
+			return nil, errors.Errorf("there are duplicates in existing data for fields (%s) of "+
+				"type %s: nodes %#x and %#x have the value %s", idx.fields(), name, other, uid,
+				value)
+		}
+		owners[value] = uid
+		tuples[uid] = value
+	}
+	return tuples, nil
+}
+
+// compositeIndexBatch is the number of edges written per transaction while an index of a @unique
+// tuple is built.
+const compositeIndexBatch = 10000
+
+// withoutTuples returns the types without the added @unique tuples, which are only published once
+// their indexes are built.
+func withoutTuples(types []*pb.TypeUpdate, added []*compositeIndex) []*pb.TypeUpdate {
+	if len(added) == 0 {
+		return types
+	}
+	res := make([]*pb.TypeUpdate, 0, len(types))
+	for _, typ := range types {
+		typ = proto.Clone(typ).(*pb.TypeUpdate)
+		typ.Unique = slices.DeleteFunc(typ.Unique, func(tuple *pb.UniqueTuple) bool {
+			return slices.ContainsFunc(added, func(idx *compositeIndex) bool {
+				return idx.typ == typ.TypeName && idx.attr == schema.CompositeUniquePredicate(
+					typ.TypeName, tuple)
+			})
+		})
+		res = append(res, typ)
+	}
+	return res
+}
+
+// updateCompositeIndexes drops the indexes of the @unique tuples removed from the types, and
+// builds the indexes of the added ones before publishing the types with them. The schema must
+// have been applied with the types returned by withoutTuples, so that the mutations don't check
+// the added tuples against incomplete indexes.
+//
+// The indexes are built in batches, then checked again at a later timestamp for the values
+// changed meanwhile, before the tuples are published. As the mutations committed between that
+// check and the publication didn't update the indexes, they're checked once more after it. If
+// the values turn out to be duplicated, the tuples are withdrawn and their indexes dropped.
+func updateCompositeIndexes(ctx context.Context, types []*pb.TypeUpdate,
+	added []*compositeIndex, removed []string) error {
+
+	for _, attr := range removed {
+		glog.Infof("Dropping the index of a @unique tuple: %s", attr)
+		if err := dropCompositeIndex(ctx, attr); err != nil {
+			return err
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	publish := func(types []*pb.TypeUpdate) error {
+		_, err := query.ApplyMutations(ctx, &pb.Mutations{
+			StartTs: worker.State.GetTimestamp(false),
+			Types:   types,
+		})
+		return err
+	}
+	// fail withdraws the added tuples if they were published, and drops their indexes.
+	fail := func(err error, published bool) error {
+		if published {
+			if pubErr := publish(withoutTuples(types, added)); pubErr != nil {
+				glog.Errorf("Unable to withdraw the added @unique tuples: %v", pubErr)
+				return err
+			}
+		}
+		for _, idx := range added {
+			if dropErr := dropCompositeIndex(ctx, idx.attr); dropErr != nil {
+				glog.Errorf("Unable to drop the index %s: %v", idx.attr, dropErr)
+			}
+		}
+		return err
+	}
+	syncAll := func() error {
+		for _, idx := range added {
+			if err := syncCompositeIndex(ctx, idx); err != nil {
+				return errors.Wrapf(err, "while building the index of @unique(%s) of type %s",
+					idx.fields(), x.ParseAttr(idx.typ))
+			}
+		}
+		return nil
+	}
+
+	for _, idx := range added {
+		glog.Infof("Building the index of @unique(%s) of type %s", idx.fields(),
+			x.ParseAttr(idx.typ))
+	}
+	// The second pass indexes the values changed while the first one ran.
+	for range 2 {
+		if err := syncAll(); err != nil {
+			return fail(err, false)
+		}
+	}
+	if err := publish(types); err != nil {
+		return fail(err, false)
+	}
+	if err := syncAll(); err != nil {
+		return fail(err, true)
+	}
+	return nil
+}
+
+// dropCompositeIndex deletes the values of the predicate that indexes a @unique tuple.
+func dropCompositeIndex(ctx context.Context, attr string) error {
+	edge := &pb.DirectedEdge{
+		Attr:      x.ParseAttr(attr),
+		Namespace: x.ParseNamespace(attr),
+		Value:     []byte(x.Star),
+		Op:        pb.DirectedEdge_DEL,
+	}
+	m := &pb.Mutations{StartTs: worker.State.GetTimestamp(false), Edges: []*pb.DirectedEdge{edge}}
+	if _, err := query.ApplyMutations(ctx, m); err != nil {
+		return err
+	}
+	return InsertDropRecord(ctx, "DROP_ATTR;"+attr)
+}
+
+// syncCompositeIndex makes the index of the tuple match the values of the nodes of the type at a
+// new timestamp, writing compositeIndexBatch edges per transaction. It fails if two nodes have
+// the same values.
+func syncCompositeIndex(ctx context.Context, idx *compositeIndex) error {
+	readTs := worker.State.GetTimestamp(true)
+	tuples, err := indexValues(ctx, idx, readTs)
+	if err != nil {
+		return err
+	}
+	indexed, err := indexedTuples(ctx, idx.attr, readTs)
+	if err != nil {
+		return err
+	}
+
+	ns, attr := x.ParseNamespaceAttr(idx.attr)
+	var edges []*pb.DirectedEdge
+	for uid, value := range tuples {
+		if vals := indexed[uid]; len(vals) == 1 && vals[0] == value {
+			continue
+		}
+		edges = append(edges, &pb.DirectedEdge{
+			Entity:    uid,
+			Attr:      attr,
+			Namespace: ns,
+			Value:     []byte(value),
+			ValueType: pb.Posting_STRING,
+			Op:        pb.DirectedEdge_SET,
+		})
+	}
+	for uid := range indexed {
+		if _, ok := tuples[uid]; !ok {
+			edges = append(edges, &pb.DirectedEdge{
+				Entity:    uid,
+				Attr:      attr,
+				Namespace: ns,
+				Value:     []byte(x.Star),
+				Op:        pb.DirectedEdge_DEL,
+			})
+		}
+	}
+	for len(edges) > 0 {
+		n := min(len(edges), compositeIndexBatch)
+		if err := commitIndexEdges(ctx, edges[:n]); err != nil {
+			return err
+		}
+		edges = edges[n:]
+	}
+	return nil
+}
+
+// indexedTuples returns the values of the index of a @unique tuple at readTs.
+func indexedTuples(ctx context.Context, attr string, readTs uint64) (map[uint64][]string, error) {
+	res, err := worker.ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    attr,
+		SrcFunc: &pb.SrcFunction{Name: "has"},
+		ReadTs:  readTs,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the index %s", x.ParseAttr(attr))
+	}
+	if len(res.UidMatrix) == 0 || len(res.UidMatrix[0].GetUids()) == 0 {
+		return nil, nil
+	}
+	return fetchValues(ctx, attr, res.UidMatrix[0].GetUids(), readTs)
+}
+
+// commitIndexEdges writes the edges of an index in a transaction of its own.
+func commitIndexEdges(ctx context.Context, edges []*pb.DirectedEdge) error {
+	startTs := worker.State.GetTimestamp(false)
+	txn, err := query.ApplyMutations(ctx, &pb.Mutations{Edges: edges, StartTs: startTs})
+	if err != nil {
+		if txn == nil {
+			txn = &api.TxnContext{StartTs: startTs}
+		}
+		txn.Aborted = true
+		_, _ = worker.CommitOverNetwork(ctx, txn)
+		return err
+	}
+	_, err = worker.CommitOverNetwork(ctx, txn)
+	return err
+}
@@ -0,0 +1,114 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+func TestIndexTuples(t *testing.T) {
+	const s = `
+		tenant: string .
+		email: string .
+		age: int .
+		type User @unique(tenant, email) {
+			tenant
+			email
+			age
+		}
+	`
+	require.NoError(t, schema.ParseBytes([]byte(s), 1))
+	result, err := schema.Parse(s)
+	require.NoError(t, err)
+	for _, typ := range result.Types {
+		schema.State().SetType(typ.TypeName, typ)
+	}
+	const index = "dgraph.unique.User.tenant.email"
+
+	// Node 0x1 is a stored User of acme with a@acme.com, and node 0x2 is a stored User of acme
+	// without an email.
+	stored := map[string]map[uint64][]string{
+		x.AttrInRootNamespace("dgraph.type"): {1: {"User"}, 2: {"User"}},
+		x.AttrInRootNamespace("tenant"):      {1: {"acme"}, 2: {"acme"}},
+		x.AttrInRootNamespace("email"):       {1: {"a@acme.com"}},
+	}
+	indexed := map[string][]uint64{encodeTuple([]string{"acme", "a@acme.com"}): {1}}
+	check := func(edges ...*pb.DirectedEdge) ([]*pb.DirectedEdge, error) {
+		c := &constraintChecker{
+			fetch: func(attr string, uids []uint64) (map[uint64][]string, error) {
+				return stored[attr], nil
+			},
+			lookup: func(attr, value string) ([]uint64, error) {
+				require.Equal(t, x.AttrInRootNamespace(index), attr)
+				return indexed[value], nil
+			},
+		}
+		return c.validate(edges, x.RootNamespace, false)
+	}
+	set := func(uid uint64, attr, val string) *pb.DirectedEdge {
+		return &pb.DirectedEdge{Entity: uid, Attr: attr, Value: []byte(val)}
+	}
+	del := func(uid uint64, attr, val string) *pb.DirectedEdge {
+		e := set(uid, attr, val)
+		e.Op = pb.DirectedEdge_DEL
+		return e
+	}
+	indexEdge := func(uid uint64, tuple ...string) *pb.DirectedEdge {
+		if len(tuple) == 0 {
+			return &pb.DirectedEdge{Entity: uid, Attr: index, Value: []byte(x.Star),
+				Op: pb.DirectedEdge_DEL}
+		}
+		return &pb.DirectedEdge{Entity: uid, Attr: index, Value: []byte(encodeTuple(tuple)),
+			ValueType: pb.Posting_STRING}
+	}
+
+	// Edges that don't change the tuple leave the index alone.
+	edges, err := check(set(1, "age", "30"))
+	require.NoError(t, err)
+	require.Empty(t, edges)
+
+	edges, err = check(set(2, "email", "b@acme.com"))
+	require.NoError(t, err)
+	require.Equal(t, []*pb.DirectedEdge{indexEdge(2, "acme", "b@acme.com")}, edges)
+
+	edges, err = check(set(3, "dgraph.type", "User"), set(3, "tenant", "globex"),
+		set(3, "email", "a@acme.com"))
+	require.NoError(t, err)
+	require.Equal(t, []*pb.DirectedEdge{indexEdge(3, "globex", "a@acme.com")}, edges)
+
+	_, err = check(set(2, "email", "a@acme.com"))
+	require.ErrorContains(t, err, `could not insert duplicate value ["acme","a@acme.com"] `+
+		`for fields (tenant, email) of type User`)
+	_, err = check(set(3, "dgraph.type", "User"), set(3, "tenant", "acme"),
+		set(3, "email", "a@acme.com"))
+	require.ErrorContains(t, err, "could not insert duplicate value")
+	_, err = check(set(2, "email", "c@acme.com"), set(3, "dgraph.type", "User"),
+		set(3, "tenant", "acme"), set(3, "email", "c@acme.com"))
+	require.ErrorContains(t, err, "could not insert duplicate value")
+
+	// The value can move to another node within the mutation.
+	edges, err = check(del(1, "email", "a@acme.com"), set(2, "email", "a@acme.com"))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*pb.DirectedEdge{indexEdge(1),
+		indexEdge(2, "acme", "a@acme.com")}, edges)
+
+	// Nodes leave the index when they lose a field of the tuple or the type.
+	edges, err = check(del(1, "tenant", x.Star))
+	require.NoError(t, err)
+	require.Equal(t, []*pb.DirectedEdge{indexEdge(1)}, edges)
+	edges, err = check(del(1, "dgraph.type", "User"))
+	require.NoError(t, err)
+	require.Equal(t, []*pb.DirectedEdge{indexEdge(1)}, edges)
+	edges, err = check(del(1, x.Star, x.Star))
+	require.NoError(t, err)
+	require.Equal(t, []*pb.DirectedEdge{indexEdge(1)}, edges)
+}
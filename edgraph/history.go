@@ -0,0 +1,55 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dgraph-io/dgraph/v25/worker"
+)
+
+// parseAsOf converts the as_of parameter, which is either a timestamp or an RFC3339 time, into
+// the timestamp the query should be run at.
+func parseAsOf(asOf string) (uint64, error) {
+	if ts, err := strconv.ParseUint(asOf, 10, 64); err == nil {
+		return ts, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, asOf)
+	if err != nil {
+		return 0, errors.Errorf("invalid value %q for as_of, expected a timestamp or an "+
+			"RFC3339 time", asOf)
+	}
+	return worker.HistoryTsAt(t)
+}
+
+// applyAsOf turns the request into a read-only query running at the point in time given by
+// asOf, provided all the queried predicates still have history for it.
+func applyAsOf(qc *queryContext, asOf string) error {
+	if len(qc.req.Mutations) > 0 {
+		return errors.Errorf("as_of can only be used with read-only queries")
+	}
+	if qc.req.StartTs != 0 {
+		return errors.Errorf("as_of can't be used along with startTs")
+	}
+	ts, err := parseAsOf(asOf)
+	if err != nil {
+		return err
+	}
+	if err := worker.ValidateAsOf(ts, parsePredsFromQuery(qc.dqlRes.Query).preds); err != nil {
+		return err
+	}
+
+	qc.span.AddEvent("as_of", trace.WithAttributes(attribute.Int64("ts", int64(ts))))
+	qc.req.StartTs = ts
+	qc.req.ReadOnly = true
+	qc.req.BestEffort = false
+	return nil
+}
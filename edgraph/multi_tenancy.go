@@ -138,5 +138,11 @@ func (s *Server) DeleteNamespace(ctx context.Context, namespace uint64) error {
 	if _, ok := schema.State().Namespaces()[namespace]; !ok {
 		return errors.Errorf("error deleting non-existing namespace %#x", namespace)
 	}
-	return worker.ProcessDeleteNsRequest(ctx, namespace)
+	if err := worker.ProcessDeleteNsRequest(ctx, namespace); err != nil {
+		return err
+	}
+	if err := deleteNamespaceQuota(ctx, namespace); err != nil {
+		glog.Errorf("Unable to delete the quota of namespace %#x: %v", namespace, err)
+	}
+	return nil
 }
@@ -0,0 +1,359 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/worker"
+	"github.com/dgraph-io/dgraph/v25/x"
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+const quotaQuery = `{
+	q(func: type(dgraph.quota)) {
+		uid
+		dgraph.quota.namespace
+		dgraph.quota.limits
+	}
+}`
+
+var (
+	errQuotasDisabled = errors.New("namespace quotas are disabled. " +
+		`Enable them via --limit "namespace-quotas=true;"`)
+
+	quotaPrefixes = [][]byte{
+		x.PredicatePrefix(x.AttrInRootNamespace("dgraph.quota.namespace")),
+		x.PredicatePrefix(x.AttrInRootNamespace("dgraph.quota.limits")),
+	}
+)
+
+// NamespaceQuota holds the limits applied to the requests of a namespace. A zero value means
+// that there is no limit, other than the ones set via the --limit flag.
+type NamespaceQuota struct {
+	// StorageBytes is the on-disk size after which mutations are rejected.
+	StorageBytes int64 `json:"storage_bytes,omitempty"`
+	// QueriesPerSec is the number of queries accepted per second.
+	QueriesPerSec int64 `json:"queries_per_sec,omitempty"`
+	// MutationsPerSec is the number of mutation requests accepted per second.
+	MutationsPerSec int64 `json:"mutations_per_sec,omitempty"`
+	// QueryEdgeLimit is the maximum number of edges a query can traverse. It only applies if it's
+	// lower than the query-edge limit of the Alpha.
+	QueryEdgeLimit uint64 `json:"query_edge_limit,omitempty"`
+	// QueryTimeout is the time after which a query fails. It only applies if it's lower than the
+	// query-timeout of the Alpha.
+	QueryTimeout time.Duration `json:"query_timeout,omitempty"`
+}
+
+type quotaNode struct {
+	Uid       string `json:"uid,omitempty"`
+	Namespace uint64 `json:"dgraph.quota.namespace"`
+	Limits    string `json:"dgraph.quota.limits"`
+}
+
+// tokenBucket allows rate requests per second on average, with bursts of up to rate requests.
+type tokenBucket struct {
+	sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate int64) *tokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+	return &tokenBucket{rate: float64(rate), tokens: float64(rate), last: time.Now()}
+}
+
+func (b *tokenBucket) allow(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+	b.Lock()
+	defer b.Unlock()
+	b.tokens = min(b.rate, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+type namespaceLimits struct {
+	quota     NamespaceQuota
+	queries   *tokenBucket
+	mutations *tokenBucket
+}
+
+// quotaCache holds the quotas of all the namespaces, along with the state of their rate limits.
+type quotaCache struct {
+	sync.RWMutex
+	limits map[uint64]*namespaceLimits
+}
+
+var quotas = &quotaCache{limits: make(map[uint64]*namespaceLimits)}
+
+// update replaces the quotas. The state of the rate limits is kept if the rates didn't change.
+func (c *quotaCache) update(all map[uint64]NamespaceQuota) {
+	c.Lock()
+	defer c.Unlock()
+	limits := make(map[uint64]*namespaceLimits, len(all))
+	for ns, q := range all {
+		l := &namespaceLimits{quota: q}
+		old, ok := c.limits[ns]
+		if ok && old.quota.QueriesPerSec == q.QueriesPerSec {
+			l.queries = old.queries
+		} else {
+			l.queries = newTokenBucket(q.QueriesPerSec)
+		}
+		if ok && old.quota.MutationsPerSec == q.MutationsPerSec {
+			l.mutations = old.mutations
+		} else {
+			l.mutations = newTokenBucket(q.MutationsPerSec)
+		}
+		limits[ns] = l
+	}
+	c.limits = limits
+}
+
+func (c *quotaCache) get(ns uint64) *namespaceLimits {
+	c.RLock()
+	defer c.RUnlock()
+	return c.limits[ns]
+}
+
+func quotaCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, IsGraphql, true)
+	return x.AttachNamespace(ctx, x.RootNamespace)
+}
+
+func queryQuotas(ctx context.Context, readTs uint64) ([]*quotaNode, error) {
+	resp, err := (&Server{}).doQuery(quotaCtx(ctx), &Request{
+		req:    &api.Request{Query: quotaQuery, ReadOnly: true, StartTs: readTs},
+		doAuth: NoAuthorize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Q []*quotaNode `json:"q"`
+	}
+	if len(resp.GetJson()) > 0 {
+		if err := json.Unmarshal(resp.GetJson(), &res); err != nil {
+			return nil, errors.Wrap(err, "while unmarshalling namespace quotas")
+		}
+	}
+	return res.Q, nil
+}
+
+func refreshQuotas(ctx context.Context, readTs uint64) error {
+	nodes, err := queryQuotas(ctx, readTs)
+	if err != nil {
+		return errors.Wrap(err, "unable to retrieve namespace quotas")
+	}
+	all := make(map[uint64]NamespaceQuota, len(nodes))
+	for _, n := range nodes {
+		var q NamespaceQuota
+		if err := json.Unmarshal([]byte(n.Limits), &q); err != nil {
+			glog.Errorf("Invalid quota for namespace %#x: %v", n.Namespace, err)
+			continue
+		}
+		all[n.Namespace] = q
+	}
+	quotas.update(all)
+	glog.V(2).Infof("Updated the quotas of %d namespaces", len(all))
+	return nil
+}
+
+// RefreshQuotas loads the namespace quotas into memory.
+func RefreshQuotas(ctx context.Context) {
+	if !x.Config.NamespaceQuotas {
+		return
+	}
+	if err := refreshQuotas(ctx, 0); err != nil {
+		glog.Errorf("Error while retrieving namespace quotas: %v", err)
+	}
+}
+
+// SubscribeForQuotaUpdates subscribes for the quota predicates and reloads the quotas whenever
+// they change.
+func SubscribeForQuotaUpdates(closer *z.Closer) {
+	defer func() {
+		glog.Infoln("RefreshQuotas closed")
+		closer.Done()
+	}()
+	if !x.Config.NamespaceQuotas {
+		return
+	}
+
+	var maxRefreshTs uint64
+	closer.AddRunning(1)
+	go worker.SubscribeForUpdates(quotaPrefixes, x.IgnoreBytes, func(kvs *bpb.KVList) {
+		if kvs == nil || len(kvs.Kv) == 0 {
+			return
+		}
+		kv := x.KvWithMaxVersion(kvs, quotaPrefixes)
+		if kv.GetVersion() <= maxRefreshTs {
+			return
+		}
+		maxRefreshTs = kv.GetVersion()
+		if err := refreshQuotas(closer.Ctx(), maxRefreshTs); err != nil {
+			glog.Errorf("Error while retrieving namespace quotas: %v", err)
+		}
+	}, 1, closer)
+
+	<-closer.HasBeenClosed()
+}
+
+// GetNamespaceQuota returns the quota of the namespace, or nil if none was set.
+func GetNamespaceQuota(ctx context.Context, ns uint64) (*NamespaceQuota, error) {
+	if !x.Config.NamespaceQuotas {
+		return nil, errQuotasDisabled
+	}
+	nodes, err := queryQuotas(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range nodes {
+		if n.Namespace != ns {
+			continue
+		}
+		var q NamespaceQuota
+		if err := json.Unmarshal([]byte(n.Limits), &q); err != nil {
+			return nil, errors.Wrapf(err, "invalid quota for namespace %#x", ns)
+		}
+		return &q, nil
+	}
+	return nil, nil
+}
+
+// SetNamespaceQuota sets the quota of the namespace, replacing any previous one. The quota is
+// applied by all the Alphas as soon as they see the update.
+func SetNamespaceQuota(ctx context.Context, ns uint64, q NamespaceQuota) error {
+	if !x.Config.NamespaceQuotas {
+		return errQuotasDisabled
+	}
+	if _, ok := schema.State().Namespaces()[ns]; !ok {
+		return errors.Errorf("namespace %#x doesn't exist", ns)
+	}
+	if q.StorageBytes < 0 || q.QueriesPerSec < 0 || q.MutationsPerSec < 0 || q.QueryTimeout < 0 {
+		return errors.Errorf("quota for namespace %#x can't be negative", ns)
+	}
+	limits, err := json.Marshal(q)
+	if err != nil {
+		return err
+	}
+
+	nsVal := &api.Value{Val: &api.Value_IntVal{IntVal: int64(ns)}}
+	_, err = (&Server{}).doQuery(quotaCtx(ctx), &Request{
+		req: &api.Request{
+			Query: `query q($ns: int) { q(func: eq(dgraph.quota.namespace, $ns)) { v as uid } }`,
+			Vars:  map[string]string{"$ns": strconv.FormatUint(ns, 10)},
+			Mutations: []*api.Mutation{{
+				Set: []*api.NQuad{
+					{Subject: "uid(v)", Predicate: "dgraph.type",
+						ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "dgraph.quota"}}},
+					{Subject: "uid(v)", Predicate: "dgraph.quota.namespace", ObjectValue: nsVal},
+					{Subject: "uid(v)", Predicate: "dgraph.quota.limits",
+						ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: string(limits)}}},
+				},
+			}},
+			CommitNow: true,
+		},
+		doAuth: NoAuthorize,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "while setting quota for namespace %#x", ns)
+	}
+	glog.Infof("Set quota for namespace %#x: %s", ns, limits)
+	return nil
+}
+
+// deleteNamespaceQuota removes the quota of a deleted namespace.
+func deleteNamespaceQuota(ctx context.Context, ns uint64) error {
+	if !x.Config.NamespaceQuotas {
+		return nil
+	}
+	_, err := (&Server{}).doQuery(quotaCtx(ctx), &Request{
+		req: &api.Request{
+			Query: `query q($ns: int) { q(func: eq(dgraph.quota.namespace, $ns)) { v as uid } }`,
+			Vars:  map[string]string{"$ns": strconv.FormatUint(ns, 10)},
+			Mutations: []*api.Mutation{{
+				Del: []*api.NQuad{{Subject: "uid(v)", Predicate: x.Star,
+					ObjectValue: &api.Value{Val: &api.Value_DefaultVal{DefaultVal: x.Star}}}},
+				Cond: "@if(gt(len(v), 0))",
+			}},
+			CommitNow: true,
+		},
+		doAuth: NoAuthorize,
+	})
+	return err
+}
+
+// applyQuota enforces the quota of the namespace of the request. It returns the context in which
+// the request must be run, along with the function to release it.
+func applyQuota(ctx context.Context, isQuery, isMutation bool) (
+	context.Context, context.CancelFunc, error) {
+	noop := func() {}
+	if !x.Config.NamespaceQuotas {
+		return ctx, noop, nil
+	}
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return ctx, noop, err
+	}
+	l := quotas.get(ns)
+	if l == nil {
+		return ctx, noop, nil
+	}
+
+	now := time.Now()
+	if isQuery && !l.queries.allow(now) {
+		return ctx, noop, status.Errorf(codes.ResourceExhausted,
+			"query rate limit of %d per second exceeded for namespace %#x",
+			l.quota.QueriesPerSec, ns)
+	}
+	if isMutation {
+		if !l.mutations.allow(now) {
+			return ctx, noop, status.Errorf(codes.ResourceExhausted,
+				"mutation rate limit of %d per second exceeded for namespace %#x",
+				l.quota.MutationsPerSec, ns)
+		}
+		if l.quota.StorageBytes > 0 {
+			if used := worker.NamespaceDiskUsage(ns); used >= l.quota.StorageBytes {
+				return ctx, noop, status.Errorf(codes.ResourceExhausted,
+					"storage quota of %d bytes exceeded for namespace %#x, using %d bytes",
+					l.quota.StorageBytes, ns, used)
+			}
+		}
+	}
+
+	if l.quota.QueryEdgeLimit > 0 {
+		ctx = x.WithQueryEdgeLimit(ctx, l.quota.QueryEdgeLimit)
+	}
+	// Like the query-timeout of the Alpha, the timeout doesn't apply to mutations.
+	if timeout := l.quota.QueryTimeout; timeout > 0 && !isMutation {
+		if d, ok := ctx.Deadline(); !ok || time.Until(d) > timeout {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			return ctx, cancel, nil
+		}
+	}
+	return ctx, noop, nil
+}
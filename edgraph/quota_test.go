@@ -0,0 +1,97 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+func TestTokenBucket(t *testing.T) {
+	var unlimited *tokenBucket
+	require.True(t, unlimited.allow(time.Now()))
+	require.Nil(t, newTokenBucket(0))
+
+	now := time.Now()
+	b := newTokenBucket(2)
+	b.last = now
+	require.True(t, b.allow(now))
+	require.True(t, b.allow(now))
+	require.False(t, b.allow(now))
+	// Half a second later, one more request is allowed.
+	require.True(t, b.allow(now.Add(500*time.Millisecond)))
+	require.False(t, b.allow(now.Add(500*time.Millisecond)))
+	// Tokens don't accumulate beyond the rate.
+	later := now.Add(time.Minute)
+	require.True(t, b.allow(later))
+	require.True(t, b.allow(later))
+	require.False(t, b.allow(later))
+}
+
+func TestQuotaCacheUpdate(t *testing.T) {
+	c := &quotaCache{limits: make(map[uint64]*namespaceLimits)}
+	c.update(map[uint64]NamespaceQuota{1: {QueriesPerSec: 5, MutationsPerSec: 1}})
+	queries := c.get(1).queries
+	mutations := c.get(1).mutations
+	require.NotNil(t, queries)
+
+	// The rate limits are kept when they don't change.
+	c.update(map[uint64]NamespaceQuota{1: {QueriesPerSec: 5, MutationsPerSec: 2}})
+	require.Same(t, queries, c.get(1).queries)
+	require.NotSame(t, mutations, c.get(1).mutations)
+
+	c.update(nil)
+	require.Nil(t, c.get(1))
+}
+
+func TestApplyQuota(t *testing.T) {
+	defer func(enabled bool, edges uint64) {
+		x.Config.NamespaceQuotas = enabled
+		x.Config.LimitQueryEdge = edges
+		quotas.update(nil)
+	}(x.Config.NamespaceQuotas, x.Config.LimitQueryEdge)
+	x.Config.NamespaceQuotas = true
+	x.Config.LimitQueryEdge = 1000
+
+	quotas.update(map[uint64]NamespaceQuota{
+		1: {QueriesPerSec: 1, QueryEdgeLimit: 10, QueryTimeout: time.Minute},
+	})
+
+	// Namespaces without quota aren't limited.
+	ctx := x.AttachNamespace(context.Background(), 2)
+	for range 5 {
+		qctx, release, err := applyQuota(ctx, true, false)
+		require.NoError(t, err)
+		release()
+		require.Equal(t, uint64(1000), x.QueryEdgeLimit(qctx))
+	}
+
+	ctx = x.AttachNamespace(context.Background(), 1)
+	qctx, release, err := applyQuota(ctx, true, false)
+	require.NoError(t, err)
+	defer release()
+	require.Equal(t, uint64(10), x.QueryEdgeLimit(qctx))
+	deadline, ok := qctx.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+
+	_, _, err = applyQuota(ctx, true, false)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Mutations aren't counted against the query rate.
+	mctx, release, err := applyQuota(ctx, false, true)
+	require.NoError(t, err)
+	defer release()
+	_, ok = mctx.Deadline()
+	require.False(t, ok)
+}
@@ -0,0 +1,87 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/worker"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// Kinds of running requests.
+const (
+	requestKindQuery    = "query"
+	requestKindMutation = "mutation"
+	requestKindGraphQL  = "graphql"
+)
+
+// queryHash identifies the query of a running request without exposing its text, which may
+// contain sensitive values.
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:8])
+}
+
+// trackRequest registers the request in the registry of running requests. The returned context
+// is cancelled if the request is cancelled through the admin API.
+func trackRequest(ctx context.Context, req *Request, isGraphQL bool) (
+	context.Context, *worker.RequestHandle) {
+	info := &pb.RunningRequest{
+		QueryHash: queryHash(req.req.Query),
+		Stage:     worker.StageParsing,
+		Kind:      requestKindQuery,
+	}
+	switch {
+	case isGraphQL:
+		info.Kind = requestKindGraphQL
+	case len(req.req.Mutations) > 0:
+		info.Kind = requestKindMutation
+	}
+	if ns, err := x.ExtractNamespace(ctx); err == nil {
+		info.Namespace = ns
+	}
+	if x.WorkerConfig.AclEnabled {
+		if user, err := extractUserAndGroups(ctx); err == nil {
+			info.User = user.userId
+		}
+	}
+	return worker.RunningRequests.Start(ctx, info)
+}
+
+// runningRequestsScope returns the namespace whose requests the caller may see and cancel. The
+// guardians of the galaxy can see the requests of all the namespaces.
+func runningRequestsScope(ctx context.Context) (uint64, bool, error) {
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	return ns, ns == x.RootNamespace && AuthSuperAdmin(ctx) == nil, nil
+}
+
+// ListRunningRequests returns the requests running across the cluster that the caller is
+// allowed to see.
+func ListRunningRequests(ctx context.Context) ([]*pb.RunningRequest, error) {
+	ns, all, err := runningRequestsScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return worker.RunningRequestsOverNetwork(ctx,
+		&pb.RunningRequestsRequest{Namespace: ns, AllNamespaces: all})
+}
+
+// CancelRunningRequest cancels the running request with the given ID.
+func CancelRunningRequest(ctx context.Context, id uint64) error {
+	ns, all, err := runningRequestsScope(ctx)
+	if err != nil {
+		return err
+	}
+	return worker.CancelRequestOverNetwork(ctx,
+		&pb.CancelRequestRequest{Id: id, Namespace: ns, AllNamespaces: all})
+}
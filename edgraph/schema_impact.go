@@ -0,0 +1,253 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	gqlSchema "github.com/dgraph-io/dgraph/v25/graphql/schema"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/worker"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// predicateImpact is the impact of a dry-run alter on a predicate.
+type predicateImpact struct {
+	Predicate          string   `json:"predicate"`
+	NewPredicate       bool     `json:"newPredicate,omitempty"`
+	OldType            string   `json:"oldType,omitempty"`
+	NewType            string   `json:"newType"`
+	IndexesBuilt       []string `json:"indexesBuilt,omitempty"`
+	IndexesDropped     []string `json:"indexesDropped,omitempty"`
+	DataKeys           uint64   `json:"dataKeys"`
+	Values             uint64   `json:"values"`
+	ConversionFailures uint64   `json:"conversionFailures"`
+	KeysToWrite        uint64   `json:"keysToWrite"`
+	KeysToDrop         uint64   `json:"keysToDrop"`
+	Error              string   `json:"error,omitempty"`
+}
+
+// graphQLConflict is a predicate whose new schema doesn't satisfy the GraphQL schema.
+type graphQLConflict struct {
+	Predicate string `json:"predicate"`
+	Reason    string `json:"reason"`
+}
+
+// uniqueImpact is a @unique tuple of fields added to or removed from a type.
+type uniqueImpact struct {
+	Fields []string `json:"fields"`
+	// Nodes is the number of stored nodes whose tuple is indexed, for an added tuple.
+	Nodes int `json:"nodes,omitempty"`
+}
+
+// typeImpact is the change of the constraints of a type by a dry-run alter.
+type typeImpact struct {
+	Type               string          `json:"type"`
+	NewType            bool            `json:"newType,omitempty"`
+	Closed             bool            `json:"closed"`
+	WasClosed          bool            `json:"wasClosed"`
+	ConstraintsChanged []string        `json:"constraintsChanged,omitempty"`
+	UniqueAdded        []*uniqueImpact `json:"uniqueAdded,omitempty"`
+	UniqueRemoved      []*uniqueImpact `json:"uniqueRemoved,omitempty"`
+	TTLPredicate       string          `json:"ttlPredicate,omitempty"`
+	OldTTLPredicate    string          `json:"oldTTLPredicate,omitempty"`
+}
+
+type schemaImpactReport struct {
+	DryRun           bool               `json:"dryRun"`
+	Predicates       []*predicateImpact `json:"predicates"`
+	Types            []*typeImpact      `json:"types"`
+	GraphQLConflicts []*graphQLConflict `json:"graphqlConflicts"`
+}
+
+// alterDryRun reports what applying the parsed schema would do, without applying it: the indexes
+// built and dropped, the type changes and the values that don't convert to the new type, the
+// estimated number of keys rewritten, the changed constraints of the types and whether the change
+// breaks the GraphQL schema. The schema has been prepared like for the alter, so the predicates
+// of the indexes of the @unique tuples are reported too, and the tuples that the stored nodes
+// violate have failed the dry run.
+func alterDryRun(ctx context.Context, namespace uint64, result *schema.ParsedSchema,
+	added []*compositeIndex, removed []string) (*api.Payload, error) {
+	impacts, err := worker.SchemaImpactOverNetwork(ctx, result.Preds, 0)
+	if err != nil {
+		return nil, err
+	}
+	report := &schemaImpactReport{
+		DryRun:           true,
+		Predicates:       make([]*predicateImpact, 0, len(impacts)),
+		Types:            typeImpacts(result.Types, added, removed),
+		GraphQLConflicts: []*graphQLConflict{},
+	}
+	for _, im := range impacts {
+		report.Predicates = append(report.Predicates, &predicateImpact{
+			Predicate:          x.ParseAttr(im.Predicate),
+			NewPredicate:       im.NewPredicate,
+			OldType:            im.OldType,
+			NewType:            im.NewType,
+			IndexesBuilt:       im.IndexesBuilt,
+			IndexesDropped:     im.IndexesDropped,
+			DataKeys:           im.DataKeys,
+			Values:             im.Values,
+			ConversionFailures: im.ConversionFailures,
+			KeysToWrite:        im.KeysToWrite,
+			KeysToDrop:         im.KeysToDrop,
+			Error:              im.Error,
+		})
+	}
+
+	conflicts, err := graphQLConflicts(namespace, result.Preds)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while checking the GraphQL schema")
+	}
+	report.GraphQLConflicts = append(report.GraphQLConflicts, conflicts...)
+
+	glog.Infof("Dry run of the schema change of %d predicates and %d types found %d GraphQL "+
+		"conflicts", len(report.Predicates), len(report.Types), len(report.GraphQLConflicts))
+	js, err := json.Marshal(report)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Payload{Data: js}, nil
+}
+
+// typeImpacts returns the changes of the constraints of the updated types, given the @unique
+// tuples that prepareCompositeUnique found added and removed.
+func typeImpacts(updates []*pb.TypeUpdate, added []*compositeIndex,
+	removed []string) []*typeImpact {
+	fields := func(tuple *pb.UniqueTuple) []string {
+		preds := make([]string, 0, len(tuple.Predicates))
+		for _, pred := range tuple.Predicates {
+			preds = append(preds, x.ParseAttr(pred))
+		}
+		return preds
+	}
+
+	res := []*typeImpact{}
+	for _, typ := range updates {
+		old, ok := schema.State().GetType(typ.TypeName)
+		im := &typeImpact{
+			Type:      x.ParseAttr(typ.TypeName),
+			NewType:   !ok,
+			Closed:    typ.Closed,
+			WasClosed: old.Closed,
+		}
+		if typ.TtlPredicate != "" {
+			im.TTLPredicate = x.ParseAttr(typ.TtlPredicate)
+		}
+		if old.TtlPredicate != "" {
+			im.OldTTLPredicate = x.ParseAttr(old.TtlPredicate)
+		}
+
+		constraints := make(map[string]*pb.FieldConstraint, len(old.Fields))
+		for _, field := range old.Fields {
+			constraints[field.Predicate] = field.Constraint
+		}
+		for _, field := range typ.Fields {
+			if !proto.Equal(constraints[field.Predicate], field.Constraint) {
+				im.ConstraintsChanged = append(im.ConstraintsChanged, x.ParseAttr(field.Predicate))
+			}
+			delete(constraints, field.Predicate)
+		}
+		for pred, c := range constraints {
+			if c != nil {
+				im.ConstraintsChanged = append(im.ConstraintsChanged, x.ParseAttr(pred))
+			}
+		}
+		sort.Strings(im.ConstraintsChanged)
+
+		for _, idx := range added {
+			if idx.typ == typ.TypeName {
+				im.UniqueAdded = append(im.UniqueAdded,
+					&uniqueImpact{Fields: fields(idx.tuple), Nodes: idx.nodes})
+			}
+		}
+		for _, tuple := range old.Unique {
+			if slices.Contains(removed, schema.CompositeUniquePredicate(old.TypeName, tuple)) {
+				im.UniqueRemoved = append(im.UniqueRemoved, &uniqueImpact{Fields: fields(tuple)})
+			}
+		}
+
+		if im.Closed == im.WasClosed && im.TTLPredicate == im.OldTTLPredicate &&
+			len(im.ConstraintsChanged) == 0 && len(im.UniqueAdded) == 0 &&
+			len(im.UniqueRemoved) == 0 {
+			continue
+		}
+		res = append(res, im)
+	}
+	return res
+}
+
+// graphQLConflicts returns the updated predicates whose type, list-ness or indexes no longer
+// match what the GraphQL schema of the namespace needs.
+func graphQLConflicts(namespace uint64, updates []*pb.SchemaUpdate) ([]*graphQLConflict, error) {
+	_, gql, err := GetGQLSchema(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(gql) == "" {
+		return nil, nil
+	}
+	handler, err := gqlSchema.NewHandler(gql, false)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := schema.ParseWithNamespace(handler.DGSchema(), namespace)
+	if err != nil {
+		return nil, err
+	}
+	required := make(map[string]*pb.SchemaUpdate, len(parsed.Preds))
+	for _, su := range parsed.Preds {
+		required[su.Predicate] = su
+	}
+
+	var conflicts []*graphQLConflict
+	for _, su := range updates {
+		req, ok := required[su.Predicate]
+		if !ok {
+			continue
+		}
+		addConflict := func(format string, args ...interface{}) {
+			conflicts = append(conflicts, &graphQLConflict{
+				Predicate: x.ParseAttr(su.Predicate),
+				Reason:    fmt.Sprintf(format, args...),
+			})
+		}
+		if req.ValueType != su.ValueType {
+			addConflict("GraphQL schema needs type %s, got %s",
+				types.TypeID(req.ValueType).Name(), types.TypeID(su.ValueType).Name())
+		}
+		if req.List != su.List {
+			addConflict("GraphQL schema needs list: %v, got list: %v", req.List, su.List)
+		}
+		for _, tokenizer := range req.Tokenizer {
+			if !x.HasString(su.Tokenizer, tokenizer) {
+				addConflict("GraphQL schema needs the %s index", tokenizer)
+			}
+		}
+		if req.Directive == pb.SchemaUpdate_REVERSE && su.Directive != pb.SchemaUpdate_REVERSE {
+			addConflict("GraphQL schema needs the reverse index")
+		}
+		if req.Count && !su.Count {
+			addConflict("GraphQL schema needs the count index")
+		}
+		if req.Upsert && !su.Upsert {
+			addConflict("GraphQL schema needs @upsert")
+		}
+	}
+	return conflicts, nil
+}
@@ -0,0 +1,66 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/schema"
+)
+
+func TestTypeImpacts(t *testing.T) {
+	const old = `
+		sku: string .
+		vendor: string .
+		price: float .
+		type Product @unique(sku, vendor) {
+			sku
+			vendor
+			price @min(0)
+		}
+	`
+	require.NoError(t, schema.ParseBytes([]byte(old), 1))
+	result, err := schema.Parse(old)
+	require.NoError(t, err)
+	for _, typ := range result.Types {
+		schema.State().SetType(typ.TypeName, typ)
+	}
+
+	result, err = schema.Parse(`
+		sku: string .
+		vendor: string .
+		price: float .
+		type Product @closed @unique(vendor, sku) {
+			sku @required
+			vendor
+			price @min(0)
+		}
+		type Vendor {
+			vendor
+		}
+	`)
+	require.NoError(t, err)
+	typ := result.Types[0]
+	added := []*compositeIndex{{
+		typ:   typ.TypeName,
+		tuple: typ.Unique[0],
+		attr:  schema.CompositeUniquePredicate(typ.TypeName, typ.Unique[0]),
+		nodes: 3,
+	}}
+	stored, _ := schema.State().GetType(typ.TypeName)
+	removed := []string{schema.CompositeUniquePredicate(typ.TypeName, stored.Unique[0])}
+
+	// The new type without constraints isn't reported.
+	require.Equal(t, []*typeImpact{{
+		Type:               "Product",
+		Closed:             true,
+		ConstraintsChanged: []string{"sku"},
+		UniqueAdded:        []*uniqueImpact{{Fields: []string{"vendor", "sku"}, Nodes: 3}},
+		UniqueRemoved:      []*uniqueImpact{{Fields: []string{"sku", "vendor"}}},
+	}}, typeImpacts(result.Types, added, removed))
+}
@@ -37,6 +37,7 @@ import (
 
 	"github.com/dgraph-io/dgo/v250"
 	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/dgraph-io/dgraph/v25/audit"
 	"github.com/dgraph-io/dgraph/v25/chunker"
 	"github.com/dgraph-io/dgraph/v25/conn"
 	"github.com/dgraph-io/dgraph/v25/dql"
@@ -283,6 +284,13 @@ func parseSchemaFromAlterOperation(ctx context.Context, sch string) (
 		if err := validatePredName(update.Predicate); err != nil {
 			return nil, err
 		}
+		// With ACL, only the users of the groups of an encrypted predicate can read its values
+		// decrypted, so nobody could without groups.
+		if enc := update.GetEncryption(); enc != nil && len(enc.Groups) == 0 &&
+			x.WorkerConfig.AclEnabled {
+			return nil, errors.Errorf("Missing groups in @encrypted for predicate %s, which are "+
+				"needed to read its values decrypted with ACL", x.ParseAttr(update.Predicate))
+		}
 		// Users are not allowed to create a predicate under the reserved `dgraph.` namespace. But,
 		// there are pre-defined predicates (subset of reserved predicates), and for them we allow
 		// the schema update to go through if the update is equal to the existing one.
@@ -394,6 +402,10 @@ func (s *Server) alter(ctx context.Context, op *api.Operation, doAuth AuthMode)
 	if err != nil {
 		return nil, errors.Wrapf(err, "While altering")
 	}
+	dryRun := x.IsDryRun(ctx)
+	if dryRun && isDropOperation(op) {
+		return empty, errors.Errorf("Dry run is only supported for schema changes")
+	}
 
 	// StartTs is not needed if the predicate to be dropped lies on this server but is required
 	// if it lies on some other machine. Let's get it for safety.
@@ -546,11 +558,24 @@ func (s *Server) alter(ctx context.Context, op *api.Operation, doAuth AuthMode)
 	} else if err != nil {
 		return nil, err
 	}
+	addedTuples, removedTuples, err := prepareCompositeUnique(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return alterDryRun(ctx, namespace, result, addedTuples, removedTuples)
+	}
+	// The changes that depend on the data or the config of the Alphas, like the encryption of a
+	// predicate, are checked before they're proposed, as every replica applies them as they are.
+	if err := worker.CheckSchemaOverNetwork(ctx, result.Preds); err != nil {
+		return nil, err
+	}
 
 	glog.Infof("Got schema: %+v\n", result)
 	// TODO: Maybe add some checks about the schema.
 	m.Schema = result.Preds
-	m.Types = result.Types
+	// The @unique tuples added to the types are published once their indexes are built.
+	m.Types = withoutTuples(result.Types, addedTuples)
 	_, err = query.ApplyMutations(ctx, m)
 	if err != nil {
 		return empty, err
@@ -561,6 +586,9 @@ func (s *Server) alter(ctx context.Context, op *api.Operation, doAuth AuthMode)
 		return empty, err
 	}
 
+	if err := updateCompositeIndexes(ctx, result.Types, addedTuples, removedTuples); err != nil {
+		return empty, err
+	}
 	return empty, nil
 }
 
@@ -644,6 +672,11 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 	if err := validateMutation(ctx, edges); err != nil {
 		return err
 	}
+	tupleEdges, err := validateTypeConstraints(ctx, edges, qc.req.StartTs)
+	if err != nil {
+		return err
+	}
+	m.Edges = append(m.Edges, tupleEdges...)
 
 	qc.span.AddEvent("Applying mutations",
 		trace.WithAttributes(attribute.String("m", fmt.Sprintf("%+v", m))))
@@ -1209,6 +1242,9 @@ type queryContext struct {
 	// uniqueVar stores the mapping between the indexes of gmuList and gmu.Set,
 	// along with their respective uniqueQueryVariables.
 	uniqueVars map[uint64]uniquePredMeta
+	// storedQuery indicates that req.Query is the text of a stored query, whose parsed form
+	// can be cached.
+	storedQuery bool
 }
 
 // Request represents a query request sent to the doQuery() method on the Server.
@@ -1459,8 +1495,15 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 		return
 	}
 
+	storedQuery := x.ExtractStoredQuery(ctx)
+	if storedQuery == "" && req.doAuth == NeedAuthorize && !isGraphQL {
+		if rerr = checkStoredQueriesOnly(ctx); rerr != nil {
+			return
+		}
+	}
+
 	req.req.Query = strings.TrimSpace(req.req.Query)
-	isQuery := len(req.req.Query) != 0
+	isQuery := len(req.req.Query) != 0 || storedQuery != ""
 	if !isQuery && !isMutation {
 		span.AddEvent("empty request")
 		return nil, errors.Errorf("empty request")
@@ -1488,6 +1531,14 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 		}
 	}
 
+	if req.doAuth == NeedAuthorize {
+		var release context.CancelFunc
+		if ctx, release, rerr = applyQuota(ctx, isQuery, isMutation); rerr != nil {
+			return
+		}
+		defer release()
+	}
+
 	qc := &queryContext{
 		req:      req.req,
 		latency:  l,
@@ -1495,11 +1546,24 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 		graphql:  isGraphQL,
 		gqlField: req.gqlField,
 	}
+	if storedQuery != "" {
+		if rerr = applyStoredQuery(ctx, qc, storedQuery); rerr != nil {
+			return
+		}
+	}
+	ctx, running := trackRequest(ctx, req, isGraphQL)
+	defer running.Done()
 	if rerr = parseRequest(ctx, qc); rerr != nil {
 		return
 	}
+	if asOf := x.ExtractAsOf(ctx); asOf != "" {
+		if rerr = applyAsOf(qc, asOf); rerr != nil {
+			return
+		}
+	}
 
 	if req.doAuth == NeedAuthorize {
+		running.SetStage(worker.StageAuthorizing)
 		if rerr = authorizeRequest(ctx, qc); rerr != nil {
 			return
 		}
@@ -1528,7 +1592,12 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 	}
 
 	var gqlErrs error
-	if resp, rerr = processQuery(ctx, qc); rerr != nil {
+	running.SetStage(worker.StageProcessing)
+	resp, rerr = processQuery(ctx, qc)
+	if qc.req.Query != "" {
+		audit.AuditRead(ctx, qc.req.Query, qc.gqlField != nil, resp.GetMetrics().GetNumUids(), rerr)
+	}
+	if rerr != nil {
 		// if rerr is just some error from GraphQL encoding, then we need to continue the normal
 		// execution ignoring the error as we still need to assign latency info to resp. If we can
 		// change the api.Response proto to have a field to contain GraphQL errors, that would be
@@ -1542,6 +1611,9 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 	// if it were a mutation, simple or upsert, in any case gqlErrs would be empty as GraphQL JSON
 	// is formed only for queries. So, gqlErrs can have something only in the case of a pure query.
 	// So, safe to ignore gqlErrs and not return that here.
+	if isMutation {
+		running.SetStage(worker.StageMutating)
+	}
 	if rerr = s.doMutate(ctx, qc, resp); rerr != nil {
 		return
 	}
@@ -1579,6 +1651,12 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 		Latency:  qc.latency,
 		DqlQuery: &qc.dqlRes,
 	}
+	if x.WorkerConfig.AclEnabled {
+		// The values of the encrypted predicates are decrypted only for the groups of the user.
+		if userData, err := extractUserAndGroups(ctx); err == nil {
+			ctx = worker.WithDecryptGroups(ctx, userData.groupIds)
+		}
+	}
 
 	// Here we try our best effort to not contact Zero for a timestamp. If we succeed,
 	// then we use the max known transaction ts value (from ProcessDelta) for a read-only query.
@@ -1762,10 +1840,14 @@ func parseRequest(ctx context.Context, qc *queryContext) error {
 
 	// parsing the updated query
 	var err error
-	qc.dqlRes, err = dql.ParseWithNeedVars(dql.Request{
-		Str:       upsertQuery,
-		Variables: qc.req.Vars,
-	}, needVars)
+	if qc.storedQuery {
+		qc.dqlRes, err = storedQueryCache.parse(upsertQuery, qc.req.Vars)
+	} else {
+		qc.dqlRes, err = dql.ParseWithNeedVars(dql.Request{
+			Str:       upsertQuery,
+			Variables: qc.req.Vars,
+		}, needVars)
+	}
 	if err != nil {
 		return err
 	}
@@ -2294,6 +2376,11 @@ func newReservedPredicateGuard(ctx context.Context) reservedPredicateGuard {
 		if !isGraphql && x.IsOtherReservedPredicate(nq.Predicate) {
 			return errors.Errorf("Cannot mutate graphql reserved predicate %s", nq.Predicate)
 		}
+		// The indexes of the @unique tuples of the types are only written by the mutations
+		// that change the values of the tuples.
+		if schema.IsCompositeUniquePredicate(nq.Predicate) {
+			return errors.Errorf("Cannot mutate reserved predicate %s", nq.Predicate)
+		}
 		if marker, locked := x.ReservedPredicateValueLock(nq.Predicate); locked {
 			trusted := false
 			if marker != nil {
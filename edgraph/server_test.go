@@ -199,6 +199,29 @@ func TestParseSchemaFromAlterOperation(t *testing.T) {
 
 }
 
+func TestParseSchemaEncryptedGroups(t *testing.T) {
+	md := metadata.New(map[string]string{"namespace": "123"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	dir := t.TempDir()
+	ps, err := badger.OpenManaged(badger.DefaultOptions(dir))
+	x.Check(err)
+	defer ps.Close()
+	schema.Init(ps)
+
+	// Without ACL, anybody with the key can read the values decrypted.
+	_, err = parseSchemaFromAlterOperation(ctx, `notes: string @encrypted(key: "pii") .`)
+	require.NoError(t, err)
+
+	// With ACL, nobody could read the values decrypted without groups.
+	defer func(enabled bool) { x.WorkerConfig.AclEnabled = enabled }(x.WorkerConfig.AclEnabled)
+	x.WorkerConfig.AclEnabled = true
+	_, err = parseSchemaFromAlterOperation(ctx, `notes: string @encrypted(key: "pii") .`)
+	require.ErrorContains(t, err, "Missing groups in @encrypted for predicate notes")
+	_, err = parseSchemaFromAlterOperation(ctx,
+		`notes: string @encrypted(key: "pii", groups: "hr") .`)
+	require.NoError(t, err)
+}
+
 func TestGetHash(t *testing.T) {
 	h := sha256.New()
 	_, err := h.Write([]byte("0xa0x140x313233343536373839"))
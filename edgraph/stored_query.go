@@ -0,0 +1,341 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/dgraph-io/dgraph/v25/dql"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+const (
+	// maxCachedStoredQueries bounds the number of parsed stored queries kept in memory. Each
+	// combination of query and variables takes one entry.
+	maxCachedStoredQueries = 1000
+
+	storedQueryLookup = `query q($name: string) {
+		q(func: eq(dgraph.query.name, $name)) {
+			uid
+			dgraph.query.name
+			dgraph.query.text
+			dgraph.query.groups
+		}
+	}`
+	storedQueryList = `{
+		q(func: type(dgraph.query), orderasc: dgraph.query.name) {
+			uid
+			dgraph.query.name
+			dgraph.query.text
+			dgraph.query.groups
+		}
+	}`
+)
+
+var (
+	errStoredQueriesDisabled = errors.New("stored queries are disabled. " +
+		`Enable them via --stored-queries "enabled=true;"`)
+	errStoredQueriesOnly = status.Error(codes.PermissionDenied,
+		"only stored queries are allowed on this server")
+)
+
+// StoredQuery is a named DQL query registered via the admin API. Clients run it by name, only
+// providing values for its variables.
+type StoredQuery struct {
+	Uid    string   `json:"uid,omitempty"`
+	Name   string   `json:"dgraph.query.name"`
+	Query  string   `json:"dgraph.query.text"`
+	Groups []string `json:"dgraph.query.groups,omitempty"`
+}
+
+// parsedQueryCache caches the parsed form of stored queries. Entries are keyed by the query text
+// and the variables, so updating a stored query doesn't require invalidating anything.
+type parsedQueryCache struct {
+	sync.Mutex
+	entries map[[sha256.Size]byte]dql.Result
+}
+
+var storedQueryCache = &parsedQueryCache{entries: make(map[[sha256.Size]byte]dql.Result)}
+
+func parsedQueryKey(query string, vars map[string]string) [sha256.Size]byte {
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	h.Write([]byte(query))
+	for _, k := range keys {
+		h.Write([]byte{0})
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(vars[k]))
+	}
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+	return key
+}
+
+// parse returns the parsed query, either from the cache or by parsing it. The returned result is
+// a copy which the caller is free to modify.
+func (c *parsedQueryCache) parse(query string, vars map[string]string) (dql.Result, error) {
+	key := parsedQueryKey(query, vars)
+	c.Lock()
+	res, ok := c.entries[key]
+	c.Unlock()
+	if ok {
+		return res.Clone(), nil
+	}
+
+	res, err := dql.Parse(dql.Request{Str: query, Variables: vars})
+	if err != nil {
+		return res, err
+	}
+	c.Lock()
+	if len(c.entries) >= maxCachedStoredQueries {
+		// Evict an arbitrary entry, the map iteration order is random.
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+	c.entries[key] = res
+	c.Unlock()
+	return res.Clone(), nil
+}
+
+func validateStoredQueryName(name string) error {
+	if name == "" {
+		return errors.New("name of the stored query can't be empty")
+	}
+	if strings.ContainsAny(name, " \t\n\"'<>") {
+		return errors.Errorf("invalid name %q for stored query", name)
+	}
+	return nil
+}
+
+// storedQueryCtx returns a context to run internal queries and mutations on stored queries in
+// the namespace of the request.
+func storedQueryCtx(ctx context.Context) (context.Context, error) {
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(context.Background(), IsGraphql, true)
+	return x.AttachNamespace(ctx, ns), nil
+}
+
+func queryStoredQueries(ctx context.Context, query string,
+	vars map[string]string) ([]*StoredQuery, error) {
+	resp, err := (&Server{}).doQuery(ctx, &Request{
+		req:    &api.Request{Query: query, Vars: vars, ReadOnly: true},
+		doAuth: NoAuthorize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Q []*StoredQuery `json:"q"`
+	}
+	if len(resp.GetJson()) > 0 {
+		if err := json.Unmarshal(resp.GetJson(), &res); err != nil {
+			return nil, errors.Wrap(err, "while unmarshalling stored queries")
+		}
+	}
+	return res.Q, nil
+}
+
+// GetStoredQuery returns the stored query with the given name in the namespace of the request,
+// or nil if there is none.
+func GetStoredQuery(ctx context.Context, name string) (*StoredQuery, error) {
+	if !x.WorkerConfig.StoredQueries {
+		return nil, errStoredQueriesDisabled
+	}
+	ctx, err := storedQueryCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries, err := queryStoredQueries(ctx, storedQueryLookup, map[string]string{"$name": name})
+	if err != nil {
+		return nil, err
+	}
+	switch len(queries) {
+	case 0:
+		return nil, nil
+	case 1:
+		return queries[0], nil
+	}
+	return nil, errors.Errorf("found %d stored queries named %q", len(queries), name)
+}
+
+// ListStoredQueries returns all the stored queries in the namespace of the request.
+func ListStoredQueries(ctx context.Context) ([]*StoredQuery, error) {
+	if !x.WorkerConfig.StoredQueries {
+		return nil, errStoredQueriesDisabled
+	}
+	ctx, err := storedQueryCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return queryStoredQueries(ctx, storedQueryList, nil)
+}
+
+// StoreQuery registers the query under the given name, replacing any existing query with the
+// same name. If groups are given, only members of these ACL groups (and guardians) can run it.
+func StoreQuery(ctx context.Context, sq *StoredQuery) error {
+	if !x.WorkerConfig.StoredQueries {
+		return errStoredQueriesDisabled
+	}
+	if err := validateStoredQueryName(sq.Name); err != nil {
+		return err
+	}
+	if strings.TrimSpace(sq.Query) == "" {
+		return errors.Errorf("query for stored query %q can't be empty", sq.Name)
+	}
+	res, err := dql.ParseWithPlaceholders(sq.Query)
+	if err != nil {
+		return errors.Wrapf(err, "while validating stored query %q", sq.Name)
+	}
+	if res.Schema != nil {
+		// Schema queries are authorized differently, they can't be run as stored queries.
+		return errors.Errorf("stored query %q can't be a schema query", sq.Name)
+	}
+
+	ctx, err = storedQueryCtx(ctx)
+	if err != nil {
+		return err
+	}
+	strVal := func(s string) *api.Value {
+		return &api.Value{Val: &api.Value_StrVal{StrVal: s}}
+	}
+	set := []*api.NQuad{
+		{Subject: "uid(v)", Predicate: "dgraph.type", ObjectValue: strVal("dgraph.query")},
+		{Subject: "uid(v)", Predicate: "dgraph.query.name", ObjectValue: strVal(sq.Name)},
+		{Subject: "uid(v)", Predicate: "dgraph.query.text", ObjectValue: strVal(sq.Query)},
+	}
+	for _, group := range sq.Groups {
+		set = append(set, &api.NQuad{Subject: "uid(v)", Predicate: "dgraph.query.groups",
+			ObjectValue: strVal(group)})
+	}
+	_, err = (&Server{}).doQuery(ctx, &Request{
+		req: &api.Request{
+			Query: `query q($name: string) { q(func: eq(dgraph.query.name, $name)) { v as uid } }`,
+			Vars:  map[string]string{"$name": sq.Name},
+			Mutations: []*api.Mutation{{
+				Del: []*api.NQuad{{Subject: "uid(v)", Predicate: "dgraph.query.groups",
+					ObjectValue: &api.Value{Val: &api.Value_DefaultVal{DefaultVal: x.Star}}}},
+				Set: set,
+			}},
+			CommitNow: true,
+		},
+		doAuth: NoAuthorize,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "while storing query %q", sq.Name)
+	}
+	glog.Infof("Stored query %q (groups: %v)", sq.Name, sq.Groups)
+	return nil
+}
+
+// DeleteStoredQuery removes the stored query with the given name. It returns false if there was
+// no such query.
+func DeleteStoredQuery(ctx context.Context, name string) (bool, error) {
+	sq, err := GetStoredQuery(ctx, name)
+	if err != nil || sq == nil {
+		return false, err
+	}
+	ctx, err = storedQueryCtx(ctx)
+	if err != nil {
+		return false, err
+	}
+	_, err = (&Server{}).doQuery(ctx, &Request{
+		req: &api.Request{
+			Mutations: []*api.Mutation{{
+				Del: []*api.NQuad{{Subject: sq.Uid, Predicate: x.Star,
+					ObjectValue: &api.Value{Val: &api.Value_DefaultVal{DefaultVal: x.Star}}}},
+			}},
+			CommitNow: true,
+		},
+		doAuth: NoAuthorize,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "while deleting stored query %q", name)
+	}
+	glog.Infof("Deleted stored query %q", name)
+	return true, nil
+}
+
+// canRunStoredQuery checks whether the user making the request belongs to one of the groups the
+// stored query is restricted to.
+func canRunStoredQuery(ctx context.Context, sq *StoredQuery) error {
+	if !x.WorkerConfig.AclEnabled || len(sq.Groups) == 0 {
+		return nil
+	}
+	user, err := extractUserAndGroups(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if x.IsSuperAdmin(user.groupIds) || slices.ContainsFunc(user.groupIds, func(g string) bool {
+		return slices.Contains(sq.Groups, g)
+	}) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "user %q is not allowed to run stored query %q",
+		user.userId, sq.Name)
+}
+
+// applyStoredQuery replaces the request with the stored query of the given name. Only the
+// variables of the original request are used.
+func applyStoredQuery(ctx context.Context, qc *queryContext, name string) error {
+	if len(qc.req.Mutations) > 0 || strings.TrimSpace(qc.req.Query) != "" {
+		return errors.New("a request for a stored query can't contain a query or mutations")
+	}
+	sq, err := GetStoredQuery(ctx, name)
+	if err != nil {
+		return err
+	}
+	if sq == nil {
+		return errors.Errorf("no stored query named %q", name)
+	}
+	if err := canRunStoredQuery(ctx, sq); err != nil {
+		return err
+	}
+	qc.span.AddEvent("stored query", trace.WithAttributes(attribute.String("name", name)))
+	qc.req.Query = sq.Query
+	qc.req.ReadOnly = true
+	qc.storedQuery = true
+	return nil
+}
+
+// checkStoredQueriesOnly rejects ad-hoc requests when the server only allows stored queries.
+// Guardians are still allowed to run anything.
+func checkStoredQueriesOnly(ctx context.Context) error {
+	if !x.WorkerConfig.StoredQueriesOnly {
+		return nil
+	}
+	if !x.WorkerConfig.AclEnabled {
+		return errStoredQueriesOnly
+	}
+	if err := AuthorizeGuardians(ctx); err != nil {
+		return errStoredQueriesOnly
+	}
+	return nil
+}
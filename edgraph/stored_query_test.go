@@ -0,0 +1,93 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/v25/dql"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+func TestParsedQueryCache(t *testing.T) {
+	cache := &parsedQueryCache{entries: make(map[[sha256.Size]byte]dql.Result)}
+	query := `query q($name: string, $first: int) {
+		q(func: eq(name, $name), first: $first) @filter(has(age)) {
+			name
+			friend { name }
+		}
+	}`
+
+	vars := map[string]string{"$name": "alice", "$first": "10"}
+	res, err := cache.parse(query, vars)
+	require.NoError(t, err)
+	require.Len(t, cache.entries, 1)
+	require.Equal(t, "alice", res.Query[0].Func.Args[0].Value)
+
+	// Modifying the returned result must not affect the cached entry.
+	res.Query[0].Children = res.Query[0].Children[:0]
+	res.Query[0].Filter = nil
+	res2, err := cache.parse(query, map[string]string{"$first": "10", "$name": "alice"})
+	require.NoError(t, err)
+	require.Len(t, cache.entries, 1)
+	require.Len(t, res2.Query[0].Children, 2)
+	require.NotNil(t, res2.Query[0].Filter)
+
+	res3, err := cache.parse(query, map[string]string{"$name": "bob", "$first": "10"})
+	require.NoError(t, err)
+	require.Len(t, cache.entries, 2)
+	require.Equal(t, "bob", res3.Query[0].Func.Args[0].Value)
+
+	_, err = cache.parse(query, map[string]string{"$name": "bob", "$first": "many"})
+	require.Error(t, err)
+	require.Len(t, cache.entries, 2)
+}
+
+func TestStoredQueriesOnly(t *testing.T) {
+	defer func(c x.WorkerOptions) { x.WorkerConfig = c }(x.WorkerConfig)
+
+	x.WorkerConfig.StoredQueriesOnly = false
+	require.NoError(t, checkStoredQueriesOnly(context.Background()))
+
+	x.WorkerConfig.StoredQueriesOnly = true
+	x.WorkerConfig.AclEnabled = false
+	require.ErrorIs(t, checkStoredQueriesOnly(context.Background()), errStoredQueriesOnly)
+
+	x.WorkerConfig.AclEnabled = true
+	expiry := time.Now().Add(time.Minute).Unix()
+	guardian := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accessJwt",
+		generateJWT(x.RootNamespace, "groot", []string{x.SuperAdminId}, expiry)))
+	require.NoError(t, checkStoredQueriesOnly(guardian))
+	user := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accessJwt",
+		generateJWT(x.RootNamespace, "alice", []string{"dev"}, expiry)))
+	require.ErrorIs(t, checkStoredQueriesOnly(user), errStoredQueriesOnly)
+}
+
+func TestCanRunStoredQuery(t *testing.T) {
+	defer func(c x.WorkerOptions) { x.WorkerConfig = c }(x.WorkerConfig)
+	x.WorkerConfig.AclEnabled = true
+
+	expiry := time.Now().Add(time.Minute).Unix()
+	ctxFor := func(groups ...string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("accessJwt",
+			generateJWT(x.RootNamespace, "alice", groups, expiry)))
+	}
+
+	open := &StoredQuery{Name: "open"}
+	require.NoError(t, canRunStoredQuery(ctxFor("dev"), open))
+
+	restricted := &StoredQuery{Name: "restricted", Groups: []string{"ops", "support"}}
+	require.NoError(t, canRunStoredQuery(ctxFor("dev", "support"), restricted))
+	require.NoError(t, canRunStoredQuery(ctxFor(x.SuperAdminId), restricted))
+	require.Error(t, canRunStoredQuery(ctxFor("dev"), restricted))
+	require.Error(t, canRunStoredQuery(context.Background(), restricted))
+}
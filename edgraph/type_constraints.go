@@ -0,0 +1,417 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/worker"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// changedNode holds the edges of a mutation that change a node, and the types of the node before
+// and after the mutation is applied.
+type changedNode struct {
+	uid      uint64
+	ns       uint64
+	edges    []*pb.DirectedEdge
+	types    []string
+	oldTypes []string
+	// expiresAt is the expiry time of the node, if it's of a type with a @ttl.
+	expiresAt int64
+}
+
+// constraintChecker validates a mutation against the constraints declared by the types of the
+// nodes it changes.
+type constraintChecker struct {
+	nodes map[uint64]*changedNode
+	// fetch reads the stored values of the predicate for the given sorted uids. The values of
+	// uid predicates are returned as uids in hex.
+	fetch func(attr string, uids []uint64) (map[uint64][]string, error)
+	// lookup returns the nodes that have the value for the indexed predicate.
+	lookup func(attr, value string) ([]uint64, error)
+}
+
+// validateTypeConstraints checks that the nodes changed by the mutation keep satisfying the
+// constraints of their types: closed types, required fields, the values of the fields, the
+// types of the nodes they point to and the @unique tuples of fields. It stamps the edges of the
+// nodes of the types with a @ttl with their expiry time. It returns the edges that update the
+// indexes of the tuples and the expiry of the stored values, to be applied with the mutation.
+func validateTypeConstraints(ctx context.Context, edges []*pb.DirectedEdge,
+	readTs uint64) ([]*pb.DirectedEdge, error) {
+	if !schema.State().HasTypeConstraints() {
+		return nil, nil
+	}
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "While validating type constraints")
+	}
+	c := &constraintChecker{
+		fetch: func(attr string, uids []uint64) (map[uint64][]string, error) {
+			return fetchValues(ctx, attr, uids, readTs)
+		},
+		lookup: func(attr, value string) ([]uint64, error) {
+			return lookupValue(ctx, attr, value, readTs)
+		},
+	}
+	return c.validate(edges, ns, x.IsRootNsOperation(ctx))
+}
+
+func (c *constraintChecker) validate(edges []*pb.DirectedEdge, ns uint64,
+	galaxy bool) ([]*pb.DirectedEdge, error) {
+	c.nodes = make(map[uint64]*changedNode)
+	node := func(uid, ns uint64) *changedNode {
+		n, ok := c.nodes[uid]
+		if !ok {
+			n = &changedNode{uid: uid, ns: ns}
+			c.nodes[uid] = n
+		}
+		return n
+	}
+	for _, e := range edges {
+		edgeNs := ns
+		if galaxy {
+			edgeNs = e.GetNamespace()
+		}
+		n := node(e.Entity, edgeNs)
+		n.edges = append(n.edges, e)
+		if e.Op == pb.DirectedEdge_SET && e.ValueId != 0 {
+			// The types of the nodes the edges point to are needed to check their targets.
+			node(e.ValueId, edgeNs)
+		}
+	}
+	if err := c.resolveTypes(); err != nil {
+		return nil, err
+	}
+
+	// The required fields that the mutation doesn't set are checked together for all the nodes.
+	required := make(map[string][]requirement)
+	for _, n := range c.nodes {
+		if len(n.edges) == 0 {
+			continue
+		}
+		var constrained []*pb.TypeUpdate
+		for _, name := range n.types {
+			if typ, ok := schema.State().ConstrainedType(x.NamespaceAttr(n.ns, name)); ok {
+				constrained = append(constrained, typ)
+			}
+		}
+		if len(constrained) == 0 {
+			continue
+		}
+		if err := c.checkClosed(n, constrained); err != nil {
+			return nil, err
+		}
+		if err := c.checkValues(n, constrained); err != nil {
+			return nil, err
+		}
+		for _, typ := range constrained {
+			for _, field := range typ.Fields {
+				if field.GetConstraint().GetRequired() && !setsPredicate(n, field.Predicate) {
+					required[field.Predicate] = append(required[field.Predicate],
+						requirement{node: n, typ: typ.TypeName})
+				}
+			}
+		}
+	}
+	if err := c.checkRequired(required); err != nil {
+		return nil, err
+	}
+	expiries, err := c.expireNodes()
+	if err != nil {
+		return nil, err
+	}
+	tuples, err := c.indexTuples()
+	if err != nil {
+		return nil, err
+	}
+	return append(tuples, expiries...), nil
+}
+
+// requirement is a node that must have a value for a required field of its type.
+type requirement struct {
+	node *changedNode
+	typ  string
+}
+
+func setsPredicate(n *changedNode, attr string) bool {
+	for _, e := range n.edges {
+		if e.Op == pb.DirectedEdge_SET && x.NamespaceAttr(n.ns, e.Attr) == attr {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveTypes finds the types of the nodes once the mutation is applied.
+func (c *constraintChecker) resolveTypes() error {
+	byNs := make(map[uint64][]uint64)
+	for uid, n := range c.nodes {
+		byNs[n.ns] = append(byNs[n.ns], uid)
+	}
+	for ns, uids := range byNs {
+		attr := x.NamespaceAttr(ns, "dgraph.type")
+		stored, err := c.fetchSorted(attr, uids)
+		if err != nil {
+			return err
+		}
+		for _, uid := range uids {
+			n := c.nodes[uid]
+			n.oldTypes = stored[uid]
+			n.types = applyEdges(stored[uid], n.edges, "dgraph.type", ns)
+		}
+	}
+	return nil
+}
+
+func (c *constraintChecker) fetchSorted(attr string, uids []uint64) (map[uint64][]string, error) {
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	return c.fetch(attr, uids)
+}
+
+// applyEdges returns the values of the predicate after the edges of the node are applied to its
+// stored values. Deletions of values that can't be matched to the stored ones are ignored.
+func applyEdges(stored []string, edges []*pb.DirectedEdge, pred string, ns uint64) []string {
+	values := slices.Clone(stored)
+	for _, e := range edges {
+		if e.Attr != pred && !(e.Attr == x.Star && e.Op == pb.DirectedEdge_DEL) {
+			continue
+		}
+		val := edgeValue(e, ns)
+		switch {
+		case e.Op == pb.DirectedEdge_SET:
+			if !slices.Contains(values, val) {
+				values = append(values, val)
+			}
+		case e.Attr == x.Star || val == x.Star:
+			values = values[:0]
+		default:
+			values = slices.DeleteFunc(values, func(v string) bool { return v == val })
+		}
+	}
+	return values
+}
+
+// edgeValue returns the value of the edge as a string, or the uid it points to in hex. The value
+// is converted to the type of the predicate first, so that it reads like the stored values.
+func edgeValue(e *pb.DirectedEdge, ns uint64) string {
+	if e.ValueId != 0 {
+		return strconv.FormatUint(e.ValueId, 16)
+	}
+	val := types.Val{Tid: types.TypeID(e.ValueType), Value: e.Value}
+	if tid, err := schema.State().TypeOf(x.NamespaceAttr(ns, e.Attr)); err == nil && tid.IsScalar() {
+		if conv, err := types.Convert(val, tid); err == nil {
+			val = conv
+		}
+	}
+	str, err := types.Convert(val, types.StringID)
+	if err != nil {
+		return string(e.Value)
+	}
+	return str.Value.(string)
+}
+
+func (c *constraintChecker) checkClosed(n *changedNode, constrained []*pb.TypeUpdate) error {
+	var closed *pb.TypeUpdate
+	for _, typ := range constrained {
+		if typ.Closed {
+			closed = typ
+			break
+		}
+	}
+	if closed == nil {
+		return nil
+	}
+	for _, e := range n.edges {
+		if e.Op != pb.DirectedEdge_SET || x.IsReservedPredicate(x.NamespaceAttr(n.ns, e.Attr)) {
+			continue
+		}
+		if field := findField(n, e.Attr); field == nil {
+			return errors.Errorf("Type %s is closed, predicate %s isn't a field of the types "+
+				"of node %#x", x.ParseAttr(closed.TypeName), e.Attr, n.uid)
+		}
+	}
+	return nil
+}
+
+// findField returns the field for the predicate in any of the types of the node.
+func findField(n *changedNode, pred string) *pb.SchemaUpdate {
+	attr := x.NamespaceAttr(n.ns, pred)
+	for _, name := range n.types {
+		typ, ok := schema.State().GetType(x.NamespaceAttr(n.ns, name))
+		if !ok {
+			continue
+		}
+		for _, field := range typ.Fields {
+			if field.Predicate == attr {
+				return field
+			}
+		}
+	}
+	return nil
+}
+
+func (c *constraintChecker) checkValues(n *changedNode, constrained []*pb.TypeUpdate) error {
+	for _, e := range n.edges {
+		if e.Op != pb.DirectedEdge_SET {
+			continue
+		}
+		attr := x.NamespaceAttr(n.ns, e.Attr)
+		for _, typ := range constrained {
+			for _, field := range typ.Fields {
+				if field.Predicate != attr || field.Constraint == nil {
+					continue
+				}
+				if err := c.checkValue(e, n.ns, field.Constraint); err != nil {
+					return errors.Wrapf(err, "Field %s of type %s on node %#x", e.Attr,
+						x.ParseAttr(typ.TypeName), n.uid)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (c *constraintChecker) checkValue(e *pb.DirectedEdge, ns uint64,
+	fc *pb.FieldConstraint) error {
+	if fc.TargetType != "" {
+		if e.ValueId == 0 {
+			return errors.Errorf("must point to a node of type %s", fc.TargetType)
+		}
+		if target := c.nodes[e.ValueId]; !slices.Contains(target.types, fc.TargetType) {
+			return errors.Errorf("points to node %#x, which isn't of type %s", e.ValueId,
+				fc.TargetType)
+		}
+	}
+	if fc.Min != nil || fc.Max != nil {
+		val, err := types.Convert(types.Val{Tid: types.TypeID(e.ValueType), Value: e.Value},
+			types.FloatID)
+		if err != nil {
+			return errors.Errorf("must be a number, got %q", edgeValue(e, ns))
+		}
+		num := val.Value.(float64)
+		if fc.Min != nil && num < fc.GetMin() {
+			return errors.Errorf("value %v is less than the minimum %v", num, fc.GetMin())
+		}
+		if fc.Max != nil && num > fc.GetMax() {
+			return errors.Errorf("value %v is more than the maximum %v", num, fc.GetMax())
+		}
+	}
+
+	str := edgeValue(e, ns)
+	if length := int64(utf8.RuneCountInString(str)); fc.MinLength != nil || fc.MaxLength != nil {
+		if fc.MinLength != nil && length < fc.GetMinLength() {
+			return errors.Errorf("value %q is shorter than %d characters", str, fc.GetMinLength())
+		}
+		if fc.MaxLength != nil && length > fc.GetMaxLength() {
+			return errors.Errorf("value %q is longer than %d characters", str, fc.GetMaxLength())
+		}
+	}
+	if fc.Regex != "" {
+		re, err := constraintRegexp(fc.Regex)
+		if err != nil {
+			return errors.Wrapf(err, "invalid regular expression %q", fc.Regex)
+		}
+		if !re.MatchString(str) {
+			return errors.Errorf("value %q doesn't match %q", str, fc.Regex)
+		}
+	}
+	if len(fc.Enum) > 0 && !slices.Contains(fc.Enum, str) {
+		return errors.Errorf("value %q isn't one of %v", str, fc.Enum)
+	}
+	return nil
+}
+
+// constraintRegexps caches the compiled @regex patterns of the fields, by pattern. The patterns
+// come from the schema, so the cache stays small.
+var constraintRegexps sync.Map
+
+func constraintRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := constraintRegexps.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	constraintRegexps.Store(pattern, re)
+	return re, nil
+}
+
+// checkRequired checks that the nodes keep a value for the required fields of their types after
+// the mutation deletes some of their values.
+func (c *constraintChecker) checkRequired(required map[string][]requirement) error {
+	for attr, reqs := range required {
+		uids := make([]uint64, 0, len(reqs))
+		for _, req := range reqs {
+			uids = append(uids, req.node.uid)
+		}
+		stored, err := c.fetchSorted(attr, uids)
+		if err != nil {
+			return err
+		}
+		pred := x.ParseAttr(attr)
+		for _, req := range reqs {
+			if len(applyEdges(stored[req.node.uid], req.node.edges, pred, req.node.ns)) == 0 {
+				return errors.Errorf("Type %s requires field %s, which node %#x doesn't have",
+					x.ParseAttr(req.typ), pred, req.node.uid)
+			}
+		}
+	}
+	return nil
+}
+
+// fetchValues reads the values of the predicate for the given sorted uids at readTs.
+func fetchValues(ctx context.Context, attr string, uids []uint64,
+	readTs uint64) (map[uint64][]string, error) {
+	if _, err := schema.State().TypeOf(attr); err != nil {
+		// The predicate has no values yet.
+		return nil, nil
+	}
+	res, err := worker.ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    attr,
+		UidList: &pb.List{Uids: uids},
+		ReadTs:  readTs,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading %s to validate type constraints",
+			x.ParseAttr(attr))
+	}
+
+	values := make(map[uint64][]string, len(uids))
+	for i, uid := range uids {
+		if i < len(res.UidMatrix) {
+			for _, v := range res.UidMatrix[i].GetUids() {
+				values[uid] = append(values[uid], strconv.FormatUint(v, 16))
+			}
+		}
+		if i < len(res.ValueMatrix) {
+			for _, tv := range res.ValueMatrix[i].GetValues() {
+				if len(tv.Val) == 0 {
+					continue
+				}
+				val, err := types.Convert(types.Val{Tid: types.TypeID(tv.ValType), Value: tv.Val},
+					types.StringID)
+				if err != nil {
+					return nil, err
+				}
+				values[uid] = append(values[uid], val.Value.(string))
+			}
+		}
+	}
+	return values, nil
+}
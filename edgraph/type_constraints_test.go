@@ -0,0 +1,99 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+func TestValidateTypeConstraints(t *testing.T) {
+	const s = `
+		name: string .
+		age: int .
+		status: string .
+		friend: [uid] .
+		nick: string .
+		type Person @closed {
+			name @required @length(1, 10) @regex("^[A-Z]")
+			age @min(0) @max(150)
+			status @enum("active", "inactive")
+			friend @target(Person)
+		}
+	`
+	require.NoError(t, schema.ParseBytes([]byte(s), 1))
+	result, err := schema.Parse(s)
+	require.NoError(t, err)
+	for _, typ := range result.Types {
+		schema.State().SetType(typ.TypeName, typ)
+	}
+
+	// Node 0x1 is a stored Person named Alice, and node 0x2 is a stored node without a type.
+	stored := map[string]map[uint64][]string{
+		x.AttrInRootNamespace("dgraph.type"): {1: {"Person"}},
+		x.AttrInRootNamespace("name"):        {1: {"Alice"}},
+	}
+	check := func(edges ...*pb.DirectedEdge) error {
+		c := &constraintChecker{
+			fetch: func(attr string, uids []uint64) (map[uint64][]string, error) {
+				return stored[attr], nil
+			},
+		}
+		_, err := c.validate(edges, x.RootNamespace, false)
+		return err
+	}
+	set := func(uid uint64, attr, val string) *pb.DirectedEdge {
+		return &pb.DirectedEdge{Entity: uid, Attr: attr, Value: []byte(val)}
+	}
+	link := func(uid uint64, attr string, target uint64) *pb.DirectedEdge {
+		return &pb.DirectedEdge{Entity: uid, Attr: attr, ValueId: target,
+			ValueType: pb.Posting_UID}
+	}
+	del := func(uid uint64, attr, val string) *pb.DirectedEdge {
+		e := set(uid, attr, val)
+		e.Op = pb.DirectedEdge_DEL
+		return e
+	}
+
+	require.NoError(t, check(set(1, "age", "30"), set(1, "status", "active")))
+	require.NoError(t, check(set(3, "dgraph.type", "Person"), set(3, "name", "Bob"),
+		link(3, "friend", 1)))
+	// Nodes without a constrained type aren't checked.
+	require.NoError(t, check(set(2, "nick", "bob"), set(2, "age", "-1")))
+
+	require.ErrorContains(t, check(set(1, "nick", "al")),
+		"Type Person is closed, predicate nick isn't a field of the types of node 0x1")
+	require.ErrorContains(t, check(set(1, "age", "200")),
+		"Field age of type Person on node 0x1: value 200 is more than the maximum 150")
+	require.ErrorContains(t, check(set(1, "age", "old")), "must be a number")
+	require.ErrorContains(t, check(set(1, "status", "gone")), `value "gone" isn't one of`)
+	require.ErrorContains(t, check(set(1, "name", "Alexandrina Victoria")),
+		"is longer than 10 characters")
+	require.ErrorContains(t, check(set(1, "name", "alice")), `doesn't match "^[A-Z]"`)
+	// The pattern is compiled once, and reused by the next edges.
+	_, ok := constraintRegexps.Load("^[A-Z]")
+	require.True(t, ok)
+	require.ErrorContains(t, check(link(1, "friend", 2)),
+		"points to node 0x2, which isn't of type Person")
+	require.NoError(t, check(set(2, "dgraph.type", "Person"), set(2, "name", "Carol"),
+		link(1, "friend", 2)))
+
+	// The required fields must be set on new nodes, and can't be deleted.
+	require.ErrorContains(t, check(set(3, "dgraph.type", "Person"), set(3, "age", "3")),
+		"Type Person requires field name, which node 0x3 doesn't have")
+	require.ErrorContains(t, check(del(1, "name", "Alice")),
+		"Type Person requires field name, which node 0x1 doesn't have")
+	require.ErrorContains(t, check(del(1, "name", x.Star)), "requires field name")
+	require.NoError(t, check(del(1, "name", "Alice"), set(1, "name", "Alicia")))
+	// Removing the type of the node removes its constraints.
+	require.NoError(t, check(del(1, "dgraph.type", x.Star), del(1, "name", x.Star)))
+	require.NoError(t, check(del(1, x.Star, x.Star)))
+}
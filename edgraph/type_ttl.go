@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// A type with a @ttl, like @ttl(expires_at), expires its nodes at the time held by the datetime
+// field. The values of the fields of the type, dgraph.type and the indexes of the @unique tuples
+// of the node are stamped with that time when they're written, like the values of a predicate
+// with its own @ttl. When the expiry time of a node changes, or the node gets or loses the type,
+// the stored values are stamped again by EXPIRE edges, which keep the values and their facets.
+// The nodes stored before their type gets a @ttl are stamped once their expiry time is written.
+
+// expiringNode is a node of a type with a @ttl that the mutation changes.
+type expiringNode struct {
+	node *changedNode
+	typ  *pb.TypeUpdate
+	// kept is set if the node still has the type once the mutation is applied.
+	kept bool
+}
+
+// expiringFields returns the predicates whose values expire with the nodes of the type.
+func expiringFields(typ *pb.TypeUpdate) []string {
+	ns := x.ParseNamespace(typ.TypeName)
+	preds := []string{x.NamespaceAttr(ns, "dgraph.type")}
+	for _, field := range typ.Fields {
+		preds = append(preds, field.Predicate)
+	}
+	for _, tuple := range typ.Unique {
+		preds = append(preds, schema.CompositeUniquePredicate(typ.TypeName, tuple))
+	}
+	return preds
+}
+
+// expireNodes stamps the edges of the nodes of the types with a @ttl with the expiry time of the
+// node, and returns the EXPIRE edges that stamp the stored values of the nodes whose expiry time
+// changes.
+func (c *constraintChecker) expireNodes() ([]*pb.DirectedEdge, error) {
+	var nodes []*expiringNode
+	for _, n := range c.nodes {
+		if len(n.edges) == 0 {
+			continue
+		}
+		names := slices.Concat(n.types, n.oldTypes)
+		slices.Sort(names)
+		for _, name := range slices.Compact(names) {
+			typ, ok := schema.State().ConstrainedType(x.NamespaceAttr(n.ns, name))
+			if !ok || typ.TtlPredicate == "" {
+				continue
+			}
+			nodes = append(nodes, &expiringNode{node: n, typ: typ,
+				kept: slices.Contains(n.types, name)})
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+
+	// The stored expiry times are read together for the nodes whose mutation doesn't set them.
+	uids := make(map[string][]uint64)
+	for _, en := range nodes {
+		if en.kept && !setsPredicate(en.node, en.typ.TtlPredicate) {
+			uids[en.typ.TtlPredicate] = append(uids[en.typ.TtlPredicate], en.node.uid)
+		}
+	}
+	stored := make(map[string]map[uint64][]string, len(uids))
+	for attr, list := range uids {
+		slices.Sort(list)
+		values, err := c.fetch(attr, slices.Compact(list))
+		if err != nil {
+			return nil, err
+		}
+		stored[attr] = values
+	}
+
+	// A node of several types with a @ttl expires at the earliest of their times.
+	for _, en := range nodes {
+		if !en.kept {
+			continue
+		}
+		n, typ := en.node, en.typ
+		pred := x.ParseAttr(typ.TtlPredicate)
+		values := applyEdges(stored[typ.TtlPredicate][n.uid], n.edges, pred, n.ns)
+		if len(values) == 0 {
+			continue
+		}
+		last := values[len(values)-1]
+		val, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(last)},
+			types.DateTimeID)
+		if err != nil {
+			return nil, errors.Errorf("Field %s of type %s on node %#x must be a datetime, "+
+				"got %q", pred, x.ParseAttr(typ.TypeName), n.uid, last)
+		}
+		n.expiresAt = earliest(n.expiresAt, val.Value.(time.Time).Unix())
+	}
+
+	var edges []*pb.DirectedEdge
+	for _, en := range nodes {
+		n, typ := en.node, en.typ
+		fields := expiringFields(typ)
+		if en.kept && n.expiresAt != 0 {
+			for _, e := range n.edges {
+				attr := x.NamespaceAttr(n.ns, e.Attr)
+				if e.Op == pb.DirectedEdge_SET && slices.Contains(fields, attr) {
+					e.ExpiresAt = earliest(e.ExpiresAt, n.expiresAt)
+				}
+			}
+		}
+
+		added := !slices.Contains(n.oldTypes, x.ParseAttr(typ.TypeName))
+		if en.kept && !added && !touchesPredicate(n, x.ParseAttr(typ.TtlPredicate)) {
+			continue
+		}
+		for _, attr := range fields {
+			if _, err := schema.State().TypeOf(attr); err != nil {
+				// The predicate has no values yet.
+				continue
+			}
+			edges = append(edges, &pb.DirectedEdge{
+				Entity:    n.uid,
+				Attr:      x.ParseAttr(attr),
+				Namespace: n.ns,
+				Op:        pb.DirectedEdge_EXPIRE,
+				ExpiresAt: n.expiresAt,
+			})
+		}
+	}
+	return edges, nil
+}
+
+// touchesPredicate returns whether the edges of the node may change the values of the predicate.
+func touchesPredicate(n *changedNode, pred string) bool {
+	for _, e := range n.edges {
+		if e.Attr == pred || e.Attr == x.Star {
+			return true
+		}
+	}
+	return false
+}
+
+// earliest returns the earliest of the expiry times, zero standing for no expiry.
+func earliest(a, b int64) int64 {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,91 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+func TestExpireNodes(t *testing.T) {
+	const s = `
+		token: string .
+		expires_at: datetime .
+		type Session @ttl(expires_at) {
+			token
+			expires_at
+		}
+	`
+	require.NoError(t, schema.ParseBytes([]byte(s), 1))
+	result, err := schema.Parse(s)
+	require.NoError(t, err)
+	for _, typ := range result.Types {
+		schema.State().SetType(typ.TypeName, typ)
+	}
+	require.True(t, schema.State().MayExpire(x.AttrInRootNamespace("token")))
+
+	// Node 0x1 is a stored Session that expires in 2030.
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	later := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	stored := map[string]map[uint64][]string{
+		x.AttrInRootNamespace("dgraph.type"): {1: {"Session"}},
+		x.AttrInRootNamespace("expires_at"):  {1: {"2030-01-01T00:00:00Z"}},
+	}
+	check := func(edges ...*pb.DirectedEdge) ([]*pb.DirectedEdge, error) {
+		c := &constraintChecker{
+			fetch: func(attr string, uids []uint64) (map[uint64][]string, error) {
+				return stored[attr], nil
+			},
+		}
+		return c.validate(edges, x.RootNamespace, false)
+	}
+	set := func(uid uint64, attr, val string) *pb.DirectedEdge {
+		return &pb.DirectedEdge{Entity: uid, Attr: attr, Value: []byte(val)}
+	}
+	expire := func(uid uint64, expiresAt int64) []*pb.DirectedEdge {
+		var edges []*pb.DirectedEdge
+		for _, attr := range []string{"token", "expires_at"} {
+			edges = append(edges, &pb.DirectedEdge{Entity: uid, Attr: attr,
+				Op: pb.DirectedEdge_EXPIRE, ExpiresAt: expiresAt})
+		}
+		return edges
+	}
+
+	// The values written to a node expire with it.
+	edge := set(1, "token", "abc")
+	edges, err := check(edge)
+	require.NoError(t, err)
+	require.Empty(t, edges)
+	require.Equal(t, expiry, edge.ExpiresAt)
+
+	// The stored values are stamped again when the expiry time changes, or is removed.
+	edge = set(1, "expires_at", "2031-01-01T00:00:00Z")
+	edges, err = check(edge)
+	require.NoError(t, err)
+	require.Equal(t, expire(1, later), edges)
+	require.Equal(t, later, edge.ExpiresAt)
+	edges, err = check(&pb.DirectedEdge{Entity: 1, Attr: "expires_at", Value: []byte(x.Star),
+		Op: pb.DirectedEdge_DEL})
+	require.NoError(t, err)
+	require.Equal(t, expire(1, 0), edges)
+
+	// A node that gets the type is stamped with its expiry time.
+	typeEdge := set(2, "dgraph.type", "Session")
+	edges, err = check(typeEdge, set(2, "expires_at", "2030-01-01T00:00:00Z"))
+	require.NoError(t, err)
+	require.Equal(t, expire(2, expiry), edges)
+	require.Equal(t, expiry, typeEdge.ExpiresAt)
+
+	_, err = check(set(1, "expires_at", "soon"))
+	require.ErrorContains(t, err, `Field expires_at of type Session on node 0x1 must be a `+
+		`datetime, got "soon"`)
+}
@@ -0,0 +1,68 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package filestore
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/v25/chunker"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// blobFiles are the files stored on Azure Blob Storage or Google Cloud Storage.
+type blobFiles struct {
+	client x.BlobClient
+}
+
+func (bf *blobFiles) Open(path string) (io.ReadCloser, error) {
+	url, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, name := bf.client.ParseLocation(url)
+	return bf.client.Get(context.Background(), bucket, name)
+}
+
+// Checking if a file exists is a no-op, like for minio, since the directories are only prefixes
+func (bf *blobFiles) Exists(path string) bool {
+	return true
+}
+
+// FindDataFiles returns the URIs of the data files, so that they're read with the same client.
+func (bf *blobFiles) FindDataFiles(str string, ext []string) (paths []string) {
+	for _, dirPath := range strings.Split(str, ",") {
+		url, err := url.Parse(dirPath)
+		x.Check(err)
+
+		bucket, prefix := bf.client.ParseLocation(url)
+		names, err := bf.client.List(context.Background(), bucket, prefix)
+		x.Check(err)
+		for _, name := range names {
+			if hasAnySuffix(name, ext) {
+				paths = append(paths, bf.client.URL(bucket, name))
+			}
+		}
+	}
+	return
+}
+
+func (bf *blobFiles) ChunkReader(file string, key x.Sensitive) (*bufio.Reader, func()) {
+	url, err := url.Parse(file)
+	x.Check(err)
+
+	bucket, name := bf.client.ParseLocation(url)
+	obj, err := bf.client.Get(context.Background(), bucket, name)
+	x.Check(err)
+
+	return chunker.StreamReader(url.Path, key, obj)
+}
+
+var _ FileStore = (*blobFiles)(nil)
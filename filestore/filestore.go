@@ -14,7 +14,7 @@ import (
 )
 
 // FileStore represents a file or directory of files that are either stored
-// locally or on minio/s3
+// locally, on minio/s3, or on Azure Blob Storage or Google Cloud Storage
 type FileStore interface {
 	// Similar to os.Open
 	Open(path string) (io.ReadCloser, error)
@@ -23,7 +23,8 @@ type FileStore interface {
 	ChunkReader(file string, key x.Sensitive) (*bufio.Reader, func())
 }
 
-// NewFileStore returns a new file storage. If remote, it's backed by an x.MinioClient
+// NewFileStore returns a new file storage. If remote, it's backed by an x.MinioClient, or by an
+// x.BlobClient for the azure and gs schemes
 func NewFileStore(path string) FileStore {
 	url, err := url.Parse(path)
 	x.Check(err)
@@ -34,6 +35,12 @@ func NewFileStore(path string) FileStore {
 
 		return &remoteFiles{mc}
 	}
+	if x.IsBlobScheme(url.Scheme) {
+		client, err := x.NewBlobClient(url, nil)
+		x.Check(err)
+
+		return &blobFiles{client}
+	}
 
 	return &localFiles{}
 }
@@ -46,6 +46,7 @@ require (
 	github.com/spf13/pflag v1.0.10
 	github.com/spf13/viper v1.21.0
 	github.com/stretchr/testify v1.11.1
+	github.com/tetratelabs/wazero v1.11.0
 	github.com/twpayne/go-geom v1.6.1
 	github.com/viterin/vek v0.4.3
 	github.com/xdg/scram v1.0.5
@@ -487,6 +487,7 @@ var (
 		"state":        minimalAdminQryMWs, // dgraph checks Guardian auth for state
 		"config":       gogQryMWs,
 		"listBackups":  gogQryMWs,
+		"verifyBackup": gogQryMWs,
 		"getGQLSchema": stdAdminQryMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
@@ -495,23 +496,37 @@ var (
 		"getUser":        minimalAdminQryMWs,
 		"getCurrentUser": minimalAdminQryMWs,
 		"getGroup":       minimalAdminQryMWs,
+		// stored queries are stored per namespace, so guardians of any namespace can manage them
+		"listStoredQueries": stdAdminQryMWs,
+		// requests of other namespaces are only visible to the guardians of the galaxy
+		"runningRequests":   stdAdminQryMWs,
+		"indexBuilds":       stdAdminQryMWs,
+		"getNamespaceQuota": gogQryMWs,
 	}
 	adminMutationMWConfig = map[string]resolve.MutationMiddlewares{
-		"backup":          gogMutMWs,
-		"config":          gogMutMWs,
-		"draining":        gogMutMWs,
-		"export":          stdAdminMutMWs, // dgraph handles the export for other namespaces by superadmin
-		"login":           minimalAdminMutMWs,
-		"restore":         gogMutMWs,
-		"restoreTenant":   gogMutMWs,
-		"shutdown":        gogMutMWs,
-		"removeNode":      gogMutMWs,
-		"moveTablet":      gogMutMWs,
-		"assign":          gogMutMWs,
-		"updateGQLSchema": stdAdminMutMWs,
-		"addNamespace":    gogAclMutMWs,
-		"deleteNamespace": gogAclMutMWs,
-		"resetPassword":   gogAclMutMWs,
+		"backup":               gogMutMWs,
+		"config":               gogMutMWs,
+		"draining":             gogMutMWs,
+		"export":               stdAdminMutMWs, // dgraph handles the export for other namespaces by superadmin
+		"login":                minimalAdminMutMWs,
+		"restore":              gogMutMWs,
+		"restoreTenant":        gogMutMWs,
+		"restorePredicates":    gogMutMWs,
+		"shutdown":             gogMutMWs,
+		"removeNode":           gogMutMWs,
+		"moveTablet":           gogMutMWs,
+		"assign":               gogMutMWs,
+		"updateGQLSchema":      stdAdminMutMWs,
+		"addNamespace":         gogAclMutMWs,
+		"deleteNamespace":      gogAclMutMWs,
+		"resetPassword":        gogAclMutMWs,
+		"updateNamespaceQuota": gogAclMutMWs,
+		"storeQuery":           stdAdminMutMWs,
+		"deleteStoredQuery":    stdAdminMutMWs,
+		"cancelRequest":        stdAdminMutMWs,
+		"rotateEncryptionKey":  gogMutMWs,
+		"checkConsistency":     stdAdminMutMWs,
+		"updateIndexBuild":     stdAdminMutMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"addUser":     minimalAdminMutMWs,
@@ -729,20 +744,28 @@ func newAdminResolver(
 
 func newAdminResolverFactory() resolve.ResolverFactory {
 	adminMutationResolvers := map[string]resolve.MutationResolverFunc{
-		"addNamespace":    resolveAddNamespace,
-		"backup":          resolveBackup,
-		"config":          resolveUpdateConfig,
-		"deleteNamespace": resolveDeleteNamespace,
-		"draining":        resolveDraining,
-		"export":          resolveExport,
-		"login":           resolveLogin,
-		"resetPassword":   resolveResetPassword,
-		"restore":         resolveRestore,
-		"shutdown":        resolveShutdown,
-		"removeNode":      resolveRemoveNode,
-		"moveTablet":      resolveMoveTablet,
-		"assign":          resolveAssign,
-		"restoreTenant":   resolveTenantRestore,
+		"addNamespace":         resolveAddNamespace,
+		"backup":               resolveBackup,
+		"config":               resolveUpdateConfig,
+		"deleteNamespace":      resolveDeleteNamespace,
+		"draining":             resolveDraining,
+		"export":               resolveExport,
+		"login":                resolveLogin,
+		"resetPassword":        resolveResetPassword,
+		"restore":              resolveRestore,
+		"shutdown":             resolveShutdown,
+		"removeNode":           resolveRemoveNode,
+		"moveTablet":           resolveMoveTablet,
+		"assign":               resolveAssign,
+		"restoreTenant":        resolveTenantRestore,
+		"restorePredicates":    resolvePredicatesRestore,
+		"storeQuery":           resolveStoreQuery,
+		"deleteStoredQuery":    resolveDeleteStoredQuery,
+		"cancelRequest":        resolveCancelRequest,
+		"rotateEncryptionKey":  resolveRotateEncryptionKey,
+		"checkConsistency":     resolveCheckConsistency,
+		"updateIndexBuild":     resolveUpdateIndexBuild,
+		"updateNamespaceQuota": resolveUpdateNamespaceQuota,
 	}
 
 	rf := resolverFactoryWithErrorMsg(errResolverNotFound).
@@ -760,9 +783,24 @@ func newAdminResolverFactory() resolve.ResolverFactory {
 		WithQueryResolver("listBackups", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(resolveListBackups)
 		}).
+		WithQueryResolver("verifyBackup", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveVerifyBackup)
+		}).
 		WithQueryResolver("task", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(resolveTask)
 		}).
+		WithQueryResolver("listStoredQueries", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveListStoredQueries)
+		}).
+		WithQueryResolver("runningRequests", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveRunningRequests)
+		}).
+		WithQueryResolver("indexBuilds", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveIndexBuilds)
+		}).
+		WithQueryResolver("getNamespaceQuota", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveGetNamespaceQuota)
+		}).
 		WithQueryResolver("getGQLSchema", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(
 				func(ctx context.Context, query schema.Query) *resolve.Resolved {
@@ -62,19 +62,34 @@ func TestAdminMutationMiddlewareConfig(t *testing.T) {
 			ipWhitelist:    true,
 			superAdminAuth: true,
 		},
+		"restorePredicates": {desc: "selective backup restore into the running cluster",
+			ipWhitelist: true, superAdminAuth: true},
 		"shutdown":   {desc: "node shutdown", ipWhitelist: true, superAdminAuth: true},
 		"removeNode": {desc: "cluster topology change", ipWhitelist: true, superAdminAuth: true},
 		"moveTablet": {desc: "tablet relocation", ipWhitelist: true, superAdminAuth: true},
 		"assign":     {desc: "UID/timestamp assignment", ipWhitelist: true, superAdminAuth: true},
+		"rotateEncryptionKey": {desc: "encryption key rotation", ipWhitelist: true,
+			superAdminAuth: true},
 
 		// Superadmin + ACL — namespace lifecycle mutations.
 		"addNamespace":    {desc: "namespace creation", ipWhitelist: true, superAdminAuth: true, aclOnly: true},
 		"deleteNamespace": {desc: "namespace deletion", ipWhitelist: true, superAdminAuth: true, aclOnly: true},
 		"resetPassword":   {desc: "password reset", ipWhitelist: true, superAdminAuth: true, aclOnly: true},
+		"updateNamespaceQuota": {desc: "namespace quota update", ipWhitelist: true,
+			superAdminAuth: true, aclOnly: true},
 
 		// Guardian auth — standard admin operations.
 		"export":          {desc: "data export", ipWhitelist: true, guardianAuth: true},
 		"updateGQLSchema": {desc: "GraphQL schema update", ipWhitelist: true, guardianAuth: true},
+		"storeQuery":      {desc: "stored query registration", ipWhitelist: true, guardianAuth: true},
+		"deleteStoredQuery": {desc: "stored query deletion", ipWhitelist: true,
+			guardianAuth: true},
+		"cancelRequest": {desc: "running request cancellation", ipWhitelist: true,
+			guardianAuth: true},
+		"checkConsistency": {desc: "index consistency check and repair", ipWhitelist: true,
+			guardianAuth: true},
+		"updateIndexBuild": {desc: "index build control", ipWhitelist: true,
+			guardianAuth: true},
 
 		// Minimal (IP whitelist + logging only) — dgraph handles auth internally for these.
 		"login":       {desc: "login (auth handled internally)", ipWhitelist: true},
@@ -21,6 +21,13 @@ import (
 type backupInput struct {
 	DestinationFields
 	ForceFull bool
+	Retention *backupRetentionInput
+}
+
+type backupRetentionInput struct {
+	KeepLast    uint32
+	KeepDaily   uint32
+	KeepMonthly uint32
 }
 
 func resolveBackup(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
@@ -43,6 +50,16 @@ func resolveBackup(ctx context.Context, m schema.Mutation) (*resolve.Resolved, b
 		Anonymous:    input.Anonymous,
 		ForceFull:    input.ForceFull,
 	}
+	if r := input.Retention; r != nil {
+		req.Retention = &pb.BackupRetention{
+			KeepLast:    r.KeepLast,
+			KeepDaily:   r.KeepDaily,
+			KeepMonthly: r.KeepMonthly,
+		}
+	}
+	if err := worker.ValidateRetention(req.Retention); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
 	taskId, err := worker.Tasks.Enqueue(req)
 	if err != nil {
 		return resolve.EmptyResult(m, err), false
@@ -0,0 +1,141 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v25/graphql/resolve"
+	"github.com/dgraph-io/dgraph/v25/graphql/schema"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/worker"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// maxReportedIssues is the number of inconsistent keys returned by checkConsistency. All of them
+// are repaired though.
+const maxReportedIssues = 1000
+
+type consistencyInput struct {
+	Predicate string
+	ReadTs    uint64
+	Repair    bool
+}
+
+func resolveCheckConsistency(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, err := getConsistencyInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	if input.Predicate == "" {
+		return resolve.EmptyResult(m, errors.New("predicate is missing")), false
+	}
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	glog.Infof("Got consistency check request for %s through GraphQL admin API", input.Predicate)
+
+	resp, err := worker.CheckConsistencyOverNetwork(ctx, &pb.ConsistencyCheckRequest{
+		Predicate: x.NamespaceAttr(ns, input.Predicate),
+		ReadTs:    input.ReadTs,
+		Repair:    input.Repair,
+	})
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	issues := make([]map[string]interface{}, 0, len(resp.Issues))
+	for _, issue := range resp.Issues {
+		if len(issues) == maxReportedIssues {
+			break
+		}
+		issues = append(issues, issueToMap(issue))
+	}
+	unrepaired := make([]map[string]interface{}, 0, len(resp.Unrepaired))
+	for _, issue := range resp.Unrepaired {
+		if len(unrepaired) == maxReportedIssues {
+			break
+		}
+		unrepaired = append(unrepaired, issueToMap(issue))
+	}
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): map[string]interface{}{
+			"readTs":           json.Number(strconv.FormatUint(resp.ReadTs, 10)),
+			"dataKeys":         json.Number(strconv.FormatUint(resp.DataKeys, 10)),
+			"keys":             json.Number(strconv.FormatUint(resp.Keys, 10)),
+			"inconsistentKeys": len(resp.Issues),
+			"repaired":         resp.Repaired,
+			"issues":           issues,
+			"unrepaired":       unrepaired,
+		}},
+		nil,
+	), true
+}
+
+func issueToMap(issue *pb.ConsistencyIssue) map[string]interface{} {
+	toHex := func(uids []uint64) []string {
+		out := make([]string, 0, len(uids))
+		for _, uid := range uids {
+			out = append(out, fmt.Sprintf("%#x", uid))
+		}
+		return out
+	}
+	out := map[string]interface{}{
+		"key":     hex.EncodeToString(issue.Key),
+		"missing": toHex(issue.Missing),
+		"extra":   toHex(issue.Extra),
+	}
+	pk, err := x.Parse(issue.Key)
+	if err != nil {
+		return out
+	}
+	switch {
+	case pk.IsIndex():
+		out["kind"] = "index"
+		out["term"] = hex.EncodeToString([]byte(pk.Term))
+	case pk.IsReverse():
+		out["kind"] = "reverse"
+		out["uid"] = fmt.Sprintf("%#x", pk.Uid)
+	case pk.IsCountRev():
+		out["kind"] = "reverseCount"
+		out["count"] = pk.Count
+	case pk.IsCount():
+		out["kind"] = "count"
+		out["count"] = pk.Count
+	}
+	return out
+}
+
+func getConsistencyInput(m schema.Mutation) (*consistencyInput, error) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input to map"))
+	}
+
+	input := &consistencyInput{}
+	if input.Predicate, ok = inputArg["predicate"].(string); !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input.predicate to string"))
+	}
+	if val, ok := inputArg["readTs"]; ok {
+		readTs, err := parseAsUint64(val)
+		if err != nil {
+			return nil, inputArgError(schema.GQLWrapf(err,
+				"can't convert input.readTs to uint64"))
+		}
+		input.ReadTs = readTs
+	}
+	input.Repair, _ = inputArg["repair"].(bool)
+	return input, nil
+}
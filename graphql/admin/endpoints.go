@@ -9,22 +9,25 @@ const adminTypes = `
 	input BackupInput {
 
 		"""
-		Destination for the backup: e.g. Minio or S3 bucket.
+		Destination for the backup: e.g. Minio or S3 bucket, Azure Blob Storage container
+		(azure://) or Google Cloud Storage bucket (gs://).
 		"""
 		destination: String!
 
 		"""
-		Access key credential for the destination.
+		Access key credential for the destination. For Azure, the storage account name.
 		"""
 		accessKey: String
 
 		"""
-		Secret key credential for the destination.
+		Secret key credential for the destination. For Azure, the account key. For GCS, the
+		JSON key of a service account.
 		"""
 		secretKey: String
 
 		"""
-		AWS session token, if required.
+		AWS session token, if required. For Azure, a SAS token. For GCS, an OAuth 2.0 access
+		token.
 		"""
 		sessionToken: String
 
@@ -37,6 +40,30 @@ const adminTypes = `
 		Force a full backup instead of an incremental backup.
 		"""
 		forceFull: Boolean
+
+		"""
+		Retention policy applied to the destination once the backup completes. The backups
+		that it doesn't keep are deleted.
+		"""
+		retention: BackupRetentionInput
+	}
+
+	input BackupRetentionInput {
+		"""
+		Number of latest backup series kept whole. The latest series is always kept.
+		"""
+		keepLast: Int
+
+		"""
+		Number of days, including today, for which the latest backup of each day is kept.
+		"""
+		keepDaily: Int
+
+		"""
+		Number of months, including the current one, for which the latest backup of each
+		month is kept.
+		"""
+		keepMonthly: Int
 	}
 
 	type BackupPayload {
@@ -57,6 +84,48 @@ const adminTypes = `
 	   fromNamespace: Int!
 	}
 
+	input RestoredNameInput {
+		"""
+		Name of the predicate or type in the backup.
+		"""
+		name: String!
+
+		"""
+		Name under which the predicate or type is restored. Defaults to its name in the backup.
+		"""
+		restoreAs: String
+	}
+
+	input RestorePredicatesInput {
+		"""
+		restoreInput contains the location and the backups to restore. It can't be an
+		incremental or a point-in-time restore.
+		"""
+		restoreInput: RestoreInput!
+
+		"""
+		Predicates to restore. Their current data in the cluster is replaced by the data
+		in the backup.
+		"""
+		predicates: [RestoredNameInput!]
+
+		"""
+		Types to restore. The predicates of a type are restored with it, under the name
+		given in predicates if any.
+		"""
+		types: [RestoredNameInput!]
+
+		"""
+		Namespace of the backup from which the predicates and types are read. Defaults to 0.
+		"""
+		fromNamespace: Int
+
+		"""
+		Namespace into which the predicates and types are restored. Defaults to 0.
+		"""
+		toNamespace: Int
+	}
+
 	input RestoreInput {
 
 		"""
@@ -84,6 +153,19 @@ const adminTypes = `
 		"""
 		incrementalFrom: Int
 
+		"""
+		Restore the state of the cluster at this timestamp: the latest backups taken before it are
+		restored, then the commits archived after them with --wal-archive are replayed up to it.
+		Cannot be used with backupNum.
+		"""
+		restoreToTs: Int64
+
+		"""
+		Restore the state of the cluster at this time, like restoreToTs. The time of an archived
+		commit is the time at which its group applied it.
+		"""
+		restoreToTime: DateTime
+
 		"""
 		If isPartial is set to true then the cluster will be kept in draining mode after
 		restore. This makes sure that the db is not corrupted by any mutations or tablet
@@ -211,6 +293,110 @@ const adminTypes = `
 		fullManifest: Boolean
 	}
 
+	input VerifyBackupInput {
+		"""
+		Location of the backups: e.g. Minio or S3 bucket. The encrypted backups are decrypted
+		with the encryption key of the Alpha.
+		"""
+		location: String!
+
+		"""
+		ID of the backup series to verify. The latest series is verified if it's not given.
+		"""
+		backupId: String
+
+		"""
+		Access key credential for the location.
+		"""
+		accessKey: String
+
+		"""
+		Secret key credential for the location.
+		"""
+		secretKey: String
+
+		"""
+		AWS session token, if required.
+		"""
+		sessionToken: String
+
+		"""
+		Whether the location doesn't require credentials (e.g. S3 public bucket).
+		"""
+		anonymous: Boolean
+	}
+
+	type BackupFileReport {
+		"""
+		Path to the backup file.
+		"""
+		path: String
+
+		"""
+		Number of the backup holding the file within the backup series.
+		"""
+		backupNum: UInt64
+
+		"""
+		The ID of the group that wrote the file.
+		"""
+		groupId: UInt64
+
+		"""
+		Status of the file, one of ok, missing or corrupted.
+		"""
+		status: String
+
+		"""
+		SHA-256 of the file.
+		"""
+		checksum: String
+
+		"""
+		Whether the checksum was checked against the one recorded in the manifest. The backups
+		taken by older versions don't record it.
+		"""
+		checksumVerified: Boolean
+
+		"""
+		Number of keys read from the file.
+		"""
+		numKeys: UInt64
+
+		"""
+		The problem found in the file, if any.
+		"""
+		error: String
+	}
+
+	type BackupVerification {
+		"""
+		ID of the verified backup series.
+		"""
+		backupId: String
+
+		"""
+		Number of backups in the series.
+		"""
+		numBackups: Int
+
+		"""
+		Whether neither the manifests nor the files of the series have a problem.
+		"""
+		valid: Boolean
+
+		"""
+		The problems found in the chain of manifests, from the full backup through the
+		incremental backups.
+		"""
+		errors: [String]
+
+		"""
+		The report of each backup file of the series.
+		"""
+		files: [BackupFileReport]
+	}
+
 	type BackupGroup {
 		"""
 		The ID of the cluster group.
@@ -456,6 +642,63 @@ const adminTypes = `
 		Enter a new password for groot in that namespace. If you leave it blank, the password will be the default.
 		"""
 		password: String
+
+		"""
+		Quota of the namespace. Requires --limit "namespace-quotas=true;".
+		"""
+		quota: NamespaceQuotaInput
+	}
+
+	"""
+	Limits applied to the requests of a namespace. A missing or zero value means no limit,
+	other than the ones set via the --limit flag.
+	"""
+	input NamespaceQuotaInput {
+		"""
+		On-disk size in bytes after which mutations are rejected. The size is the one last
+		reported by the group leaders, so it can be exceeded by a little.
+		"""
+		storageBytes: Int64
+
+		"""
+		Number of queries accepted per second.
+		"""
+		queriesPerSecond: Int
+
+		"""
+		Number of mutation requests accepted per second.
+		"""
+		mutationsPerSecond: Int
+
+		"""
+		Maximum number of edges a recurse or shortest path query can traverse. Only applies if
+		it's lower than the query-edge limit of the Alphas.
+		"""
+		queryEdgeLimit: Int64
+
+		"""
+		Time after which a query fails, e.g. "30s". Only applies if it's lower than the
+		query-timeout of the Alphas.
+		"""
+		queryTimeout: String
+	}
+
+	input UpdateNamespaceQuotaInput {
+		namespaceId: Int!
+		quota: NamespaceQuotaInput!
+	}
+
+	type NamespaceQuota {
+		namespaceId: UInt64
+		storageBytes: Int64
+		"""
+		On-disk size of the namespace in bytes, as last reported by the group leaders.
+		"""
+		storageUsedBytes: Int64
+		queriesPerSecond: Int
+		mutationsPerSecond: Int
+		queryEdgeLimit: Int64
+		queryTimeout: String
 	}
 
 	input DeleteNamespaceInput {
@@ -478,6 +721,219 @@ const adminTypes = `
 		message: String
 		namespace: UInt64
 	}
+
+	type StoredQuery {
+		name: String!
+		query: String!
+
+		"""
+		ACL groups whose members are allowed to run the query. If empty, anyone can run it.
+		"""
+		groups: [String!]
+	}
+
+	input StoreQueryInput {
+		"""
+		Name used by clients to run the query.
+		"""
+		name: String!
+
+		"""
+		DQL query, variables are declared as usual, e.g. query q($name: string) { ... }
+		"""
+		query: String!
+
+		"""
+		ACL groups whose members are allowed to run the query. Guardians can always run it.
+		"""
+		groups: [String!]
+	}
+
+	type StoredQueryPayload {
+		name: String
+		message: String
+	}
+
+	type RunningRequest {
+		id: String!
+		namespace: UInt64
+		user: String
+		startedAt: DateTime
+		"""
+		Time since the request was received, in milliseconds.
+		"""
+		elapsedMs: Int64
+		"""
+		Hash of the query text, the text itself isn't exposed.
+		"""
+		queryHash: String
+		"""
+		One of parsing, authorizing, processing or mutating.
+		"""
+		stage: String
+		"""
+		One of query, mutation or graphql.
+		"""
+		kind: String
+		"""
+		Address of the Alpha serving the request.
+		"""
+		alpha: String
+	}
+
+	type CancelRequestPayload {
+		id: String
+		message: String
+	}
+
+	input RotateEncryptionKeyInput {
+		"""
+		Path of the file with the new key on every Alpha. If it's not set, the Alphas read the
+		key again from the key file or Vault they were started with.
+		"""
+		keyFile: String
+	}
+
+	type EncryptionKeyVersion {
+		version: UInt64
+		"""
+		Hash identifying the key, recorded in the manifests of the encrypted backups.
+		"""
+		fingerprint: String
+		createdAt: DateTime
+		retiredAt: DateTime
+	}
+
+	type AlphaEncryptionKey {
+		alpha: String
+		current: EncryptionKeyVersion
+		versions: [EncryptionKeyVersion]
+	}
+
+	type RotateEncryptionKeyPayload {
+		response: Response
+		alphas: [AlphaEncryptionKey]
+	}
+
+	input CheckConsistencyInput {
+		predicate: String!
+		"""
+		Timestamp to check the predicate at, the latest one if not set.
+		"""
+		readTs: UInt64
+		"""
+		Write the deltas that fix the inconsistent keys, and check the repaired keys again once
+		they're applied. readTs can't be set.
+		"""
+		repair: Boolean
+	}
+
+	type ConsistencyIssue {
+		"""
+		One of index, reverse, count or reverseCount.
+		"""
+		kind: String
+		"""
+		Hex of the inconsistent key.
+		"""
+		key: String
+		"""
+		Hex of the token, for index keys.
+		"""
+		term: String
+		"""
+		Object of the reverse edges, for reverse keys.
+		"""
+		uid: String
+		count: Int
+		"""
+		UIDs that the key should hold and doesn't.
+		"""
+		missing: [String]
+		"""
+		UIDs that the key holds and shouldn't.
+		"""
+		extra: [String]
+	}
+
+	type ConsistencyReport {
+		readTs: UInt64
+		"""
+		Number of data keys scanned.
+		"""
+		dataKeys: UInt64
+		"""
+		Number of index, reverse and count keys checked.
+		"""
+		keys: UInt64
+		inconsistentKeys: Int
+		"""
+		Whether every inconsistent key was found consistent when checked after the repair.
+		"""
+		repaired: Boolean
+		"""
+		The first 1000 inconsistent keys.
+		"""
+		issues: [ConsistencyIssue]
+		"""
+		The first 1000 repaired keys that were still inconsistent when checked again.
+		"""
+		unrepaired: [ConsistencyIssue]
+	}
+
+	type IndexBuild {
+		predicate: String
+		namespace: UInt64
+		"""
+		The index being built: tokens, reverse, count, reverse count or facets.
+		"""
+		phase: String
+		"""
+		Step 1 reads the data of the predicate, step 2 writes the index built from it.
+		"""
+		step: Int
+		keysProcessed: UInt64
+		"""
+		Number of keys to process in the step, 0 while they are being counted.
+		"""
+		totalKeys: UInt64
+		startedAt: DateTime
+		stepStartedAt: DateTime
+		"""
+		Estimated time left in the step, in milliseconds. Not set if unknown.
+		"""
+		etaMs: Int64
+		paused: Boolean
+		"""
+		Rate limit of the build, 0 if it isn't limited.
+		"""
+		keysPerSecond: UInt64
+		"""
+		Address of the Alpha building the index. Every replica of a group builds its own indexes.
+		"""
+		alpha: String
+	}
+
+	enum IndexBuildAction {
+		PAUSE
+		RESUME
+		CANCEL
+		RATE_LIMIT
+	}
+
+	input UpdateIndexBuildInput {
+		predicate: String!
+		action: IndexBuildAction!
+		"""
+		Keys processed per second by each replica, for RATE_LIMIT. 0 removes the limit.
+		"""
+		keysPerSecond: UInt64
+	}
+
+	type UpdateIndexBuildPayload {
+		predicate: String
+		message: String
+	}
 	`
 
 const adminMutations = `
@@ -497,6 +953,13 @@ const adminMutations = `
 	"""
 	restoreTenant(input: RestoreTenantInput!) : RestorePayload
 
+	"""
+	Restore some predicates and types of a binary backup into the running cluster, leaving
+	the other predicates untouched. It returns once they're restored, and fails if any group or
+	the update of the types fails.
+	"""
+	restorePredicates(input: RestorePredicatesInput!) : RestorePayload
+
 	"""
 	Login to Dgraph.  Successful login results in a JWT that can be used in future requests.
 	If login is not successful an error is returned.
@@ -549,6 +1012,52 @@ const adminMutations = `
 	any user in any namespace.
 	"""
 	resetPassword(input: ResetPasswordInput!): ResetPasswordPayload
+
+	"""
+	Set the quota of a namespace, replacing the previous one. Requires
+	--limit "namespace-quotas=true;".
+	"""
+	updateNamespaceQuota(input: UpdateNamespaceQuotaInput!): NamespacePayload
+
+	"""
+	Store a named DQL query in the current namespace, replacing any query with the same name.
+	"""
+	storeQuery(input: StoreQueryInput!): StoredQueryPayload
+
+	"""
+	Delete a stored DQL query from the current namespace.
+	"""
+	deleteStoredQuery(name: String!): StoredQueryPayload
+
+	"""
+	Cancel a running query or mutation. The ID is the one returned by runningRequests.
+	"""
+	cancelRequest(id: String!): CancelRequestPayload
+
+	"""
+	Rotate the encryption key of all the Alphas. New backups, exports, WAL archives and audit
+	logs are encrypted with the new key right away. The data and the Raft WAL are encrypted with
+	a storage key that is re-encrypted with the new key right away too, so the retired key can't
+	read them anymore. The older keys are kept in the keyring of the Alphas, encrypted with the new key, so
+	that the older backups can still be restored. The key is only rotated if every Alpha can read
+	it, and backups are refused while the Alphas have different keys. It can be retried if it
+	failed on some Alphas.
+	"""
+	rotateEncryptionKey(input: RotateEncryptionKeyInput): RotateEncryptionKeyPayload
+
+	"""
+	Check that the index, reverse and count keys of a predicate match its data, and optionally
+	repair the keys that don't.
+	"""
+	checkConsistency(input: CheckConsistencyInput!): ConsistencyReport
+
+	"""
+	Pause, resume, cancel or rate limit the index build of a predicate on all the replicas that
+	serve it. Cancelling the build rolls back the schema change that started it on every replica,
+	including the ones that are done building the indexes. A build can't be cancelled once it's
+	done on the Alpha that proposes the cancel.
+	"""
+	updateIndexBuild(input: UpdateIndexBuildInput!): UpdateIndexBuildPayload
 	`
 
 const adminQueries = `
@@ -567,4 +1076,31 @@ const adminQueries = `
 	Get the information about the backups at a given location.
 	"""
 	listBackups(input: ListBackupsInput!) : [Manifest]
+
+	"""
+	Verify the files of a backup series without restoring it: the chain of manifests, the
+	checksums of the files and whether they can be decrypted, decompressed and read.
+	"""
+	verifyBackup(input: VerifyBackupInput!) : BackupVerification
+
+	"""
+	Get the stored DQL queries of the current namespace.
+	"""
+	listStoredQueries: [StoredQuery]
+
+	"""
+	Get the quota of a namespace, along with its current storage usage.
+	"""
+	getNamespaceQuota(namespaceId: Int!): NamespaceQuota
+
+	"""
+	Get the queries and mutations currently running in the cluster. Only the guardians of the
+	galaxy can see the requests of other namespaces.
+	"""
+	runningRequests: [RunningRequest]
+
+	"""
+	Get the progress of the index builds running in the cluster, one per replica building them.
+	"""
+	indexBuilds: [IndexBuild]
 	`
@@ -0,0 +1,117 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v25/graphql/resolve"
+	"github.com/dgraph-io/dgraph/v25/graphql/schema"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/worker"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+func resolveIndexBuilds(ctx context.Context, q schema.Query) *resolve.Resolved {
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	// The guardians of the galaxy can see the builds of all the namespaces.
+	builds, err := worker.IndexBuildsOverNetwork(ctx, &pb.IndexBuildsRequest{
+		Namespace:     ns,
+		AllNamespaces: ns == x.RootNamespace,
+	})
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	results := make([]map[string]interface{}, 0, len(builds))
+	for _, b := range builds {
+		res := map[string]interface{}{
+			"predicate":     x.ParseAttr(b.Predicate),
+			"namespace":     json.Number(strconv.FormatUint(x.ParseNamespace(b.Predicate), 10)),
+			"phase":         b.Phase,
+			"step":          b.Step,
+			"keysProcessed": json.Number(strconv.FormatUint(b.KeysProcessed, 10)),
+			"totalKeys":     json.Number(strconv.FormatUint(b.TotalKeys, 10)),
+			"startedAt":     time.Unix(0, b.StartedAt).Format(time.RFC3339Nano),
+			"stepStartedAt": time.Unix(0, b.StepStartedAt).Format(time.RFC3339Nano),
+			"paused":        b.Paused,
+			"keysPerSecond": json.Number(strconv.FormatUint(b.KeysPerSec, 10)),
+			"alpha":         b.Alpha,
+		}
+		if b.Eta > 0 {
+			res["etaMs"] = json.Number(strconv.FormatInt(time.Duration(b.Eta).Milliseconds(), 10))
+		}
+		results = append(results, res)
+	}
+	return resolve.DataResult(
+		q,
+		map[string]interface{}{q.Name(): results},
+		nil,
+	)
+}
+
+func resolveUpdateIndexBuild(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	req, err := getIndexBuildControl(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	predicate := req.Predicate
+	req.Predicate = x.NamespaceAttr(ns, predicate)
+	glog.Infof("Got %s request for the index build of %s through GraphQL admin API",
+		req.Action, predicate)
+
+	if err := worker.ControlIndexBuildOverNetwork(ctx, req); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): map[string]interface{}{
+			"predicate": predicate,
+			"message":   "Updated index build successfully",
+		}},
+		nil,
+	), true
+}
+
+func getIndexBuildControl(m schema.Mutation) (*pb.IndexBuildControl, error) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input to map"))
+	}
+
+	req := &pb.IndexBuildControl{}
+	if req.Predicate, ok = inputArg["predicate"].(string); !ok || req.Predicate == "" {
+		return nil, inputArgError(errors.Errorf("can't convert input.predicate to string"))
+	}
+	action, _ := inputArg["action"].(string)
+	val, ok := pb.IndexBuildControl_Action_value[action]
+	if !ok {
+		return nil, inputArgError(errors.Errorf("invalid input.action: %s", action))
+	}
+	req.Action = pb.IndexBuildControl_Action(val)
+	if val, ok := inputArg["keysPerSecond"]; ok {
+		keysPerSec, err := parseAsUint64(val)
+		if err != nil {
+			return nil, inputArgError(schema.GQLWrapf(err,
+				"can't convert input.keysPerSecond to uint64"))
+		}
+		req.KeysPerSec = keysPerSec
+	}
+	return req, nil
+}
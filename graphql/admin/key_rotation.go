@@ -0,0 +1,76 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dgraph-io/dgraph/v25/graphql/resolve"
+	"github.com/dgraph-io/dgraph/v25/graphql/schema"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/worker"
+)
+
+type rotateEncryptionKeyInput struct {
+	KeyFile string
+}
+
+func resolveRotateEncryptionKey(ctx context.Context, m schema.Mutation) (*resolve.Resolved,
+	bool) {
+	var input rotateEncryptionKeyInput
+	if arg := m.ArgValue(schema.InputArgName); arg != nil {
+		b, err := json.Marshal(arg)
+		if err != nil {
+			return resolve.EmptyResult(m, schema.GQLWrapf(err, "couldn't get input argument")),
+				false
+		}
+		if err := json.Unmarshal(b, &input); err != nil {
+			return resolve.EmptyResult(m, schema.GQLWrapf(err, "couldn't get input argument")),
+				false
+		}
+	}
+
+	resps, err := worker.RotateEncryptionKeyOverNetwork(ctx,
+		&pb.RotateKeyRequest{KeyFile: input.KeyFile})
+	alphas := make([]map[string]interface{}, 0, len(resps))
+	for _, resp := range resps {
+		versions := make([]map[string]interface{}, 0, len(resp.Versions))
+		for _, v := range resp.Versions {
+			versions = append(versions, keyVersion(v))
+		}
+		alphas = append(alphas, map[string]interface{}{
+			"alpha":    resp.Alpha,
+			"current":  keyVersion(resp.Current),
+			"versions": versions,
+		})
+	}
+
+	var data map[string]interface{}
+	if err != nil {
+		data = response("Failure", err.Error())
+	} else {
+		data = response("Success", fmt.Sprintf("Rotated the encryption key of %d Alphas",
+			len(resps)))
+	}
+	data["alphas"] = alphas
+	return resolve.DataResult(m, map[string]interface{}{m.Name(): data}, err), err == nil
+}
+
+func keyVersion(v *pb.KeyVersion) map[string]interface{} {
+	res := map[string]interface{}{
+		"version":     json.Number(strconv.FormatUint(v.GetVersion(), 10)),
+		"fingerprint": v.GetFingerprint(),
+		"createdAt":   time.Unix(v.GetCreatedAt(), 0).UTC().Format(time.RFC3339),
+	}
+	if v.GetRetiredAt() != 0 {
+		res["retiredAt"] = time.Unix(v.GetRetiredAt(), 0).UTC().Format(time.RFC3339)
+	}
+	return res
+}
@@ -11,15 +11,31 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/dgraph-io/dgraph/v25/edgraph"
 	"github.com/dgraph-io/dgraph/v25/graphql/resolve"
 	"github.com/dgraph-io/dgraph/v25/graphql/schema"
+	"github.com/dgraph-io/dgraph/v25/worker"
 	"github.com/dgraph-io/dgraph/v25/x"
 )
 
 type addNamespaceInput struct {
 	Password string
+	Quota    *namespaceQuotaInput
+}
+
+type namespaceQuotaInput struct {
+	StorageBytes       json.Number
+	QueriesPerSecond   int64
+	MutationsPerSecond int64
+	QueryEdgeLimit     json.Number
+	QueryTimeout       string
+}
+
+type updateNamespaceQuotaInput struct {
+	NamespaceId int
+	Quota       namespaceQuotaInput
 }
 
 type deleteNamespaceInput struct {
@@ -35,10 +51,21 @@ func resolveAddNamespace(ctx context.Context, m schema.Mutation) (*resolve.Resol
 		// Use the default password, if the user does not specify.
 		req.Password = "password"
 	}
+	var quota edgraph.NamespaceQuota
+	if req.Quota != nil {
+		if quota, err = req.Quota.toQuota(); err != nil {
+			return resolve.EmptyResult(m, err), false
+		}
+	}
 	var ns uint64
 	if ns, err = (&edgraph.Server{}).CreateNamespaceInternal(ctx, req.Password); err != nil {
 		return resolve.EmptyResult(m, err), false
 	}
+	if req.Quota != nil {
+		if err = edgraph.SetNamespaceQuota(ctx, ns, quota); err != nil {
+			return resolve.EmptyResult(m, err), false
+		}
+	}
 	return resolve.DataResult(
 		m,
 		map[string]interface{}{m.Name(): map[string]interface{}{
@@ -98,3 +125,82 @@ func getDeleteNamespaceInput(m schema.Mutation) (*deleteNamespaceInput, error) {
 	err = json.Unmarshal(inputByts, &input)
 	return &input, schema.GQLWrapf(err, "couldn't get input argument")
 }
+
+func (in *namespaceQuotaInput) toQuota() (edgraph.NamespaceQuota, error) {
+	q := edgraph.NamespaceQuota{
+		QueriesPerSec:   in.QueriesPerSecond,
+		MutationsPerSec: in.MutationsPerSecond,
+	}
+	var err error
+	if in.StorageBytes != "" {
+		if q.StorageBytes, err = in.StorageBytes.Int64(); err != nil {
+			return q, fmt.Errorf("invalid storageBytes: %w", err)
+		}
+	}
+	if in.QueryEdgeLimit != "" {
+		if q.QueryEdgeLimit, err = strconv.ParseUint(in.QueryEdgeLimit.String(), 10, 64); err != nil {
+			return q, fmt.Errorf("invalid queryEdgeLimit: %w", err)
+		}
+	}
+	if in.QueryTimeout != "" {
+		if q.QueryTimeout, err = time.ParseDuration(in.QueryTimeout); err != nil {
+			return q, fmt.Errorf("invalid queryTimeout: %w", err)
+		}
+	}
+	return q, nil
+}
+
+func resolveUpdateNamespaceQuota(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	inputArg := m.ArgValue(schema.InputArgName)
+	inputByts, err := json.Marshal(inputArg)
+	if err != nil {
+		return resolve.EmptyResult(m, schema.GQLWrapf(err, "couldn't get input argument")), false
+	}
+	var req updateNamespaceQuotaInput
+	if err := json.Unmarshal(inputByts, &req); err != nil {
+		return resolve.EmptyResult(m, schema.GQLWrapf(err, "couldn't get input argument")), false
+	}
+	quota, err := req.Quota.toQuota()
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	if err := edgraph.SetNamespaceQuota(ctx, uint64(req.NamespaceId), quota); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): map[string]interface{}{
+			"namespaceId": json.Number(strconv.Itoa(req.NamespaceId)),
+			"message":     "Updated namespace quota successfully",
+		}},
+		nil,
+	), true
+}
+
+func resolveGetNamespaceQuota(ctx context.Context, q schema.Query) *resolve.Resolved {
+	b, err := json.Marshal(q.ArgValue("namespaceId"))
+	if err != nil {
+		return resolve.EmptyResult(q, schema.GQLWrapf(err, "couldn't get namespaceId argument"))
+	}
+	var ns uint64
+	if err := json.Unmarshal(b, &ns); err != nil {
+		return resolve.EmptyResult(q, schema.GQLWrapf(err, "couldn't get namespaceId argument"))
+	}
+	quota, err := edgraph.GetNamespaceQuota(ctx, ns)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	if quota == nil {
+		quota = &edgraph.NamespaceQuota{}
+	}
+	res := map[string]interface{}{
+		"namespaceId":        json.Number(strconv.FormatUint(ns, 10)),
+		"storageBytes":       json.Number(strconv.FormatInt(quota.StorageBytes, 10)),
+		"storageUsedBytes":   json.Number(strconv.FormatInt(worker.NamespaceDiskUsage(ns), 10)),
+		"queriesPerSecond":   json.Number(strconv.FormatInt(quota.QueriesPerSec, 10)),
+		"mutationsPerSecond": json.Number(strconv.FormatInt(quota.MutationsPerSec, 10)),
+		"queryEdgeLimit":     json.Number(strconv.FormatUint(quota.QueryEdgeLimit, 10)),
+		"queryTimeout":       quota.QueryTimeout.String(),
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): res}, nil)
+}
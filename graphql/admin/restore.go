@@ -25,6 +25,8 @@ type restoreInput struct {
 	BackupId          string
 	BackupNum         int
 	IncrementalFrom   int
+	RestoreToTs       uint64
+	RestoreToTime     string
 	IsPartial         bool
 	EncryptionKeyFile string
 	AccessKey         string
@@ -44,6 +46,71 @@ type restoreTenantInput struct {
 	FromNamespace uint64
 }
 
+type restoredName struct {
+	Name      string
+	RestoreAs string
+}
+
+type restorePredicatesInput struct {
+	RestoreInput  restoreInput
+	Predicates    []restoredName
+	Types         []restoredName
+	FromNamespace uint64
+	ToNamespace   uint64
+}
+
+func resolvePredicatesRestore(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, err := getRestorePredicatesInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	glog.Infof("Got restore predicates request, location: %v, backupId: %v, backupNum: %v, "+
+		"predicates: %+v, types: %+v, fromNamespace: %d, toNamespace: %d",
+		input.RestoreInput.Location, input.RestoreInput.BackupId, input.RestoreInput.BackupNum,
+		input.Predicates, input.Types, input.FromNamespace, input.ToNamespace)
+
+	selective := &pb.SelectiveRestore{
+		RenamePredicates: make(map[string]string),
+		RenameTypes:      make(map[string]string),
+		ToNamespace:      input.ToNamespace,
+	}
+	for _, p := range input.Predicates {
+		selective.Predicates = append(selective.Predicates, p.Name)
+		if p.RestoreAs != "" && p.RestoreAs != p.Name {
+			selective.RenamePredicates[p.Name] = p.RestoreAs
+		}
+	}
+	for _, t := range input.Types {
+		selective.Types = append(selective.Types, t.Name)
+		if t.RestoreAs != "" && t.RestoreAs != t.Name {
+			selective.RenameTypes[t.Name] = t.RestoreAs
+		}
+	}
+
+	req := pb.RestoreRequest{
+		Location:          input.RestoreInput.Location,
+		BackupId:          input.RestoreInput.BackupId,
+		BackupNum:         uint64(input.RestoreInput.BackupNum),
+		IncrementalFrom:   uint64(input.RestoreInput.IncrementalFrom),
+		RestoreToTs:       input.RestoreInput.RestoreToTs,
+		RestoreToTime:     input.RestoreInput.RestoreToTime,
+		EncryptionKeyFile: input.RestoreInput.EncryptionKeyFile,
+		AccessKey:         input.RestoreInput.AccessKey,
+		SecretKey:         input.RestoreInput.SecretKey,
+		SessionToken:      input.RestoreInput.SessionToken,
+		Anonymous:         input.RestoreInput.Anonymous,
+		VaultAddr:         input.RestoreInput.VaultAddr,
+		VaultRoleidFile:   input.RestoreInput.VaultRoleIDFile,
+		VaultSecretidFile: input.RestoreInput.VaultSecretIDFile,
+		VaultPath:         input.RestoreInput.VaultPath,
+		VaultField:        input.RestoreInput.VaultField,
+		VaultFormat:       input.RestoreInput.VaultFormat,
+		FromNamespace:     input.FromNamespace,
+		Selective:         selective,
+	}
+	return restore(ctx, m, req)
+}
+
 func resolveTenantRestore(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
 	input, err := getRestoreTenantInput(m)
 	if err != nil {
@@ -79,14 +146,17 @@ func resolveRestore(ctx context.Context, m schema.Mutation) (*resolve.Resolved,
 	if err != nil {
 		return resolve.EmptyResult(m, err), false
 	}
-	glog.Infof("Got restore request, location: %v, backupId: %v, backupNum: %v, incrementalFrom: %d, isPartial: %v",
-		input.Location, input.BackupId, input.BackupNum, input.IncrementalFrom, input.IsPartial)
+	glog.Infof("Got restore request, location: %v, backupId: %v, backupNum: %v, incrementalFrom: %d, "+
+		"restoreToTs: %d, restoreToTime: %q, isPartial: %v", input.Location, input.BackupId,
+		input.BackupNum, input.IncrementalFrom, input.RestoreToTs, input.RestoreToTime, input.IsPartial)
 
 	req := pb.RestoreRequest{
 		Location:                input.Location,
 		BackupId:                input.BackupId,
 		BackupNum:               uint64(input.BackupNum),
 		IncrementalFrom:         uint64(input.IncrementalFrom),
+		RestoreToTs:             input.RestoreToTs,
+		RestoreToTime:           input.RestoreToTime,
 		IsPartial:               input.IsPartial,
 		EncryptionKeyFile:       input.EncryptionKeyFile,
 		AccessKey:               input.AccessKey,
@@ -123,11 +193,15 @@ func restore(ctx context.Context, m schema.Mutation, req pb.RestoreRequest) (*re
 		edgraph.InitializeAcl(nil)
 	}()
 
+	message := "Restore operation started."
+	if req.Selective != nil {
+		message = "Restore operation done."
+	}
 	return resolve.DataResult(
 		m,
 		map[string]interface{}{m.Name(): map[string]interface{}{
 			"code":    "Success",
-			"message": "Restore operation started.",
+			"message": message,
 		}},
 		nil,
 	), true
@@ -169,6 +243,24 @@ func getRestoreTenantInput(m schema.Mutation) (*restoreTenantInput, error) {
 	return &input, nil
 }
 
+func getRestorePredicatesInput(m schema.Mutation) (*restorePredicatesInput, error) {
+	inputArg := m.ArgValue(schema.InputArgName)
+	inputByts, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+
+	var input restorePredicatesInput
+	if err := json.Unmarshal(inputByts, &input); err != nil {
+		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+	if err := verifyRestoreInput(input.RestoreInput); err != nil {
+		return nil, err
+	}
+
+	return &input, nil
+}
+
 func verifyRestoreInput(input restoreInput) error {
 	if input.BackupNum < 0 {
 		err := errors.Errorf("backupNum value should be equal or greater than zero")
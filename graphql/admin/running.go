@@ -0,0 +1,67 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v25/edgraph"
+	"github.com/dgraph-io/dgraph/v25/graphql/resolve"
+	"github.com/dgraph-io/dgraph/v25/graphql/schema"
+)
+
+func resolveRunningRequests(ctx context.Context, q schema.Query) *resolve.Resolved {
+	requests, err := edgraph.ListRunningRequests(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	now := time.Now()
+	results := make([]map[string]interface{}, 0, len(requests))
+	for _, req := range requests {
+		started := time.Unix(0, req.StartedAt)
+		results = append(results, map[string]interface{}{
+			"id":        fmt.Sprintf("%#x", req.Id),
+			"namespace": json.Number(strconv.FormatUint(req.Namespace, 10)),
+			"user":      req.User,
+			"startedAt": started.Format(time.RFC3339Nano),
+			"elapsedMs": json.Number(strconv.FormatInt(now.Sub(started).Milliseconds(), 10)),
+			"queryHash": req.QueryHash,
+			"stage":     req.Stage,
+			"kind":      req.Kind,
+			"alpha":     req.Alpha,
+		})
+	}
+	return resolve.DataResult(
+		q,
+		map[string]interface{}{q.Name(): results},
+		nil,
+	)
+}
+
+func resolveCancelRequest(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	idStr, _ := m.ArgValue("id").(string)
+	id, err := strconv.ParseUint(idStr, 0, 64)
+	if err != nil {
+		return resolve.EmptyResult(m, errors.Wrapf(err, "invalid request ID: %s", idStr)), false
+	}
+	if err := edgraph.CancelRunningRequest(ctx, id); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): map[string]interface{}{
+			"id":      idStr,
+			"message": "Cancelled request successfully",
+		}},
+		nil,
+	), true
+}
@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dgraph-io/dgraph/v25/edgraph"
+	"github.com/dgraph-io/dgraph/v25/graphql/resolve"
+	"github.com/dgraph-io/dgraph/v25/graphql/schema"
+)
+
+type storeQueryInput struct {
+	Name   string
+	Query  string
+	Groups []string
+}
+
+func resolveStoreQuery(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, err := getStoreQueryInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	sq := &edgraph.StoredQuery{Name: input.Name, Query: input.Query, Groups: input.Groups}
+	if err := edgraph.StoreQuery(ctx, sq); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): map[string]interface{}{
+			"name":    input.Name,
+			"message": "Stored query successfully",
+		}},
+		nil,
+	), true
+}
+
+func resolveDeleteStoredQuery(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	name, _ := m.ArgValue("name").(string)
+	deleted, err := edgraph.DeleteStoredQuery(ctx, name)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	if !deleted {
+		return resolve.EmptyResult(m, fmt.Errorf("no stored query named %q", name)), false
+	}
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): map[string]interface{}{
+			"name":    name,
+			"message": "Deleted stored query successfully",
+		}},
+		nil,
+	), true
+}
+
+func resolveListStoredQueries(ctx context.Context, q schema.Query) *resolve.Resolved {
+	queries, err := edgraph.ListStoredQueries(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	results := make([]map[string]interface{}, 0, len(queries))
+	for _, sq := range queries {
+		groups := make([]interface{}, 0, len(sq.Groups))
+		for _, g := range sq.Groups {
+			groups = append(groups, g)
+		}
+		results = append(results, map[string]interface{}{
+			"name":   sq.Name,
+			"query":  sq.Query,
+			"groups": groups,
+		})
+	}
+	return resolve.DataResult(
+		q,
+		map[string]interface{}{q.Name(): results},
+		nil,
+	)
+}
+
+func getStoreQueryInput(m schema.Mutation) (*storeQueryInput, error) {
+	inputArg := m.ArgValue(schema.InputArgName)
+	inputByts, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+
+	var input storeQueryInput
+	err = json.Unmarshal(inputByts, &input)
+	return &input, schema.GQLWrapf(err, "couldn't get input argument")
+}
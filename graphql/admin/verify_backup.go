@@ -0,0 +1,112 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v25/graphql/resolve"
+	"github.com/dgraph-io/dgraph/v25/graphql/schema"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/worker"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+type verifyBackupInput struct {
+	Location     string
+	BackupId     string
+	AccessKey    string
+	SecretKey    pb.Sensitive
+	SessionToken pb.Sensitive
+	Anonymous    bool
+}
+
+type backupFileReport struct {
+	Path             string `json:"path,omitempty"`
+	BackupNum        uint64 `json:"backupNum,omitempty"`
+	GroupId          uint32 `json:"groupId,omitempty"`
+	Status           string `json:"status,omitempty"`
+	Checksum         string `json:"checksum,omitempty"`
+	ChecksumVerified bool   `json:"checksumVerified"`
+	NumKeys          uint64 `json:"numKeys"`
+	Error            string `json:"error,omitempty"`
+}
+
+type backupVerification struct {
+	BackupId   string              `json:"backupId,omitempty"`
+	NumBackups int                 `json:"numBackups"`
+	Valid      bool                `json:"valid"`
+	Errors     []string            `json:"errors"`
+	Files      []*backupFileReport `json:"files"`
+}
+
+func resolveVerifyBackup(ctx context.Context, q schema.Query) *resolve.Resolved {
+	input, err := getVerifyBackupInput(q)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	creds := &x.MinioCredentials{
+		AccessKey:    input.AccessKey,
+		SecretKey:    input.SecretKey,
+		SessionToken: input.SessionToken,
+		Anonymous:    input.Anonymous,
+	}
+	report, err := worker.ProcessVerifyBackup(input.Location, input.BackupId, creds,
+		worker.EncryptionKey())
+	if err != nil {
+		return resolve.EmptyResult(q, errors.Errorf("%s: %s", x.Error, err.Error()))
+	}
+
+	res := &backupVerification{
+		BackupId:   report.BackupId,
+		NumBackups: report.NumBackups,
+		Valid:      report.Valid,
+		Errors:     report.Errors,
+		Files:      make([]*backupFileReport, 0, len(report.Files)),
+	}
+	for _, f := range report.Files {
+		res.Files = append(res.Files, &backupFileReport{
+			Path:             f.Path,
+			BackupNum:        f.BackupNum,
+			GroupId:          f.GroupId,
+			Status:           f.Status,
+			Checksum:         f.Checksum,
+			ChecksumVerified: f.ChecksumVerified,
+			NumKeys:          f.NumKeys,
+			Error:            f.Error,
+		})
+	}
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	var result map[string]interface{}
+	if err := schema.Unmarshal(b, &result); err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	return resolve.DataResult(
+		q,
+		map[string]interface{}{q.Name(): result},
+		nil,
+	)
+}
+
+func getVerifyBackupInput(q schema.Query) (*verifyBackupInput, error) {
+	inputArg := q.ArgValue(schema.InputArgName)
+	inputByts, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+
+	var input verifyBackupInput
+	err = json.Unmarshal(inputByts, &input)
+	return &input, schema.GQLWrapf(err, "couldn't get input argument")
+}
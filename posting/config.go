@@ -12,6 +12,9 @@ type Options struct {
 	sync.Mutex
 
 	CommitFraction float64
+	// BitmapBlocks makes the posting lists store their dense containers of UIDs as bitmap
+	// blocks. The older versions can't read them, so it's off by default.
+	BitmapBlocks bool
 }
 
 // Config stores the posting options of this instance.
@@ -0,0 +1,423 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package posting
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/tok"
+	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/x"
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+// Inconsistency is an index, reverse or count key whose uids don't match the data of its
+// predicate.
+type Inconsistency struct {
+	Key []byte
+	// Missing holds the uids that the key should hold and doesn't, and Extra the uids that it
+	// holds and shouldn't.
+	Missing []uint64
+	Extra   []uint64
+
+	// missing holds the postings of the missing uids, with their facets and expiry time.
+	missing []*pb.Posting
+}
+
+// ConsistencyReport is the result of checking the index, reverse and count keys of a predicate.
+type ConsistencyReport struct {
+	Attr   string
+	ReadTs uint64
+	// DataKeys is the number of data keys scanned, and Keys the number of derived keys checked.
+	DataKeys        int
+	Keys            int
+	Inconsistencies []*Inconsistency
+}
+
+// CheckConsistency scans the data keys of the predicate at readTs, recomputes the index, facet
+// index, reverse and count keys that they imply with the tokenizers of the schema, and compares
+// them with the keys stored in db. Vector indexes aren't checked. The expected postings are
+// sorted in a buffer that spills to a file in the tmp directory, and are compared with the
+// stored keys as they're read, so the predicate isn't held in memory.
+func CheckConsistency(ctx context.Context, db *badger.DB, attr string,
+	readTs uint64) (*ConsistencyReport, error) {
+	su, ok := schema.State().Get(ctx, attr)
+	if !ok {
+		return nil, errors.Errorf("predicate %s has no schema", x.ParseAttr(attr))
+	}
+	c := &consistencyChecker{
+		attr:       attr,
+		readTs:     readTs,
+		db:         db,
+		tokenizers: schema.State().Tokenizer(ctx, attr),
+		facets:     schema.State().IndexedFacets(ctx, attr),
+		reverse:    schema.State().IsReversed(ctx, attr),
+		count:      schema.State().HasCount(ctx, attr),
+		typ:        types.TypeID(su.ValueType),
+		expected: z.NewBuffer(64<<20, "CheckConsistency").
+			WithAutoMmap(1<<30, x.WorkerConfig.TmpDir),
+		report: &ConsistencyReport{Attr: attr, ReadTs: readTs},
+	}
+	defer func() { _ = c.expected.Release() }()
+
+	pk := x.ParsedKey{Attr: attr}
+	if err := c.iterate(ctx, pk.DataPrefix(), c.addExpected); err != nil {
+		return nil, err
+	}
+	c.expected.SortSlice(lessExpected)
+	if c.reverse && c.count {
+		// The reverse count keys count the subjects of the expected reverse keys.
+		if err := c.expectReverseCounts(pk.ReversePrefix()); err != nil {
+			return nil, err
+		}
+		c.expected.SortSlice(lessExpected)
+	}
+
+	var prefixes [][]byte
+	if len(c.tokenizers) > 0 || len(c.facets) > 0 {
+		prefixes = append(prefixes, pk.IndexPrefix())
+	}
+	if c.reverse {
+		prefixes = append(prefixes, pk.ReversePrefix())
+	}
+	if c.count {
+		prefixes = append(prefixes, pk.CountPrefix(false), pk.CountPrefix(true))
+	}
+	// The prefixes are sorted, so the stored keys are read in the order of the expected ones.
+	c.next = c.expected.StartOffset()
+	c.nextExpected()
+	for _, prefix := range prefixes {
+		if err := c.iterate(ctx, prefix, c.compare); err != nil {
+			return nil, err
+		}
+	}
+
+	// The keys left were expected and not found.
+	c.missingUntil(nil)
+	return c.report, nil
+}
+
+type consistencyChecker struct {
+	attr       string
+	readTs     uint64
+	db         *badger.DB
+	tokenizers []tok.Tokenizer
+	facets     []*pb.FacetSchema
+	reverse    bool
+	count      bool
+	typ        types.TypeID
+
+	// expected holds an entry for each posting that a derived key should have, made of the
+	// length of the key, the key, the uid and the posting without its uid. It's sorted by key
+	// and uid before the derived keys are compared.
+	expected *z.Buffer
+	// next is the offset of the entry that follows the current group of expected postings, the
+	// ones of key.
+	next     int
+	key      []byte
+	postings []*pb.Posting
+	report   *ConsistencyReport
+}
+
+// iterate calls fn with the posting list of each key with the prefix, at the read timestamp.
+func (c *consistencyChecker) iterate(ctx context.Context, prefix []byte,
+	fn func(key []byte, pk x.ParsedKey, l *List) error) error {
+	txn := c.db.NewTransactionAt(c.readTs, false)
+	defer txn.Discard()
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.AllVersions = true
+	itOpt.Prefix = prefix
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	var lastKey []byte
+	for it.Rewind(); it.Valid(); {
+		// ReadPostingList stops at a complete posting list, skip its older versions.
+		if bytes.Equal(lastKey, it.Item().Key()) {
+			it.Next()
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		key := it.Item().KeyCopy(nil)
+		lastKey = key
+		pk, err := x.Parse(key)
+		if err != nil {
+			return err
+		}
+		l, err := ReadPostingList(key, it)
+		if err != nil {
+			return err
+		}
+		if err := fn(key, pk, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *consistencyChecker) expect(key []byte, p *pb.Posting) error {
+	uid := p.Uid
+	p.Uid = 0
+	data, err := proto.Marshal(p)
+	if err != nil {
+		return err
+	}
+	entry := c.expected.SliceAllocate(2 + len(key) + 8 + len(data))
+	binary.BigEndian.PutUint16(entry, uint16(len(key)))
+	n := 2 + copy(entry[2:], key)
+	binary.BigEndian.PutUint64(entry[n:], uid)
+	copy(entry[n+8:], data)
+	return nil
+}
+
+func entryKey(entry []byte) []byte {
+	return entry[2 : 2+binary.BigEndian.Uint16(entry)]
+}
+
+func entryUid(entry []byte) uint64 {
+	return binary.BigEndian.Uint64(entry[2+binary.BigEndian.Uint16(entry):])
+}
+
+func lessExpected(left, right []byte) bool {
+	if cmp := bytes.Compare(entryKey(left), entryKey(right)); cmp != 0 {
+		return cmp < 0
+	}
+	return entryUid(left) < entryUid(right)
+}
+
+// nextExpected loads the next group of expected postings, leaving key nil at the end.
+func (c *consistencyChecker) nextExpected() {
+	c.key, c.postings = nil, nil
+	for c.next >= 0 {
+		entry, next := c.expected.Slice(c.next)
+		if len(entry) == 0 {
+			c.next = next
+			continue
+		}
+		if c.key != nil && !bytes.Equal(c.key, entryKey(entry)) {
+			return
+		}
+		c.next = next
+		if c.key == nil {
+			c.key = bytes.Clone(entryKey(entry))
+		}
+		uid := entryUid(entry)
+		// The values of a node can share tokens.
+		if n := len(c.postings); n > 0 && c.postings[n-1].Uid == uid {
+			continue
+		}
+		p := &pb.Posting{}
+		x.Check(proto.Unmarshal(entry[2+len(c.key)+8:], p))
+		p.Uid = uid
+		c.postings = append(c.postings, p)
+	}
+}
+
+// expectReverseCounts adds the reverse count keys of the sorted expected reverse keys.
+func (c *consistencyChecker) expectReverseCounts(prefix []byte) error {
+	// The counts are added once the expected postings are read, as adding them would move the
+	// buffer.
+	counts := z.NewBuffer(1<<20, "CheckConsistency.Counts")
+	defer func() { _ = counts.Release() }()
+	var key []byte
+	var uid uint64
+	n := 0
+	addCount := func() error {
+		if n == 0 {
+			return nil
+		}
+		rk, err := x.Parse(key)
+		if err != nil {
+			return err
+		}
+		entry := counts.SliceAllocate(12)
+		binary.BigEndian.PutUint64(entry, rk.Uid)
+		binary.BigEndian.PutUint32(entry[8:], uint32(n))
+		return nil
+	}
+	err := c.expected.SliceIterate(func(entry []byte) error {
+		if !bytes.HasPrefix(entryKey(entry), prefix) {
+			return nil
+		}
+		if !bytes.Equal(key, entryKey(entry)) {
+			if err := addCount(); err != nil {
+				return err
+			}
+			key, n = bytes.Clone(entryKey(entry)), 0
+		}
+		if n == 0 || entryUid(entry) != uid {
+			uid = entryUid(entry)
+			n++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := addCount(); err != nil {
+		return err
+	}
+	return counts.SliceIterate(func(entry []byte) error {
+		return c.expect(x.CountKey(c.attr, binary.BigEndian.Uint32(entry[8:]), true),
+			&pb.Posting{Uid: binary.BigEndian.Uint64(entry)})
+	})
+}
+
+// addExpected adds the derived keys implied by the data key.
+func (c *consistencyChecker) addExpected(_ []byte, pk x.ParsedKey, l *List) error {
+	c.report.DataKeys++
+	err := l.Iterate(c.readTs, 0, func(p *pb.Posting) error {
+		if len(c.facets) > 0 {
+			tokens, err := facetTokens(c.facets, p.Facets)
+			if err == nil {
+				for _, token := range tokens {
+					if err := c.expect(x.IndexKey(c.attr, token),
+						&pb.Posting{Uid: pk.Uid}); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		switch {
+		case c.typ == types.UidID:
+			if c.reverse {
+				return c.expect(x.ReverseKey(c.attr, p.Uid), &pb.Posting{Uid: pk.Uid,
+					Facets: p.Facets, ExpiresAt: p.ExpiresAt})
+			}
+		case len(c.tokenizers) > 0:
+			sv, err := types.Convert(valueToTypesVal(p), c.typ)
+			if err != nil {
+				// The value isn't indexable.
+				return nil
+			}
+			for _, it := range c.tokenizers {
+				tokens, err := tok.BuildTokens(sv.Value,
+					tok.GetTokenizerForLang(it, string(p.LangTag)))
+				if err != nil {
+					return nil
+				}
+				for _, token := range tokens {
+					if err := c.expect(x.IndexKey(c.attr, token), &pb.Posting{Uid: pk.Uid,
+						ExpiresAt: p.ExpiresAt}); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if c.count {
+		if n := l.Length(c.readTs, 0); n > 0 {
+			return c.expect(x.CountKey(c.attr, uint32(n), false), &pb.Posting{Uid: pk.Uid})
+		}
+	}
+	return nil
+}
+
+// missingUntil reports the expected keys before the key as missing, or all of them if key is nil.
+func (c *consistencyChecker) missingUntil(key []byte) {
+	for c.key != nil && (key == nil || bytes.Compare(c.key, key) < 0) {
+		c.report.Inconsistencies = append(c.report.Inconsistencies,
+			newInconsistency(c.key, c.postings, nil))
+		c.nextExpected()
+	}
+}
+
+// compare compares the uids of the derived key with the expected ones.
+func (c *consistencyChecker) compare(key []byte, _ x.ParsedKey, l *List) error {
+	c.report.Keys++
+	var uids []uint64
+	if err := l.Iterate(c.readTs, 0, func(p *pb.Posting) error {
+		uids = append(uids, p.Uid)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	c.missingUntil(key)
+	var expected []*pb.Posting
+	if bytes.Equal(c.key, key) {
+		expected = c.postings
+		c.nextExpected()
+	}
+	if inc := newInconsistency(key, expected, uids); inc != nil {
+		c.report.Inconsistencies = append(c.report.Inconsistencies, inc)
+	}
+	return nil
+}
+
+// newInconsistency returns the differences between the expected postings and the stored uids of
+// the key, or nil if there are none.
+func newInconsistency(key []byte, expected []*pb.Posting, uids []uint64) *Inconsistency {
+	inc := &Inconsistency{Key: key}
+	i, j := 0, 0
+	for i < len(expected) || j < len(uids) {
+		switch {
+		case j == len(uids) || (i < len(expected) && expected[i].Uid < uids[j]):
+			inc.Missing = append(inc.Missing, expected[i].Uid)
+			inc.missing = append(inc.missing, expected[i])
+			i++
+		case i == len(expected) || expected[i].Uid > uids[j]:
+			inc.Extra = append(inc.Extra, uids[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	if len(inc.Missing) == 0 && len(inc.Extra) == 0 {
+		return nil
+	}
+	return inc
+}
+
+// RepairKVs returns the deltas that fix the inconsistencies of the report, to be written at the
+// given version.
+func (r *ConsistencyReport) RepairKVs(version uint64) ([]*bpb.KV, error) {
+	var kvs []*bpb.KV
+	for _, inc := range r.Inconsistencies {
+		plist := &pb.PostingList{}
+		i, j := 0, 0
+		for i < len(inc.missing) || j < len(inc.Extra) {
+			if j == len(inc.Extra) || (i < len(inc.missing) && inc.missing[i].Uid < inc.Extra[j]) {
+				p := NewPosting(&pb.DirectedEdge{ValueId: inc.missing[i].Uid,
+					Facets: inc.missing[i].Facets, ExpiresAt: inc.missing[i].ExpiresAt})
+				plist.Postings = append(plist.Postings, p)
+				i++
+				continue
+			}
+			plist.Postings = append(plist.Postings, NewPosting(&pb.DirectedEdge{
+				ValueId: inc.Extra[j], Op: pb.DirectedEdge_DEL}))
+			j++
+		}
+		data, err := proto.Marshal(plist)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, &bpb.KV{
+			Key:      inc.Key,
+			Value:    data,
+			UserMeta: []byte{BitDeltaPosting},
+			Version:  version,
+		})
+	}
+	return kvs, nil
+}
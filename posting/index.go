@@ -28,11 +28,13 @@ import (
 	"github.com/dgraph-io/badger/v4"
 	"github.com/dgraph-io/badger/v4/options"
 	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/dgraph-io/dgraph/v25/protos/pb"
 	"github.com/dgraph-io/dgraph/v25/schema"
 	"github.com/dgraph-io/dgraph/v25/tok"
 	"github.com/dgraph-io/dgraph/v25/tok/hnsw"
 	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/types/facets"
 	"github.com/dgraph-io/dgraph/v25/x"
 	"github.com/dgraph-io/ristretto/v2/z"
 )
@@ -187,9 +189,10 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 
 	// Create a value token -> uid edge.
 	edge := &pb.DirectedEdge{
-		ValueId: uid,
-		Attr:    attr,
-		Op:      info.op,
+		ValueId:   uid,
+		Attr:      attr,
+		Op:        info.op,
+		ExpiresAt: info.edge.ExpiresAt,
 	}
 
 	for _, token := range tokens {
@@ -283,11 +286,12 @@ func (txn *Txn) addReverseMutation(ctx context.Context, t *pb.DirectedEdge) erro
 
 	// We must create a copy here.
 	edge := &pb.DirectedEdge{
-		Entity:  t.ValueId,
-		ValueId: t.Entity,
-		Attr:    t.Attr,
-		Op:      t.Op,
-		Facets:  t.Facets,
+		Entity:    t.ValueId,
+		ValueId:   t.Entity,
+		Attr:      t.Attr,
+		Op:        t.Op,
+		Facets:    t.Facets,
+		ExpiresAt: t.ExpiresAt,
 	}
 	if err := plist.addMutation(ctx, txn, edge); err != nil {
 		return err
@@ -347,11 +351,12 @@ func (txn *Txn) addReverseAndCountMutation(ctx context.Context, t *pb.DirectedEd
 
 	// We must create a copy here.
 	edge := &pb.DirectedEdge{
-		Entity:  t.ValueId,
-		ValueId: t.Entity,
-		Attr:    t.Attr,
-		Op:      t.Op,
-		Facets:  t.Facets,
+		Entity:    t.ValueId,
+		ValueId:   t.Entity,
+		Attr:      t.Attr,
+		Op:        t.Op,
+		Facets:    t.Facets,
+		ExpiresAt: t.ExpiresAt,
 	}
 
 	cp, err := txn.addReverseMutationHelper(ctx, plist, hasCountIndex, edge)
@@ -588,8 +593,23 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 			" and value: [%v]", edge.Entity, edge.ValueId, edge.Value)
 	}
 
+	var indexedFacets []*pb.FacetSchema
+	var facetTokensBefore map[string]struct{}
+	if pstore != nil {
+		indexedFacets = schema.State().IndexedFacets(ctx, edge.Attr)
+	}
+	if len(indexedFacets) > 0 {
+		var err error
+		if facetTokensBefore, err = l.changedFacetTokens(txn, edge, indexedFacets); err != nil {
+			return err
+		}
+	}
+
 	if edge.Op == pb.DirectedEdge_DEL && string(edge.Value) == x.Star {
-		return l.handleDeleteAll(ctx, edge, txn)
+		if err := l.handleDeleteAll(ctx, edge, txn); err != nil {
+			return err
+		}
+		return l.updateFacetIndex(ctx, txn, edge, indexedFacets, facetTokensBefore)
 	}
 
 	doUpdateIndex := pstore != nil && schema.State().IsIndexed(ctx, edge.Attr)
@@ -640,6 +660,150 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 			}
 		}
 	}
+	return l.updateFacetIndex(ctx, txn, edge, indexedFacets, facetTokensBefore)
+}
+
+// facetTokens returns the index tokens for the values of the indexed facets. Facets whose values
+// can't be converted to the type declared in the schema aren't indexed.
+func facetTokens(indexed []*pb.FacetSchema, fcs []*api.Facet) ([]string, error) {
+	var tokens []string
+	for _, fs := range indexed {
+		for _, f := range fcs {
+			if f.Key != fs.Name {
+				continue
+			}
+			tid, err := facets.TypeIDFor(f)
+			if err != nil {
+				continue
+			}
+			val, err := types.Convert(types.Val{Tid: tid, Value: f.Value}, types.TypeID(fs.ValueType))
+			if err != nil {
+				continue
+			}
+			tokenizer, ok := tok.GetTokenizer(fs.Tokenizer)
+			if !ok {
+				return nil, errors.Errorf("Invalid tokenizer %s for facet %s", fs.Tokenizer, fs.Name)
+			}
+			toks, err := tok.BuildFacetTokens(fs.Name, val.Value, tokenizer)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, toks...)
+		}
+	}
+	return tokens, nil
+}
+
+// facetIndexTokens returns the facet index tokens for all the postings in the list. The facet
+// index maps each token to the subjects that have at least one posting producing it.
+func (l *List) facetIndexTokens(txn *Txn, indexed []*pb.FacetSchema) (map[string]struct{}, error) {
+	tokens := make(map[string]struct{})
+	err := l.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
+		toks, err := facetTokens(indexed, p.Facets)
+		if err != nil {
+			return err
+		}
+		for _, t := range toks {
+			tokens[t] = struct{}{}
+		}
+		return nil
+	})
+	return tokens, err
+}
+
+// changedFacetTokens returns the facet index tokens of the posting the edge replaces or deletes.
+// Deleting all the postings of the list changes all of them.
+func (l *List) changedFacetTokens(txn *Txn, edge *pb.DirectedEdge,
+	indexed []*pb.FacetSchema) (map[string]struct{}, error) {
+	if edge.Op == pb.DirectedEdge_DEL && string(edge.Value) == x.Star {
+		return l.facetIndexTokens(txn, indexed)
+	}
+	uid := edge.ValueId
+	if edge.ValueType != pb.Posting_UID {
+		uid = fingerprintEdge(edge)
+	}
+	l.RLock()
+	found, p, err := l.findPosting(txn.StartTs, uid)
+	l.RUnlock()
+	if err != nil || !found {
+		return nil, err
+	}
+	toks, err := facetTokens(indexed, p.Facets)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make(map[string]struct{}, len(toks))
+	for _, t := range toks {
+		tokens[t] = struct{}{}
+	}
+	return tokens, nil
+}
+
+// updateFacetIndex updates the facet index of the subject of the edge after the edge has been
+// applied to the list, from the tokens of the changed posting before and after the edge. A token
+// the posting no longer produces is removed only if no other posting of the list produces it,
+// which is looked up only for such tokens, and stops once they're all found.
+func (l *List) updateFacetIndex(ctx context.Context, txn *Txn, edge *pb.DirectedEdge,
+	indexed []*pb.FacetSchema, before map[string]struct{}) error {
+	if len(indexed) == 0 {
+		return nil
+	}
+	deleteAll := edge.Op == pb.DirectedEdge_DEL && string(edge.Value) == x.Star
+	after := make(map[string]struct{})
+	if edge.Op != pb.DirectedEdge_DEL {
+		toks, err := facetTokens(indexed, edge.Facets)
+		if err != nil {
+			return err
+		}
+		for _, t := range toks {
+			after[t] = struct{}{}
+		}
+	}
+
+	removed := make(map[string]struct{})
+	for token := range before {
+		if _, ok := after[token]; !ok {
+			removed[token] = struct{}{}
+		}
+	}
+	if len(removed) > 0 && !deleteAll {
+		err := l.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
+			toks, err := facetTokens(indexed, p.Facets)
+			if err != nil {
+				return err
+			}
+			for _, t := range toks {
+				delete(removed, t)
+			}
+			if len(removed) == 0 {
+				return ErrStopIteration
+			}
+			return nil
+		})
+		if err != nil && err != ErrStopIteration {
+			return err
+		}
+	}
+
+	indexEdge := &pb.DirectedEdge{
+		ValueId: edge.Entity,
+		Attr:    edge.Attr,
+		Op:      pb.DirectedEdge_DEL,
+	}
+	for token := range removed {
+		if err := txn.addIndexMutation(ctx, indexEdge, token); err != nil {
+			return err
+		}
+	}
+	indexEdge.Op = pb.DirectedEdge_SET
+	for token := range after {
+		if _, ok := before[token]; ok {
+			continue
+		}
+		if err := txn.addIndexMutation(ctx, indexEdge, token); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -674,6 +838,8 @@ type rebuilder struct {
 	attr    string
 	prefix  []byte
 	startTs uint64
+	// phase is the phase of the index build reported while the rebuilder runs.
+	phase string
 
 	// The posting list passed here is the on disk version. It is not coming
 	// from the LRU cache.
@@ -682,6 +848,10 @@ type rebuilder struct {
 
 func (r *rebuilder) RunWithoutTemp(ctx context.Context) error {
 	ResetCache()
+	build := IndexBuilds.get(r.attr)
+	// The keys of step 1 are counted until it ends.
+	endStep1 := build.setStep(ctx, r.phase, 1, pstore, r.prefix, r.startTs)
+	defer endStep1()
 	stream := pstore.NewStreamAt(r.startTs)
 	stream.LogPrefix = fmt.Sprintf("Rebuilding index for predicate %s (1/2):", r.attr)
 	stream.Prefix = r.prefix
@@ -694,6 +864,9 @@ func (r *rebuilder) RunWithoutTemp(ctx context.Context) error {
 			return nil, ctx.Err()
 		default:
 		}
+		if err := build.wait(ctx); err != nil {
+			return nil, err
+		}
 
 		pk, err := x.Parse(key)
 		if err != nil {
@@ -774,6 +947,8 @@ func (r *rebuilder) RunWithoutTemp(ctx context.Context) error {
 	}
 
 	txn.Update()
+	endStep1()
+	build.setStep(ctx, r.phase, 2, nil, nil, 0)()
 	writer := NewTxnWriter(pstore)
 
 	defer func() {
@@ -925,6 +1100,10 @@ func (r *rebuilder) Run(ctx context.Context) error {
 	// We set it to 1 in case there are no keys found and NewStreamAt is called with ts=0.
 	var counter uint64 = 1
 
+	build := IndexBuilds.get(r.attr)
+	// The keys of step 1 are counted until it ends.
+	endStep1 := build.setStep(ctx, r.phase, 1, pstore, r.prefix, r.startTs)
+	defer endStep1()
 	tmpWriter := tmpDB.NewManagedWriteBatch()
 	stream := pstore.NewStreamAt(r.startTs)
 	stream.LogPrefix = fmt.Sprintf("Rebuilding index for predicate %s (1/2):", r.attr)
@@ -972,6 +1151,9 @@ func (r *rebuilder) Run(ctx context.Context) error {
 			return nil, ctx.Err()
 		default:
 		}
+		if err := build.wait(ctx); err != nil {
+			return nil, err
+		}
 
 		pk, err := x.Parse(key)
 		if err != nil {
@@ -1044,6 +1226,7 @@ func (r *rebuilder) Run(ctx context.Context) error {
 	if err := tmpWriter.Flush(); err != nil {
 		return err
 	}
+	endStep1()
 	glog.V(1).Infof("Rebuilding index for predicate %s: building temp index took: %v\n",
 		r.attr, time.Since(start))
 
@@ -1055,10 +1238,14 @@ func (r *rebuilder) Run(ctx context.Context) error {
 			r.attr, time.Since(start))
 	}()
 
+	defer build.setStep(ctx, r.phase, 2, tmpDB, nil, counter)()
 	writer := pstore.NewManagedWriteBatch()
 	tmpStream := tmpDB.NewStreamAt(counter)
 	tmpStream.LogPrefix = fmt.Sprintf("Rebuilding index for predicate %s (2/2):", r.attr)
 	tmpStream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		if err := build.wait(ctx); err != nil {
+			return nil, err
+		}
 		l, err := ReadPostingList(key, itr)
 		if err != nil {
 			return nil, errors.Wrap(err, "error in reading posting list from pstore")
@@ -1156,6 +1343,19 @@ func (rb *IndexRebuild) GetQuerySchema() *pb.SchemaUpdate {
 	if rb.needsReverseEdgesRebuild() == indexRebuild {
 		querySchema.Directive = pb.SchemaUpdate_NONE
 	}
+	if _, rebuildFacets := rb.needsFacetIndexRebuild(); len(rebuildFacets) > 0 {
+		// The facet indexes being rebuilt can't be used until they are complete.
+		querySchema.Facets = make([]*pb.FacetSchema, 0, len(rb.CurrentSchema.Facets))
+		for _, f := range rb.CurrentSchema.Facets {
+			for _, rf := range rebuildFacets {
+				if f.Name == rf.Name {
+					f = &pb.FacetSchema{Name: f.Name, ValueType: f.ValueType}
+					break
+				}
+			}
+			querySchema.Facets = append(querySchema.Facets, f)
+		}
+	}
 	return &querySchema
 }
 
@@ -1174,6 +1374,7 @@ func (rb *IndexRebuild) DropIndexes(ctx context.Context) error {
 	prefixes = append(prefixes, prefixesToDropReverseEdges(ctx, rb)...)
 	prefixes = append(prefixes, prefixesToDropCountIndex(ctx, rb)...)
 	prefixes = append(prefixes, prefixesToDropVectorIndexEdges(ctx, rb)...)
+	prefixes = append(prefixes, prefixesToDropFacetIndexes(rb)...)
 	if len(prefixes) > 0 {
 		// This trace message now gets logged only if there are any prefixes to
 		// to be deleted
@@ -1191,19 +1392,26 @@ func (rb *IndexRebuild) BuildData(ctx context.Context) error {
 // NeedIndexRebuild returns true if any of the tokenizer, reverse
 // or count indexes need to be rebuilt.
 func (rb *IndexRebuild) NeedIndexRebuild() bool {
+	_, rebuildFacets := rb.needsFacetIndexRebuild()
 	return rb.needsTokIndexRebuild().op == indexRebuild ||
 		rb.needsReverseEdgesRebuild() == indexRebuild ||
-		rb.needsCountIndexRebuild() == indexRebuild
+		rb.needsCountIndexRebuild() == indexRebuild ||
+		len(rebuildFacets) > 0
 }
 
-// BuildIndexes builds indexes.
+// BuildIndexes builds indexes. The build is tracked by IndexBuilds while it runs.
 func (rb *IndexRebuild) BuildIndexes(ctx context.Context) error {
+	ctx, build := IndexBuilds.start(ctx, rb.Attr, rb.CurrentSchema, rb.OldSchema)
+	defer IndexBuilds.done(build)
 	if err := rebuildTokIndex(ctx, rb); err != nil {
 		return err
 	}
 	if err := rebuildReverseEdges(ctx, rb); err != nil {
 		return err
 	}
+	if err := rebuildFacetIndexes(ctx, rb); err != nil {
+		return err
+	}
 	return rebuildCountIndex(ctx, rb)
 }
 
@@ -1275,6 +1483,14 @@ func (rb *IndexRebuild) needsTokIndexRebuild() *indexRebuildInfo {
 	}
 
 	newTokenizers, deletedTokenizers := x.Diff(currTokens, prevTokens)
+	// The tokens of an index change with its analyzer, so the index is rebuilt.
+	for _, t := range rb.CurrentSchema.Tokenizer {
+		if _, ok := prevTokens[t]; ok &&
+			!proto.Equal(old.Analyzers[t], rb.CurrentSchema.Analyzers[t]) {
+			newTokenizers = append(newTokenizers, t)
+			deletedTokenizers = append(deletedTokenizers, t)
+		}
+	}
 
 	prevFactoryNames := make(map[string]struct{})
 	prevFactories := make(map[string]*pb.VectorIndexSpec)
@@ -1427,7 +1643,8 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 
 	glog.Infof("Rebuilding index for attr %s and tokenizers %s", rb.Attr,
 		rebuildInfo.tokenizersToRebuild)
-	tokenizers, err := tok.GetTokenizers(rebuildInfo.tokenizersToRebuild)
+	tokenizers, err := tok.GetTokenizersWithAnalyzers(rebuildInfo.tokenizersToRebuild,
+		rb.CurrentSchema.Analyzers)
 	if err != nil {
 		return err
 	}
@@ -1445,7 +1662,8 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 	runForVectors := (len(factorySpecs) != 0)
 
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		phase: IndexPhaseTokens}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) ([]*pb.DirectedEdge, error) {
 		edge := pb.DirectedEdge{Attr: rb.Attr, Entity: uid}
 		edges := []*pb.DirectedEdge{}
@@ -1520,6 +1738,7 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 				Tid:   types.TypeID(p.ValType),
 			}
 			edge.Lang = string(p.LangTag)
+			edge.ExpiresAt = p.ExpiresAt
 
 			newEdges, err := processAddIndexMutation(&edge, val)
 			if err != nil {
@@ -1623,7 +1842,8 @@ func rebuildCountIndex(ctx context.Context, rb *IndexRebuild) error {
 
 	// Create the forward index.
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		phase: IndexPhaseCount}
 	builder.fn = fn
 	if err := builder.Run(ctx); err != nil {
 		return err
@@ -1634,7 +1854,8 @@ func rebuildCountIndex(ctx context.Context, rb *IndexRebuild) error {
 	// to call builder.Run even if that's not the case as the reverse prefix
 	// will be empty.
 	reverse = true
-	builder = rebuilder{attr: rb.Attr, prefix: pk.ReversePrefix(), startTs: rb.StartTs}
+	builder = rebuilder{attr: rb.Attr, prefix: pk.ReversePrefix(), startTs: rb.StartTs,
+		phase: IndexPhaseReverseCount}
 	builder.fn = fn
 	return builder.Run(ctx)
 }
@@ -1764,7 +1985,8 @@ func rebuildReverseEdges(ctx context.Context, rb *IndexRebuild) error {
 
 	glog.Infof("Rebuilding reverse index for %s", rb.Attr)
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		phase: IndexPhaseReverse}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) ([]*pb.DirectedEdge, error) {
 		edge := pb.DirectedEdge{Attr: rb.Attr, Entity: uid}
 		return []*pb.DirectedEdge{}, pl.Iterate(txn.StartTs, 0, func(pp *pb.Posting) error {
@@ -1773,6 +1995,7 @@ func rebuildReverseEdges(ctx context.Context, rb *IndexRebuild) error {
 			edge.ValueId = puid
 			edge.Op = pb.DirectedEdge_SET
 			edge.Facets = pp.Facets
+			edge.ExpiresAt = pp.ExpiresAt
 
 			for {
 				// we only need to build reverse index here.
@@ -1790,6 +2013,97 @@ func rebuildReverseEdges(ctx context.Context, rb *IndexRebuild) error {
 	return builder.Run(ctx)
 }
 
+// needsFacetIndexRebuild returns the names of the facets whose index should be deleted and the
+// facets whose index should be rebuilt. A facet is reindexed if its type or tokenizer changed.
+func (rb *IndexRebuild) needsFacetIndexRebuild() ([]string, []*pb.FacetSchema) {
+	x.AssertTruef(rb.CurrentSchema != nil, "Current schema cannot be nil.")
+
+	indexed := func(su *pb.SchemaUpdate) map[string]*pb.FacetSchema {
+		facets := make(map[string]*pb.FacetSchema)
+		for _, f := range su.GetFacets() {
+			if f.Tokenizer != "" {
+				facets[f.Name] = f
+			}
+		}
+		return facets
+	}
+	sameIndex := func(a, b *pb.FacetSchema) bool {
+		return a != nil && b != nil && a.ValueType == b.ValueType && a.Tokenizer == b.Tokenizer
+	}
+
+	prev, curr := indexed(rb.OldSchema), indexed(rb.CurrentSchema)
+	var toDelete []string
+	for name, f := range prev {
+		if !sameIndex(f, curr[name]) {
+			toDelete = append(toDelete, name)
+		}
+	}
+	var toRebuild []*pb.FacetSchema
+	for name, f := range curr {
+		if !sameIndex(f, prev[name]) {
+			toRebuild = append(toRebuild, f)
+		}
+	}
+	return toDelete, toRebuild
+}
+
+func prefixesToDropFacetIndexes(rb *IndexRebuild) [][]byte {
+	toDelete, toRebuild := rb.needsFacetIndexRebuild()
+	names := toDelete
+	for _, f := range toRebuild {
+		names = append(names, f.Name)
+	}
+
+	var prefixes [][]byte
+	for _, name := range names {
+		prefix := x.IndexKey(rb.Attr, tok.FacetTokenPrefix(name))
+		prefixes = append(prefixes, prefix)
+		// All the parts of any list that has been split into multiple parts.
+		splitPrefix := append([]byte{}, prefix...)
+		splitPrefix[0] = x.ByteSplit
+		prefixes = append(prefixes, splitPrefix)
+	}
+	return prefixes
+}
+
+// rebuildFacetIndexes rebuilds the indexes of the facets whose index changed.
+func rebuildFacetIndexes(ctx context.Context, rb *IndexRebuild) error {
+	_, toRebuild := rb.needsFacetIndexRebuild()
+	if len(toRebuild) == 0 {
+		return nil
+	}
+
+	glog.Infof("Rebuilding facet indexes for %s", rb.Attr)
+	pk := x.ParsedKey{Attr: rb.Attr}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		phase: IndexPhaseFacets}
+	builder.fn = func(uid uint64, pl *List, txn *Txn) ([]*pb.DirectedEdge, error) {
+		tokens, err := pl.facetIndexTokens(txn, toRebuild)
+		if err != nil {
+			return []*pb.DirectedEdge{}, err
+		}
+		edge := &pb.DirectedEdge{ValueId: uid, Attr: rb.Attr, Op: pb.DirectedEdge_SET}
+		addIndexMutation := func(token string) error {
+			for {
+				err := txn.addIndexMutation(ctx, edge, token)
+				switch err {
+				case ErrRetry:
+					time.Sleep(10 * time.Millisecond)
+				default:
+					return err
+				}
+			}
+		}
+		for token := range tokens {
+			if err := addIndexMutation(token); err != nil {
+				return []*pb.DirectedEdge{}, err
+			}
+		}
+		return []*pb.DirectedEdge{}, nil
+	}
+	return builder.Run(ctx)
+}
+
 // needsListTypeRebuild returns true if the schema changed from a scalar to a
 // list. It returns true if the index can be left as is.
 func (rb *IndexRebuild) needsListTypeRebuild() (bool, error) {
@@ -0,0 +1,270 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package posting
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// Phases of an index build.
+const (
+	IndexPhaseTokens       = "tokens"
+	IndexPhaseReverse      = "reverse"
+	IndexPhaseCount        = "count"
+	IndexPhaseReverseCount = "reverse count"
+	IndexPhaseFacets       = "facets"
+)
+
+// ErrIndexBuildNotFound is returned when controlling the index build of a predicate that isn't
+// being indexed.
+var ErrIndexBuildNotFound = errors.New("no index build running for that predicate")
+
+// IndexBuilds keeps track of the index builds running on this Alpha, so that their progress can
+// be reported and they can be paused, resumed, cancelled and rate limited via the admin API.
+var IndexBuilds = newIndexBuildRegistry()
+
+type indexBuildRegistry struct {
+	sync.Mutex
+	builds map[string]*indexBuild
+}
+
+func newIndexBuildRegistry() *indexBuildRegistry {
+	return &indexBuildRegistry{builds: make(map[string]*indexBuild)}
+}
+
+// indexBuild is the state of the index build of a predicate. Its methods can be called on a nil
+// build, which is the case of the rebuilds that aren't tracked.
+type indexBuild struct {
+	sync.Mutex
+	attr      string
+	startedAt time.Time
+	cancel    context.CancelFunc
+	// schema is the schema that the build applies, and old the one it started from.
+	schema, old *pb.SchemaUpdate
+
+	phase         string
+	step          uint32
+	stepStartedAt time.Time
+	keys          uint64
+	totalKeys     uint64
+
+	// resume is closed when a paused build is resumed.
+	resume     chan struct{}
+	keysPerSec uint64
+	// next is the time at which the next key can be processed when rate limited.
+	next time.Time
+}
+
+// start registers the index build of the predicate from the old schema to the new one, and
+// returns a context that is cancelled when the build is cancelled.
+func (r *indexBuildRegistry) start(ctx context.Context, attr string, schema,
+	old *pb.SchemaUpdate) (context.Context, *indexBuild) {
+	ctx, cancel := context.WithCancel(ctx)
+	b := &indexBuild{attr: attr, startedAt: time.Now(), cancel: cancel, schema: schema, old: old}
+
+	r.Lock()
+	defer r.Unlock()
+	r.builds[attr] = b
+	return ctx, b
+}
+
+func (r *indexBuildRegistry) done(b *indexBuild) {
+	r.Lock()
+	defer r.Unlock()
+	if r.builds[b.attr] == b {
+		delete(r.builds, b.attr)
+	}
+	b.cancel()
+}
+
+func (r *indexBuildRegistry) get(attr string) *indexBuild {
+	r.Lock()
+	defer r.Unlock()
+	return r.builds[attr]
+}
+
+// Schemas returns the schema that the index build of the predicate applies and the one it
+// started from.
+func (r *indexBuildRegistry) Schemas(attr string) (*pb.SchemaUpdate, *pb.SchemaUpdate, error) {
+	b := r.get(attr)
+	if b == nil || b.schema == nil || b.old == nil {
+		return nil, nil, ErrIndexBuildNotFound
+	}
+	return proto.Clone(b.schema).(*pb.SchemaUpdate), proto.Clone(b.old).(*pb.SchemaUpdate), nil
+}
+
+// List returns the index builds of the predicates in the given namespace, or in all namespaces
+// if allNamespaces is set. The oldest builds are returned first.
+func (r *indexBuildRegistry) List(namespace uint64, allNamespaces bool) []*pb.IndexBuild {
+	r.Lock()
+	builds := make([]*indexBuild, 0, len(r.builds))
+	for _, b := range r.builds {
+		if allNamespaces || x.ParseNamespace(b.attr) == namespace {
+			builds = append(builds, b)
+		}
+	}
+	r.Unlock()
+
+	res := make([]*pb.IndexBuild, 0, len(builds))
+	for _, b := range builds {
+		res = append(res, b.info())
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].StartedAt < res[j].StartedAt })
+	return res
+}
+
+// Control pauses, resumes, cancels or rate limits the index build of a predicate.
+func (r *indexBuildRegistry) Control(req *pb.IndexBuildControl) error {
+	b := r.get(req.GetPredicate())
+	if b == nil {
+		return ErrIndexBuildNotFound
+	}
+
+	b.Lock()
+	defer b.Unlock()
+	switch req.GetAction() {
+	case pb.IndexBuildControl_PAUSE:
+		if b.resume == nil {
+			b.resume = make(chan struct{})
+		}
+	case pb.IndexBuildControl_RESUME:
+		if b.resume != nil {
+			close(b.resume)
+			b.resume = nil
+		}
+	case pb.IndexBuildControl_CANCEL:
+		b.cancel()
+	case pb.IndexBuildControl_RATE_LIMIT:
+		b.keysPerSec = req.GetKeysPerSec()
+	default:
+		return errors.Errorf("invalid index build action: %v", req.GetAction())
+	}
+	glog.Infof("Index build of %s: %s %d", b.attr, req.GetAction(), req.GetKeysPerSec())
+	return nil
+}
+
+func (b *indexBuild) info() *pb.IndexBuild {
+	b.Lock()
+	defer b.Unlock()
+	info := &pb.IndexBuild{
+		Predicate:     b.attr,
+		Phase:         b.phase,
+		Step:          b.step,
+		KeysProcessed: b.keys,
+		TotalKeys:     b.totalKeys,
+		StartedAt:     b.startedAt.UnixNano(),
+		StepStartedAt: b.stepStartedAt.UnixNano(),
+		Paused:        b.resume != nil,
+		KeysPerSec:    b.keysPerSec,
+		Alpha:         x.WorkerConfig.MyAddr,
+	}
+	if b.keys > 0 && b.totalKeys > b.keys {
+		elapsed := time.Since(b.stepStartedAt)
+		info.Eta = int64(float64(elapsed) * float64(b.totalKeys-b.keys) / float64(b.keys))
+	}
+	return info
+}
+
+// setStep starts a step of a phase of the build. The keys with the prefix are counted in the
+// background to estimate how long the step takes, if db is set. The returned function stops the
+// counting, and must be called once the step ends, before db is closed. It can be called again.
+func (b *indexBuild) setStep(ctx context.Context, phase string, step uint32, db *badger.DB,
+	prefix []byte, readTs uint64) func() {
+	if b == nil {
+		return func() {}
+	}
+	b.Lock()
+	b.phase, b.step, b.stepStartedAt = phase, step, time.Now()
+	b.keys, b.totalKeys = 0, 0
+	b.Unlock()
+	if db == nil {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		txn := db.NewTransactionAt(readTs, false)
+		defer txn.Discard()
+		iopt := badger.DefaultIteratorOptions
+		iopt.PrefetchValues = false
+		iopt.Prefix = prefix
+		it := txn.NewIterator(iopt)
+		defer it.Close()
+
+		var n uint64
+		for it.Rewind(); it.Valid(); it.Next() {
+			if n%10000 == 0 && ctx.Err() != nil {
+				return
+			}
+			n++
+		}
+		b.Lock()
+		defer b.Unlock()
+		if b.phase == phase && b.step == step {
+			b.totalKeys = n
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// wait is called before processing each key of the build. It blocks while the build is paused or
+// ahead of its rate limit.
+func (b *indexBuild) wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b.Lock()
+	for b.resume != nil {
+		resume := b.resume
+		b.Unlock()
+		select {
+		case <-resume:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		b.Lock()
+	}
+	b.keys++
+	var delay time.Duration
+	if b.keysPerSec > 0 {
+		now := time.Now()
+		if b.next.Before(now) {
+			b.next = now
+		}
+		delay = b.next.Sub(now)
+		b.next = b.next.Add(time.Second / time.Duration(b.keysPerSec))
+	}
+	b.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -13,11 +13,16 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/dgraph-io/badger/v4"
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/dgraph-io/dgraph/v25/protos/pb"
 	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/tok"
 	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/types/facets"
 	"github.com/dgraph-io/dgraph/v25/x"
 )
 
@@ -500,6 +505,87 @@ func TestRebuildReverseEdges(t *testing.T) {
 	require.EqualValues(t, 1, uids1[0])
 }
 
+func TestFacetIndex(t *testing.T) {
+	require.NoError(t, schema.ParseBytes(
+		[]byte("follows: [uid] @facets(since: int @index(int)) ."), 1))
+	attr := x.AttrInRootNamespace("follows")
+	sinceToken := func(v int64) string {
+		toks, err := tok.BuildFacetTokens("since", v, tok.IntTokenizer{})
+		require.NoError(t, err)
+		return toks[0]
+	}
+	indexed := func(v int64, readTs uint64) []uint64 {
+		l, err := GetNoStore(x.IndexKey(attr, sinceToken(v)), readTs)
+		require.NoError(t, err)
+		return uids(l, readTs)
+	}
+	follow := func(dst uint64, since string, op uint32, startTs, commitTs uint64) {
+		f, err := facets.FacetFor("since", since)
+		require.NoError(t, err)
+		edge := &pb.DirectedEdge{
+			ValueId:   dst,
+			ValueType: pb.Posting_UID,
+			Attr:      attr,
+			Entity:    1,
+			Facets:    []*api.Facet{f},
+		}
+		l, err := GetNoStore(x.DataKey(attr, 1), startTs)
+		require.NoError(t, err)
+		addMutation(t, l, edge, op, startTs, commitTs, true)
+	}
+
+	follow(2, "5", Set, 1, 2)
+	follow(3, "5", Set, 3, 4)
+	require.Equal(t, []uint64{1}, indexed(5, 5))
+
+	// The token is kept while another edge of the subject still has the value.
+	follow(2, "7", Set, 5, 6)
+	require.Equal(t, []uint64{1}, indexed(5, 7))
+	require.Equal(t, []uint64{1}, indexed(7, 7))
+
+	// The facet index keys of uid predicates are checked too.
+	report, err := CheckConsistency(context.Background(), pstore, attr, 7)
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Keys)
+	require.Empty(t, report.Inconsistencies)
+
+	follow(3, "5", Del, 7, 8)
+	require.Empty(t, indexed(5, 9))
+	require.Equal(t, []uint64{1}, indexed(7, 9))
+
+	// Deleting all the edges of the subject removes all its tokens.
+	l, err := GetNoStore(x.DataKey(attr, 1), 9)
+	require.NoError(t, err)
+	addMutation(t, l, &pb.DirectedEdge{Value: []byte(x.Star), Attr: attr, Entity: 1}, Del, 9, 10,
+		true)
+	require.Empty(t, indexed(7, 11))
+}
+
+func TestNeedsFacetIndexRebuild(t *testing.T) {
+	since := &pb.FacetSchema{Name: "since", ValueType: pb.Posting_INT, Tokenizer: "int"}
+	weight := &pb.FacetSchema{Name: "weight", ValueType: pb.Posting_FLOAT}
+	rb := IndexRebuild{
+		OldSchema:     &pb.SchemaUpdate{Facets: []*pb.FacetSchema{since, weight}},
+		CurrentSchema: &pb.SchemaUpdate{Facets: []*pb.FacetSchema{since, weight}},
+	}
+	toDelete, toRebuild := rb.needsFacetIndexRebuild()
+	require.Empty(t, toDelete)
+	require.Empty(t, toRebuild)
+	require.False(t, rb.NeedIndexRebuild())
+
+	rb.OldSchema = &pb.SchemaUpdate{}
+	toDelete, toRebuild = rb.needsFacetIndexRebuild()
+	require.Empty(t, toDelete)
+	require.Equal(t, []*pb.FacetSchema{since}, toRebuild)
+	require.Empty(t, rb.GetQuerySchema().Facets[0].Tokenizer)
+
+	rb.OldSchema = &pb.SchemaUpdate{Facets: []*pb.FacetSchema{since}}
+	rb.CurrentSchema = &pb.SchemaUpdate{Facets: []*pb.FacetSchema{weight}}
+	toDelete, toRebuild = rb.needsFacetIndexRebuild()
+	require.Equal(t, []string{"since"}, toDelete)
+	require.Empty(t, toRebuild)
+}
+
 func TestNeedsTokIndexRebuild(t *testing.T) {
 	rb := IndexRebuild{}
 	rb.OldSchema = &pb.SchemaUpdate{ValueType: pb.Posting_UID}
@@ -552,6 +638,18 @@ func TestNeedsTokIndexRebuild(t *testing.T) {
 	require.Equal(t, indexOp(indexDelete), rebuildInfo.op)
 	require.Equal(t, []string{"exact"}, rebuildInfo.tokenizersToDelete)
 	require.Equal(t, []string(nil), rebuildInfo.tokenizersToRebuild)
+
+	// Changing the analyzer of an index rebuilds it.
+	rb.OldSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING, Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"fulltext", "exact"}}
+	rb.CurrentSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"fulltext", "exact"},
+		Analyzers: map[string]*pb.Analyzer{"fulltext": {Name: "en"}}}
+	rebuildInfo = rb.needsTokIndexRebuild()
+	require.Equal(t, indexOp(indexRebuild), rebuildInfo.op)
+	require.Equal(t, []string{"fulltext"}, rebuildInfo.tokenizersToDelete)
+	require.Equal(t, []string{"fulltext"}, rebuildInfo.tokenizersToRebuild)
 }
 
 func TestNeedsCountIndexRebuild(t *testing.T) {
@@ -615,3 +713,156 @@ func TestNeedsListTypeRebuild(t *testing.T) {
 	require.False(t, rebuild)
 	require.Error(t, err)
 }
+
+func TestCheckConsistency(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		ccity: string @index(exact) .
+		cfollows: [uid] @reverse @count .
+	`), 1))
+	city := x.AttrInRootNamespace("ccity")
+	follows := x.AttrInRootNamespace("cfollows")
+	set := func(attr string, edge *pb.DirectedEdge, ts uint64, index bool) {
+		edge.Attr = attr
+		l, err := GetNoStore(x.DataKey(attr, edge.Entity), ts)
+		require.NoError(t, err)
+		addMutation(t, l, edge, Set, ts, ts+1, index)
+	}
+
+	// Node 0x1 is indexed, node 0x2 isn't, and the index key of Paris has a stale uid.
+	set(city, &pb.DirectedEdge{Entity: 1, Value: []byte("Paris")}, 1, true)
+	set(city, &pb.DirectedEdge{Entity: 2, Value: []byte("Rome")}, 3, false)
+	paris, err := indexTokensForTest("ccity", "", types.Val{Tid: types.StringID,
+		Value: []byte("Paris")})
+	require.NoError(t, err)
+	l, err := GetNoStore(x.IndexKey(city, paris[0]), 5)
+	require.NoError(t, err)
+	addMutation(t, l, &pb.DirectedEdge{ValueId: 3, Attr: city}, Set, 5, 6, false)
+	set(follows, &pb.DirectedEdge{Entity: 1, ValueId: 10, ValueType: pb.Posting_UID}, 7, true)
+	set(follows, &pb.DirectedEdge{Entity: 2, ValueId: 10, ValueType: pb.Posting_UID}, 9, false)
+
+	ctx := context.Background()
+	report, err := CheckConsistency(ctx, pstore, city, 20)
+	require.NoError(t, err)
+	require.Equal(t, 2, report.DataKeys)
+	require.Len(t, report.Inconsistencies, 2)
+	require.Equal(t, x.IndexKey(city, paris[0]), report.Inconsistencies[0].Key)
+	require.Equal(t, []uint64{3}, report.Inconsistencies[0].Extra)
+	require.Empty(t, report.Inconsistencies[0].Missing)
+	require.Equal(t, []uint64{2}, report.Inconsistencies[1].Missing)
+
+	report2, err := CheckConsistency(ctx, pstore, follows, 20)
+	require.NoError(t, err)
+	byKey := make(map[string]*Inconsistency)
+	for _, inc := range report2.Inconsistencies {
+		byKey[string(inc.Key)] = inc
+	}
+	require.Len(t, byKey, 4)
+	require.Equal(t, []uint64{2}, byKey[string(x.ReverseKey(follows, 10))].Missing)
+	require.Equal(t, []uint64{2}, byKey[string(x.CountKey(follows, 1, false))].Missing)
+	require.Equal(t, []uint64{10}, byKey[string(x.CountKey(follows, 1, true))].Extra)
+	require.Equal(t, []uint64{10}, byKey[string(x.CountKey(follows, 2, true))].Missing)
+
+	// The repaired keys are consistent.
+	for _, r := range []*ConsistencyReport{report, report2} {
+		kvs, err := r.RepairKVs(21)
+		require.NoError(t, err)
+		writer := NewTxnWriter(pstore)
+		require.NoError(t, writer.Write(&bpb.KVList{Kv: kvs}))
+		require.NoError(t, writer.Flush())
+
+		report, err := CheckConsistency(ctx, pstore, r.Attr, 22)
+		require.NoError(t, err)
+		require.Empty(t, report.Inconsistencies)
+	}
+}
+
+func TestIndexBuildControl(t *testing.T) {
+	attr := x.AttrInRootNamespace("ibuild")
+	update := &pb.SchemaUpdate{Predicate: attr, Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"}}
+	old := &pb.SchemaUpdate{Predicate: attr}
+	ctx, build := IndexBuilds.start(context.Background(), attr, update, old)
+	defer IndexBuilds.done(build)
+	build.setStep(ctx, IndexPhaseTokens, 1, nil, nil, 0)()
+
+	control := func(action pb.IndexBuildControl_Action, keysPerSec uint64) {
+		require.NoError(t, IndexBuilds.Control(&pb.IndexBuildControl{Predicate: attr,
+			Action: action, KeysPerSec: keysPerSec}))
+	}
+	require.NoError(t, build.wait(ctx))
+
+	// A paused build waits until it's resumed.
+	control(pb.IndexBuildControl_PAUSE, 0)
+	waited := make(chan error)
+	go func() { waited <- build.wait(ctx) }()
+	select {
+	case <-waited:
+		t.Fatal("paused build processed a key")
+	case <-time.After(50 * time.Millisecond):
+	}
+	control(pb.IndexBuildControl_RESUME, 0)
+	require.NoError(t, <-waited)
+
+	// 20 keys at 200 keys per second take at least 95ms, the first one isn't delayed.
+	control(pb.IndexBuildControl_RATE_LIMIT, 200)
+	start := time.Now()
+	for range 20 {
+		require.NoError(t, build.wait(ctx))
+	}
+	require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	builds := IndexBuilds.List(x.RootNamespace, false)
+	require.Len(t, builds, 1)
+	require.Equal(t, attr, builds[0].Predicate)
+	require.Equal(t, IndexPhaseTokens, builds[0].Phase)
+	require.Equal(t, uint64(22), builds[0].KeysProcessed)
+	require.Equal(t, uint64(200), builds[0].KeysPerSec)
+	require.Empty(t, IndexBuilds.List(1, false))
+
+	gotUpdate, gotOld, err := IndexBuilds.Schemas(attr)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(update, gotUpdate))
+	require.True(t, proto.Equal(old, gotOld))
+
+	control(pb.IndexBuildControl_CANCEL, 0)
+	require.ErrorIs(t, build.wait(ctx), context.Canceled)
+	require.ErrorIs(t, IndexBuilds.Control(&pb.IndexBuildControl{Predicate: "other"}),
+		ErrIndexBuildNotFound)
+	_, _, err = IndexBuilds.Schemas("other")
+	require.ErrorIs(t, err, ErrIndexBuildNotFound)
+}
+
+func TestSchemaImpact(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`iage: string @index(exact) .`), 1))
+	attr := x.AttrInRootNamespace("iage")
+	for i, age := range []string{"25", "abc", "30"} {
+		ts := uint64(2*i + 1)
+		l, err := GetNoStore(x.DataKey(attr, uint64(i+1)), ts)
+		require.NoError(t, err)
+		addMutation(t, l, &pb.DirectedEdge{Entity: uint64(i + 1), Attr: attr,
+			Value: []byte(age)}, Set, ts, ts+1, true)
+	}
+
+	parsed, err := schema.Parse(`iage: int @index(int) .`)
+	require.NoError(t, err)
+	old, _ := schema.State().Get(context.Background(), attr)
+	rb := IndexRebuild{Attr: attr, StartTs: 10, OldSchema: &old, CurrentSchema: parsed.Preds[0]}
+	impact, err := rb.Impact(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, "string", impact.OldType)
+	require.Equal(t, "int", impact.NewType)
+	require.Equal(t, []string{"int"}, impact.IndexesBuilt)
+	require.Equal(t, []string{"exact"}, impact.IndexesDropped)
+	require.Equal(t, uint64(3), impact.DataKeys)
+	require.Equal(t, uint64(3), impact.Values)
+	// "abc" isn't an int.
+	require.Equal(t, uint64(1), impact.ConversionFailures)
+	require.Equal(t, uint64(2), impact.KeysToWrite)
+	require.Equal(t, uint64(3), impact.KeysToDrop)
+
+	// A list can't become a scalar.
+	rb.OldSchema = &pb.SchemaUpdate{Predicate: attr, ValueType: pb.Posting_STRING, List: true}
+	impact, err = rb.Impact(context.Background(), 10)
+	require.NoError(t, err)
+	require.Contains(t, impact.Error, "list to scalar")
+}
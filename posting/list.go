@@ -763,6 +763,7 @@ func NewPosting(t *pb.DirectedEdge) *pb.Posting {
 		LangTag:     []byte(t.Lang),
 		Op:          op,
 		Facets:      t.Facets,
+		ExpiresAt:   t.ExpiresAt,
 	}
 	return p
 }
@@ -775,6 +776,35 @@ func createDeleteAllPosting() *pb.Posting {
 	}
 }
 
+// isExpired returns whether the posting has expired at the given unix time.
+func isExpired(p *pb.Posting, now int64) bool {
+	return p.ExpiresAt != 0 && p.ExpiresAt <= now
+}
+
+// mayExpire returns whether the list belongs to a predicate whose postings can expire. The
+// reads that don't iterate over the postings can't be used for such lists.
+func (l *List) mayExpire() bool {
+	if !schema.State().HasTTL() {
+		return false
+	}
+	pk, err := x.Parse(l.key)
+	if err != nil {
+		return false
+	}
+	return schema.State().MayExpire(pk.Attr)
+}
+
+// hasExpiredPostings returns whether the posting list holds postings that have expired and
+// need to be purged by a rollup.
+func hasExpiredPostings(plist *pb.PostingList, now int64) bool {
+	for _, p := range plist.GetPostings() {
+		if isExpired(p, now) {
+			return true
+		}
+	}
+	return false
+}
+
 func hasDeleteAll(mpost *pb.Posting) bool {
 	return mpost.Op == Del && bytes.Equal(mpost.Value, []byte(x.Star)) && len(mpost.LangTag) == 0
 }
@@ -1151,6 +1181,8 @@ func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *pb.Posting) e
 
 	numDeletePostingsRead := 0
 	numNormalPostingsRead := 0
+	// Expired postings are skipped like deleted ones, until a rollup purges them.
+	now := Oracle().TimeAt(readTs)
 	defer func() {
 		// If we see a lot of these logs, it means that a lot of elements are getting deleted.
 		// This could be normal, but if we see this too much, that means that rollups are too slow.
@@ -1203,10 +1235,14 @@ loop:
 			return nil
 		case mp.Uid == 0 || (pp.Uid > 0 && pp.Uid < mp.Uid):
 			// Either mp is empty, or pp is lower than mp.
-			err = f(pp)
-			numNormalPostingsRead += 1
-			if err != nil {
-				break loop
+			if !isExpired(pp, now) {
+				err = f(pp)
+				numNormalPostingsRead += 1
+				if err != nil {
+					break loop
+				}
+			} else {
+				numDeletePostingsRead += 1
 			}
 
 			if err = pitr.next(); err != nil {
@@ -1214,7 +1250,7 @@ loop:
 			}
 		case pp.Uid == 0 || (mp.Uid > 0 && mp.Uid < pp.Uid):
 			// Either pp is empty, or mp is lower than pp.
-			if mp.Op != Del {
+			if mp.Op != Del && !isExpired(mp, now) {
 				err = f(mp)
 				numNormalPostingsRead += 1
 				if err != nil {
@@ -1226,7 +1262,7 @@ loop:
 			prevUid = mp.Uid
 			midx++
 		case pp.Uid == mp.Uid:
-			if mp.Op != Del {
+			if mp.Op != Del && !isExpired(mp, now) {
 				err = f(mp)
 				numNormalPostingsRead += 1
 				if err != nil {
@@ -1610,12 +1646,14 @@ func (l *List) encode(out *rollupOutput, readTs uint64, split bool) error {
 	var plist *pb.PostingList
 	var startUid, endUid uint64
 	var splitIdx int
-	enc := codec.Encoder{BlockSize: blockSize}
+	// With BitmapBlocks, the dense containers of uids are stored as bitmaps, which intersect
+	// without being decoded.
+	enc := codec.Encoder{BlockSize: blockSize, Bitmaps: Config.BitmapBlocks}
 
 	// Method to properly initialize the variables above
 	// when a multi-part list boundary is crossed.
 	initializeSplit := func() {
-		enc = codec.Encoder{BlockSize: blockSize}
+		enc = codec.Encoder{BlockSize: blockSize, Bitmaps: Config.BitmapBlocks}
 
 		// Load the corresponding part and set endUid to correctly detect the end of the list.
 		startUid = l.plist.Splits[splitIdx]
@@ -1646,7 +1684,8 @@ func (l *List) encode(out *rollupOutput, readTs uint64, split bool) error {
 		}
 
 		enc.Add(p.Uid)
-		if p.Facets != nil || p.PostingType != pb.Posting_REF {
+		// Postings that expire are kept, so that reads can skip them once they expire.
+		if p.Facets != nil || p.PostingType != pb.Posting_REF || p.ExpiresAt != 0 {
 			plist.Postings = append(plist.Postings, p)
 		}
 		return nil
@@ -1687,9 +1726,10 @@ func (l *List) rollup(readTs uint64, split bool) (*rollupOutput, error) {
 		parts: make(map[uint64]*pb.PostingList),
 	}
 
-	if len(out.plist.Splits) > 0 || l.mutationMap.len() > 0 {
+	if len(out.plist.Splits) > 0 || l.mutationMap.len() > 0 ||
+		hasExpiredPostings(l.plist, Oracle().TimeAt(readTs)) {
 		// In case there were splits, this would read all the splits from
-		// Badger.
+		// Badger. Expired postings are dropped while encoding.
 		if err := l.encode(out, readTs, split); err != nil {
 			return nil, errors.Wrapf(err, "while encoding")
 		}
@@ -1776,6 +1816,9 @@ func (l *List) canUseCalculatedUids(readTs uint64) bool {
 	if !l.mutationMap.isUidsCalculated || l.mutationMap.currentEntries != nil {
 		return false
 	}
+	if l.mayExpire() {
+		return false
+	}
 	return l.mutationMap.committedUidsTime == math.MaxUint64 ||
 		readTs >= l.mutationMap.committedUidsTime
 }
@@ -1820,7 +1863,8 @@ func (l *List) Uids(opt ListOptions) (*pb.List, error) {
 		res := make([]uint64, 0, l.ApproxLen())
 		out := &pb.List{}
 
-		if l.mutationMap.len() == 0 && opt.Intersect != nil && len(l.plist.Splits) == 0 {
+		if l.mutationMap.len() == 0 && opt.Intersect != nil && len(l.plist.Splits) == 0 &&
+			!l.mayExpire() {
 			if opt.ReadTs < l.minTs {
 				return out, errors.Wrapf(ErrTsTooOld, "While reading UIDs"), false
 			}
@@ -2195,8 +2239,12 @@ func (l *List) FindPosting(readTs uint64, uid uint64) (found bool, pos *pb.Posti
 func (l *List) findPostingWithItr(readTs uint64, uid uint64, pitr pIterator) (found bool, pos *pb.Posting, err error) {
 	// Iterate starts iterating after the given argument, so we pass UID - 1
 	// TODO Find what happens when uid = math.MaxUint64
+	now := Oracle().TimeAt(readTs)
 	searchFurther, pos := l.mutationMap.findPosting(readTs, uid)
 	if pos != nil {
+		if isExpired(pos, now) {
+			return false, nil, nil
+		}
 		return true, pos, nil
 	}
 	if !searchFurther {
@@ -2216,7 +2264,7 @@ func (l *List) findPostingWithItr(readTs uint64, uid uint64, pitr pIterator) (fo
 	}
 	if valid {
 		pp := pitr.posting()
-		if pp.Uid == uid {
+		if pp.Uid == uid && !isExpired(pp, now) {
 			return true, pp, nil
 		}
 		return false, nil, nil
@@ -2284,7 +2332,8 @@ func (l *List) readListPart(startUid uint64) (*pb.PostingList, error) {
 
 // shouldSplit returns true if the given plist should be split in two.
 func shouldSplit(plist *pb.PostingList) bool {
-	return proto.Size(plist) >= maxListSize && len(plist.Pack.Blocks) > 1
+	return proto.Size(plist) >= maxListSize &&
+		(len(plist.Pack.Blocks) > 1 || codec.HasBitmaps(plist.Pack))
 }
 
 func (out *rollupOutput) updateSplits() {
@@ -2354,6 +2403,8 @@ func (out *rollupOutput) splitUpList() {
 // half of the blocks and postings of the original as well as the new startUids
 // for each of the new parts.
 func binSplit(lowUid uint64, plist *pb.PostingList) ([]uint64, []*pb.PostingList) {
+	// A bitmap block can't be split, so the uids of a list being split are stored as deltas.
+	plist.Pack = codec.ExpandBitmaps(plist.Pack)
 	midBlock := len(plist.Pack.Blocks) / 2
 	midUid := plist.Pack.Blocks[midBlock].GetBase()
 
@@ -14,6 +14,7 @@ import (
 	"sort"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -583,6 +584,201 @@ func TestRollupMaxTsIsSet(t *testing.T) {
 	}
 }
 
+func TestExpiredPostings(t *testing.T) {
+	attr := x.AttrInRootNamespace("session")
+	schema.State().Set(attr, &pb.SchemaUpdate{
+		Predicate: attr,
+		ValueType: pb.Posting_UID,
+		List:      true,
+		Ttl:       3600,
+	})
+	key := x.DataKey(attr, 1)
+	ol, err := readPostingListFromDisk(key, ps, math.MaxUint64)
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	txn := Txn{StartTs: 1}
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 2, ExpiresAt: now - 10}, Set, &txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 3, ExpiresAt: now + 3600}, Set, &txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 4}, Set, &txn)
+	require.NoError(t, ol.commitMutation(1, 2))
+
+	require.Equal(t, []uint64{3, 4}, listToArray(t, 0, ol, 3))
+	found, _, err := ol.FindPosting(3, 2)
+	require.NoError(t, err)
+	require.False(t, found)
+	expired, err := ol.hasExpired(now)
+	require.NoError(t, err)
+	require.True(t, expired)
+
+	// The rollup purges the expired posting, and keeps the expiry time of the others.
+	kvs, err := ol.Rollup(nil, 3)
+	require.NoError(t, err)
+	require.NoError(t, writePostingListToDisk(kvs))
+	ol, err = readPostingListFromDisk(key, ps, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4}, codec.Decode(ol.plist.Pack, 0))
+	require.Len(t, ol.plist.Postings, 1)
+	require.Equal(t, now+3600, ol.plist.Postings[0].ExpiresAt)
+	expired, err = ol.hasExpired(now)
+	require.NoError(t, err)
+	require.False(t, expired)
+	keys, _, err := expiredKeys(x.PredicatePrefix(attr), nil, 0)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	// Postings that expire after the rollup are skipped by the reads of the immutable layer.
+	ol = NewList(key, &pb.PostingList{
+		Pack:     codec.Encode([]uint64{3, 4}, blockSize),
+		Postings: []*pb.Posting{{Uid: 3, ExpiresAt: now - 10}},
+	}, 2)
+	require.Equal(t, []uint64{4}, listToArray(t, 0, ol, 3))
+	uids, err := ol.Uids(ListOptions{ReadTs: 3, Intersect: &pb.List{Uids: []uint64{3, 4}}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4}, uids.Uids)
+	kvs, err = ol.Rollup(nil, 3)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(kvs))
+	var plist pb.PostingList
+	require.NoError(t, proto.Unmarshal(kvs[0].Value, &plist))
+	require.Equal(t, []uint64{4}, codec.Decode(plist.Pack, 0))
+	require.Empty(t, plist.Postings)
+}
+
+func TestExpiredKeysInBatches(t *testing.T) {
+	attr := x.AttrInRootNamespace("otp")
+	now := time.Now().Unix()
+	var kvs []*bpb.KV
+	for uid := uint64(1); uid <= 5; uid++ {
+		expiresAt := now - 10
+		if uid == 3 {
+			expiresAt = now + 3600
+		}
+		val, err := proto.Marshal(&pb.PostingList{
+			Pack:     codec.Encode([]uint64{10}, blockSize),
+			Postings: []*pb.Posting{{Uid: 10, ExpiresAt: expiresAt}},
+		})
+		require.NoError(t, err)
+		kvs = append(kvs, &bpb.KV{Key: x.DataKey(attr, uid), Value: val,
+			UserMeta: []byte{BitCompletePosting}, Version: 1})
+	}
+	require.NoError(t, writePostingListToDisk(kvs))
+
+	// The keys are checked two at a time, and the key with no expired posting is skipped.
+	var expired [][]byte
+	var start []byte
+	for batches := 1; ; batches++ {
+		keys, next, err := expiredKeys(x.PredicatePrefix(attr), start, 2)
+		require.NoError(t, err)
+		expired = append(expired, keys...)
+		if start = next; start == nil {
+			require.Equal(t, 3, batches)
+			break
+		}
+	}
+	require.Equal(t, [][]byte{x.DataKey(attr, 1), x.DataKey(attr, 2), x.DataKey(attr, 4),
+		x.DataKey(attr, 5)}, expired)
+}
+
+func TestExpiryAtReadTs(t *testing.T) {
+	attr := x.AttrInRootNamespace("token")
+	schema.State().Set(attr, &pb.SchemaUpdate{
+		Predicate: attr,
+		ValueType: pb.Posting_UID,
+		List:      true,
+		Ttl:       60,
+	})
+	defer func() {
+		o.Lock()
+		o.times = nil
+		o.Unlock()
+	}()
+	o.Lock()
+	o.recordTimes(map[uint64]int64{10: 1000, 20: 1060, 30: 1060, 40: 1120})
+	o.Unlock()
+	require.Equal(t, []tsTime{{ts: 10, unix: 1000}, {ts: 30, unix: 1060}, {ts: 40, unix: 1120}},
+		o.times)
+	require.Equal(t, int64(1000), o.TimeAt(5))
+	require.Equal(t, int64(1060), o.TimeAt(25))
+	require.Equal(t, int64(1120), o.TimeAt(50))
+
+	key := x.DataKey(attr, 1)
+	ol, err := readPostingListFromDisk(key, ps, math.MaxUint64)
+	require.NoError(t, err)
+	txn := Txn{StartTs: 1}
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 2, ExpiresAt: 1060}, Set, &txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 3}, Set, &txn)
+	require.NoError(t, ol.commitMutation(1, 2))
+
+	// The posting expires for the reads at or after the timestamp sent at its expiry time,
+	// whatever the local time of the read.
+	require.Equal(t, []uint64{2, 3}, listToArray(t, 0, ol, 10))
+	require.Equal(t, []uint64{3}, listToArray(t, 0, ol, 25))
+	found, _, err := ol.FindPosting(10, 2)
+	require.NoError(t, err)
+	require.True(t, found)
+	found, _, err = ol.FindPosting(25, 2)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	o.PruneTimes(25)
+	require.Equal(t, []tsTime{{ts: 30, unix: 1060}, {ts: 40, unix: 1120}}, o.times)
+}
+
+func TestBitmapBlocks(t *testing.T) {
+	defer func() { Config.BitmapBlocks = false }()
+	Config.BitmapBlocks = true
+	defer setMaxListSize(maxListSize)
+	maxListSize = math.MaxInt32
+
+	key := x.DataKey(x.AttrInRootNamespace(uuid.New().String()), 1331)
+	ol, err := readPostingListFromDisk(key, ps, math.MaxUint64)
+	require.NoError(t, err)
+	var uids []uint64
+	txn := Txn{StartTs: 1}
+	for i := 1; i <= 10000; i++ {
+		uids = append(uids, uint64(i))
+		addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: uint64(i)}, Set, &txn)
+	}
+	require.NoError(t, ol.commitMutation(1, 2))
+
+	// The dense uids are written as a bitmap block, and read back.
+	kvs, err := ol.Rollup(nil, math.MaxUint64)
+	require.NoError(t, err)
+	require.NoError(t, writePostingListToDisk(kvs))
+	ol, err = readPostingListFromDisk(key, ps, math.MaxUint64)
+	require.NoError(t, err)
+	require.True(t, codec.HasBitmaps(ol.plist.Pack))
+	require.Equal(t, uids, listToArray(t, 0, ol, 3))
+
+	// The 8 KiB bitmap is too large for a part, so the list is split into blocks of deltas.
+	maxListSize = 5000
+	kvs, err = ol.Rollup(nil, math.MaxUint64)
+	require.NoError(t, err)
+	require.NoError(t, writePostingListToDisk(kvs))
+	ol, err = readPostingListFromDisk(key, ps, math.MaxUint64)
+	require.NoError(t, err)
+	require.True(t, len(ol.plist.Splits) > 0)
+	verifySplits(t, ol.plist.Splits)
+	require.Equal(t, uids, listToArray(t, 0, ol, 3))
+}
+
+func TestUnknownBlockEncoding(t *testing.T) {
+	key := x.DataKey(x.AttrInRootNamespace(uuid.New().String()), 1331)
+	plist := &pb.PostingList{Pack: &pb.UidPack{BlockSize: 256, Blocks: []*pb.UidBlock{
+		{Base: 1, NumUids: 1, Encoding: pb.UidBlock_Encoding(7)},
+	}}}
+	val, err := proto.Marshal(plist)
+	require.NoError(t, err)
+	require.NoError(t, writePostingListToDisk([]*bpb.KV{
+		{Key: key, Value: val, UserMeta: []byte{BitCompletePosting}, Version: 1},
+	}))
+
+	// A block written by a newer version is refused instead of being read as deltas.
+	_, err = readPostingListFromDisk(key, ps, math.MaxUint64)
+	require.ErrorContains(t, err, "unknown encoding 7")
+}
+
 func TestMillion(t *testing.T) {
 	// Ensure list is stored in a single part.
 	defer setMaxListSize(maxListSize)
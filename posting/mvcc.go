@@ -24,7 +24,9 @@ import (
 	"github.com/dgraph-io/badger/v4"
 	bpb "github.com/dgraph-io/badger/v4/pb"
 	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/dgraph-io/dgraph/v25/codec"
 	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
 	"github.com/dgraph-io/dgraph/v25/x"
 	"github.com/dgraph-io/ristretto/v2"
 	"github.com/dgraph-io/ristretto/v2/z"
@@ -66,6 +68,14 @@ var (
 	// ErrHighPriorityOp is returned when rollup is cancelled so that operations could start.
 	ErrHighPriorityOp = errors.New("Cancelled rollup to make way for high priority operation")
 
+	// purgeInterval is how often the keys of the predicates with a TTL are checked for
+	// expired postings, which are then purged by rolling the keys up.
+	purgeInterval = 5 * time.Minute
+	// The keys are checked purgeBatchSize at a time, every purgeBatchInterval, so that a large
+	// predicate doesn't hold up the other rollups and reads.
+	purgeBatchSize     = 1000
+	purgeBatchInterval = time.Second
+
 	// IncrRollup is used to batch keys for rollup incrementally.
 	IncrRollup = &incrRollupi{
 		priorityKeys: make([]*pooledKeys, 2),
@@ -134,6 +144,135 @@ func (ir *incrRollupi) rollUpKey(writer *TxnWriter, key []byte) error {
 	return writer.Write(&bpb.KVList{Kv: kvs})
 }
 
+// purgeExpired rolls up the keys of the predicates with a TTL that hold expired postings, until
+// the closer is closed. The rollups drop the expired postings, along with the index and reverse
+// entries pointing to them. Every purgeInterval, it sweeps the keys of the predicates in batches.
+func (ir *incrRollupi) purgeExpired(closer *z.Closer) {
+	defer closer.Done()
+
+	writer := NewTxnWriter(pstore)
+	defer writer.Flush()
+
+	sweepTick := time.NewTicker(purgeInterval)
+	defer sweepTick.Stop()
+	batchTick := time.NewTicker(purgeBatchInterval)
+	defer batchTick.Stop()
+
+	// prefixes are the prefixes left to sweep, and start is the key to resume the first one at.
+	var prefixes [][]byte
+	var start []byte
+	var purged int
+	for {
+		select {
+		case <-closer.HasBeenClosed():
+			return
+		case <-sweepTick.C:
+			if len(prefixes) > 0 {
+				// The previous sweep isn't done yet.
+				continue
+			}
+			for _, attr := range schema.State().TTLPredicates() {
+				pk := x.ParsedKey{Attr: attr}
+				prefixes = append(prefixes, pk.DataPrefix(), pk.IndexPrefix(), pk.ReversePrefix())
+			}
+		case <-batchTick.C:
+			if len(prefixes) == 0 {
+				continue
+			}
+			keys, next, err := expiredKeys(prefixes[0], start, purgeBatchSize)
+			if err != nil {
+				glog.Warningf("Error while looking for expired data with prefix [%x]: %v",
+					prefixes[0], err)
+				next = nil
+			}
+			for _, key := range keys {
+				switch err := ir.rollUpKey(writer, key); {
+				case err == ErrHighPriorityOp:
+					return
+				case err != nil:
+					glog.Warningf("Error purging expired data of key [%x]: %v", key, err)
+				}
+			}
+			purged += len(keys)
+			if start = next; start == nil {
+				if purged > 0 {
+					glog.V(2).Infof("Purged expired data from %d keys with prefix [%x]", purged,
+						prefixes[0])
+				}
+				prefixes, purged = prefixes[1:], 0
+			}
+		}
+	}
+}
+
+// expiredKeys returns the keys with the given prefix whose posting lists hold expired postings,
+// among the first limit keys from start, and the key to continue from, which is nil once all the
+// keys with the prefix were checked. A limit of zero checks all of them.
+func expiredKeys(prefix, start []byte, limit int) ([][]byte, []byte, error) {
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.AllVersions = true
+	itOpt.Prefix = prefix
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	if start == nil {
+		start = prefix
+	}
+	now := Oracle().TimeAt(Oracle().MaxAssigned())
+	var keys [][]byte
+	var lastKey []byte
+	var checked int
+	for it.Seek(start); it.Valid(); {
+		// ReadPostingList stops at a complete posting list, skip its older versions.
+		if bytes.Equal(lastKey, it.Item().Key()) {
+			it.Next()
+			continue
+		}
+		key := it.Item().KeyCopy(nil)
+		if limit > 0 && checked == limit {
+			return keys, key, nil
+		}
+		checked++
+		lastKey = key
+		l, err := ReadPostingList(key, it)
+		if err != nil {
+			return nil, nil, err
+		}
+		expired, err := l.hasExpired(now)
+		if err != nil {
+			return nil, nil, err
+		}
+		if expired {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil, nil
+}
+
+// hasExpired returns whether any layer or part of the list holds postings that have expired.
+func (l *List) hasExpired(now int64) (bool, error) {
+	var expired bool
+	l.mutationMap.iterate(func(_ uint64, plist *pb.PostingList) {
+		expired = expired || hasExpiredPostings(plist, now)
+	}, math.MaxUint64)
+	if expired || hasExpiredPostings(l.plist, now) {
+		return true, nil
+	}
+	for _, startUid := range l.plist.Splits {
+		part, err := l.readListPart(startUid)
+		if err != nil {
+			return false, err
+		}
+		if hasExpiredPostings(part, now) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // TODO: When the opRollup is not running the keys from keysPool of ir are dropped. Figure out some
 // way to handle that.
 func (ir *incrRollupi) addKeyToBatch(key []byte, priority int) {
@@ -161,6 +300,10 @@ func (ir *incrRollupi) Process(closer *z.Closer, getNewTs func(bool) uint64) {
 
 	defer closer.Done()
 
+	// The expired postings are purged alongside, so that their sweeps don't delay the rollups.
+	closer.AddRunning(1)
+	go ir.purgeExpired(closer)
+
 	writer := NewTxnWriter(pstore)
 	defer writer.Flush()
 
@@ -631,7 +774,13 @@ func unmarshalOrCopy(plist *pb.PostingList, item *badger.Item) error {
 			// empty pl
 			return nil
 		}
-		return proto.Unmarshal(val, plist)
+		if err := proto.Unmarshal(val, plist); err != nil {
+			return err
+		}
+		if err := codec.CheckPack(plist.Pack); err != nil {
+			return errors.Wrapf(err, "while reading posting list of key %s", hex.Dump(item.Key()))
+		}
+		return nil
 	})
 }
 
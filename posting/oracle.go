@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/hex"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -210,6 +211,17 @@ type oracle struct {
 	// Used for waiting logic for transactions with startTs > maxpending so that we don't read an
 	// uncommitted transaction.
 	waiters map[uint64][]chan struct{}
+
+	// times holds the unix times at which Zero sent the max assigned timestamps, sorted by
+	// timestamp. They come with the deltas applied via raft, so that all the replicas derive the
+	// same time from a read timestamp.
+	times []tsTime
+}
+
+// tsTime is the unix time at which Zero sent the timestamp.
+type tsTime struct {
+	ts   uint64
+	unix int64
 }
 
 func (o *oracle) init() {
@@ -330,6 +342,7 @@ func (o *oracle) ProcessDelta(delta *pb.OracleDelta) {
 		}
 		delete(o.pendingTxns, status.StartTs)
 	}
+	o.recordTimes(delta.Times)
 	curMax := o.MaxAssigned()
 	if delta.MaxAssigned < curMax {
 		return
@@ -350,6 +363,54 @@ func (o *oracle) ProcessDelta(delta *pb.OracleDelta) {
 		x.MaxAssignedTs.M(int64(delta.MaxAssigned))) // Can't access o.MaxAssigned without atomics.
 }
 
+// recordTimes adds the times of the timestamps of a delta. The times never go backwards, and only
+// the last timestamp of every second is kept, as it gives the same results.
+func (o *oracle) recordTimes(times map[uint64]int64) {
+	o.AssertLock()
+	tss := make([]uint64, 0, len(times))
+	for ts := range times {
+		tss = append(tss, ts)
+	}
+	sort.Slice(tss, func(i, j int) bool { return tss[i] < tss[j] })
+	for _, ts := range tss {
+		unix := times[ts]
+		n := len(o.times)
+		if n > 0 && o.times[n-1].ts >= ts {
+			continue
+		}
+		if n > 0 && o.times[n-1].unix >= unix {
+			o.times[n-1].ts = ts
+			continue
+		}
+		o.times = append(o.times, tsTime{ts: ts, unix: unix})
+	}
+}
+
+// TimeAt returns the unix time as of the read timestamp, which is the time at which Zero sent the
+// first timestamp at or above it. The time of the latest timestamp is used above all of them, and
+// the local time if Zero sent none yet.
+func (o *oracle) TimeAt(readTs uint64) int64 {
+	o.RLock()
+	defer o.RUnlock()
+	n := len(o.times)
+	if n == 0 {
+		return time.Now().Unix()
+	}
+	idx := sort.Search(n, func(i int) bool { return o.times[i].ts >= readTs })
+	if idx == n {
+		return o.times[n-1].unix
+	}
+	return o.times[idx].unix
+}
+
+// PruneTimes drops the times that are no longer needed to read at or above the timestamp.
+func (o *oracle) PruneTimes(ts uint64) {
+	o.Lock()
+	defer o.Unlock()
+	idx := sort.Search(len(o.times), func(i int) bool { return o.times[i].ts >= ts })
+	o.times = append(o.times[:0], o.times[idx:]...)
+}
+
 func (o *oracle) ResetTxns() {
 	o.Lock()
 	defer o.Unlock()
@@ -0,0 +1,243 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package posting
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/dgryski/go-farm"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/tok"
+	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// Impact reports what applying the schema change would do to the data of the predicate at
+// readTs, without changing anything: the indexes built and dropped, the values that don't convert
+// to the new type, and an estimate of the keys written and deleted. The data of the predicate is
+// scanned if the change builds an index, changes the type or rewrites the data.
+func (rb *IndexRebuild) Impact(ctx context.Context, readTs uint64) (*pb.SchemaImpact, error) {
+	impact := &pb.SchemaImpact{
+		Predicate: rb.Attr,
+		NewType:   schemaTypeName(rb.CurrentSchema),
+	}
+	if rb.OldSchema == nil {
+		impact.NewPredicate = true
+		return impact, nil
+	}
+	impact.OldType = schemaTypeName(rb.OldSchema)
+
+	rebuildList, err := rb.needsListTypeRebuild()
+	if err != nil {
+		impact.Error = err.Error()
+		return impact, nil
+	}
+	info := rb.needsTokIndexRebuild()
+	if info.op == indexNoop {
+		info = &indexRebuildInfo{}
+	}
+	rebuilt := make(map[string]bool)
+	for _, name := range info.tokenizersToRebuild {
+		impact.IndexesBuilt = append(impact.IndexesBuilt, name)
+		rebuilt[name] = true
+	}
+	for _, spec := range info.vectorIndexesToRebuild {
+		impact.IndexesBuilt = append(impact.IndexesBuilt, spec.Name)
+		rebuilt[spec.Name] = true
+	}
+	for _, name := range info.tokenizersToDelete {
+		if !rebuilt[name] {
+			impact.IndexesDropped = append(impact.IndexesDropped, name)
+		}
+	}
+	for _, spec := range info.vectorIndexesToDelete {
+		if !rebuilt[spec.Name] {
+			impact.IndexesDropped = append(impact.IndexesDropped, spec.Name)
+		}
+	}
+	reverse, count := rb.needsReverseEdgesRebuild(), rb.needsCountIndexRebuild()
+	for _, idx := range []struct {
+		name string
+		op   indexOp
+	}{{"reverse", reverse}, {"count", count}} {
+		switch idx.op {
+		case indexRebuild:
+			impact.IndexesBuilt = append(impact.IndexesBuilt, idx.name)
+		case indexDelete:
+			impact.IndexesDropped = append(impact.IndexesDropped, idx.name)
+		}
+	}
+	facetsToDelete, facetsToRebuild := rb.needsFacetIndexRebuild()
+	for _, f := range facetsToRebuild {
+		impact.IndexesBuilt = append(impact.IndexesBuilt, "facet:"+f.Name)
+	}
+	for _, name := range facetsToDelete {
+		impact.IndexesDropped = append(impact.IndexesDropped, "facet:"+name)
+	}
+
+	// The keys under the prefixes that DropIndexes deletes. Rebuilt indexes are deleted first.
+	var prefixes [][]byte
+	names := append(append([]string{}, info.tokenizersToDelete...), info.tokenizersToRebuild...)
+	for _, spec := range append(info.vectorIndexesToDelete, info.vectorIndexesToRebuild...) {
+		names = append(names, spec.Name)
+	}
+	for _, name := range names {
+		if prefixes, err = info.appendTokenizerPrefixesToDelete(name, prefixes); err != nil {
+			return nil, err
+		}
+	}
+	prefixes = append(prefixes, prefixesToDropReverseEdges(ctx, rb)...)
+	prefixes = append(prefixes, prefixesToDropCountIndex(ctx, rb)...)
+	prefixes = append(prefixes, prefixesToDropFacetIndexes(rb)...)
+	for _, prefix := range prefixes {
+		n, err := countKeys(ctx, prefix, readTs)
+		if err != nil {
+			return nil, err
+		}
+		impact.KeysToDrop += n
+	}
+
+	tokenizers, err := tok.GetTokenizersWithAnalyzers(info.tokenizersToRebuild,
+		rb.CurrentSchema.Analyzers)
+	if err != nil {
+		return nil, err
+	}
+	oldType, newType := types.TypeID(rb.OldSchema.ValueType), types.TypeID(rb.CurrentSchema.ValueType)
+	convert := oldType != newType && newType != types.UidID
+	if len(tokenizers) == 0 && reverse != indexRebuild && count != indexRebuild && !convert &&
+		!rebuildList {
+		return impact, nil
+	}
+
+	// Hashes of the keys that the index builds write.
+	keys := make(map[uint64]struct{})
+	addKey := func(key []byte) {
+		keys[farm.Fingerprint64(key)] = struct{}{}
+	}
+	// Number of subjects of each object, for the reverse count index.
+	subjects := make(map[uint64]uint32)
+	pk := x.ParsedKey{Attr: rb.Attr}
+	err = iterateLists(ctx, pk.DataPrefix(), readTs, func(pk x.ParsedKey, l *List) error {
+		impact.DataKeys++
+		if rebuildList {
+			impact.KeysToWrite++
+		}
+		err := l.Iterate(readTs, 0, func(p *pb.Posting) error {
+			impact.Values++
+			if newType == types.UidID {
+				if reverse == indexRebuild {
+					addKey(x.ReverseKey(rb.Attr, p.Uid))
+				}
+				subjects[p.Uid]++
+				return nil
+			}
+			if !convert && len(tokenizers) == 0 {
+				return nil
+			}
+			sv, err := types.Convert(valueToTypesVal(p), newType)
+			if err != nil {
+				impact.ConversionFailures++
+				return nil
+			}
+			for _, t := range tokenizers {
+				tokens, err := tok.BuildTokens(sv.Value,
+					tok.GetTokenizerForLang(t, string(p.LangTag)))
+				if err != nil {
+					continue
+				}
+				for _, token := range tokens {
+					addKey(x.IndexKey(rb.Attr, token))
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if count == indexRebuild {
+			if n := l.Length(readTs, 0); n > 0 {
+				addKey(x.CountKey(rb.Attr, uint32(n), false))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count == indexRebuild && rb.CurrentSchema.Directive == pb.SchemaUpdate_REVERSE {
+		for _, n := range subjects {
+			addKey(x.CountKey(rb.Attr, n, true))
+		}
+	}
+	impact.KeysToWrite += uint64(len(keys))
+	return impact, nil
+}
+
+func schemaTypeName(su *pb.SchemaUpdate) string {
+	name := types.TypeID(su.ValueType).Name()
+	if su.List {
+		return "[" + name + "]"
+	}
+	return name
+}
+
+// iterateLists calls fn with the posting list of each key with the prefix at readTs.
+func iterateLists(ctx context.Context, prefix []byte, readTs uint64,
+	fn func(pk x.ParsedKey, l *List) error) error {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	iopt := badger.DefaultIteratorOptions
+	iopt.AllVersions = true
+	iopt.Prefix = prefix
+	it := txn.NewIterator(iopt)
+	defer it.Close()
+
+	for it.Rewind(); it.Valid(); {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		key := it.Item().KeyCopy(nil)
+		pk, err := x.Parse(key)
+		if err != nil {
+			return err
+		}
+		l, err := ReadPostingList(key, it)
+		if err != nil {
+			return err
+		}
+		if err := fn(pk, l); err != nil {
+			return err
+		}
+		// ReadPostingList stops at a complete posting list, skip its older versions.
+		for it.Valid() && bytes.Equal(it.Item().Key(), key) {
+			it.Next()
+		}
+	}
+	return nil
+}
+
+// countKeys returns the number of keys with the prefix at readTs.
+func countKeys(ctx context.Context, prefix []byte, readTs uint64) (uint64, error) {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	iopt := badger.DefaultIteratorOptions
+	iopt.PrefetchValues = false
+	iopt.Prefix = prefix
+	it := txn.NewIterator(iopt)
+	defer it.Close()
+
+	var n uint64
+	for it.Rewind(); it.Valid(); it.Next() {
+		if n%10000 == 0 && ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		n++
+	}
+	return n, nil
+}
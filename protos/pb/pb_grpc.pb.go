@@ -898,6 +898,13 @@ const (
 	Worker_UpdateGraphQLSchema_FullMethodName             = "/pb.Worker/UpdateGraphQLSchema"
 	Worker_DeleteNamespace_FullMethodName                 = "/pb.Worker/DeleteNamespace"
 	Worker_TaskStatus_FullMethodName                      = "/pb.Worker/TaskStatus"
+	Worker_RunningRequests_FullMethodName                 = "/pb.Worker/RunningRequests"
+	Worker_CancelRequest_FullMethodName                   = "/pb.Worker/CancelRequest"
+	Worker_CheckConsistency_FullMethodName                = "/pb.Worker/CheckConsistency"
+	Worker_IndexBuilds_FullMethodName                     = "/pb.Worker/IndexBuilds"
+	Worker_ControlIndexBuild_FullMethodName               = "/pb.Worker/ControlIndexBuild"
+	Worker_SchemaImpact_FullMethodName                    = "/pb.Worker/SchemaImpact"
+	Worker_RotateEncryptionKey_FullMethodName             = "/pb.Worker/RotateEncryptionKey"
 	Worker_UpdateExtSnapshotStreamingState_FullMethodName = "/pb.Worker/UpdateExtSnapshotStreamingState"
 	Worker_StreamExtSnapshot_FullMethodName               = "/pb.Worker/StreamExtSnapshot"
 )
@@ -921,6 +928,13 @@ type WorkerClient interface {
 	UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQLSchemaRequest, opts ...grpc.CallOption) (*UpdateGraphQLSchemaResponse, error)
 	DeleteNamespace(ctx context.Context, in *DeleteNsRequest, opts ...grpc.CallOption) (*Status, error)
 	TaskStatus(ctx context.Context, in *TaskStatusRequest, opts ...grpc.CallOption) (*TaskStatusResponse, error)
+	RunningRequests(ctx context.Context, in *RunningRequestsRequest, opts ...grpc.CallOption) (*RunningRequestsResponse, error)
+	CancelRequest(ctx context.Context, in *CancelRequestRequest, opts ...grpc.CallOption) (*Status, error)
+	CheckConsistency(ctx context.Context, in *ConsistencyCheckRequest, opts ...grpc.CallOption) (*ConsistencyCheckResponse, error)
+	IndexBuilds(ctx context.Context, in *IndexBuildsRequest, opts ...grpc.CallOption) (*IndexBuildsResponse, error)
+	ControlIndexBuild(ctx context.Context, in *IndexBuildControl, opts ...grpc.CallOption) (*Status, error)
+	SchemaImpact(ctx context.Context, in *SchemaImpactRequest, opts ...grpc.CallOption) (*SchemaImpactResponse, error)
+	RotateEncryptionKey(ctx context.Context, in *RotateKeyRequest, opts ...grpc.CallOption) (*RotateKeyResponse, error)
 	UpdateExtSnapshotStreamingState(ctx context.Context, in *api.UpdateExtSnapshotStreamingStateRequest, opts ...grpc.CallOption) (*Status, error)
 	StreamExtSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamExtSnapshotClient, error)
 }
@@ -1129,6 +1143,69 @@ func (c *workerClient) TaskStatus(ctx context.Context, in *TaskStatusRequest, op
 	return out, nil
 }
 
+func (c *workerClient) RunningRequests(ctx context.Context, in *RunningRequestsRequest, opts ...grpc.CallOption) (*RunningRequestsResponse, error) {
+	out := new(RunningRequestsResponse)
+	err := c.cc.Invoke(ctx, Worker_RunningRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) CancelRequest(ctx context.Context, in *CancelRequestRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Worker_CancelRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) CheckConsistency(ctx context.Context, in *ConsistencyCheckRequest, opts ...grpc.CallOption) (*ConsistencyCheckResponse, error) {
+	out := new(ConsistencyCheckResponse)
+	err := c.cc.Invoke(ctx, Worker_CheckConsistency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) IndexBuilds(ctx context.Context, in *IndexBuildsRequest, opts ...grpc.CallOption) (*IndexBuildsResponse, error) {
+	out := new(IndexBuildsResponse)
+	err := c.cc.Invoke(ctx, Worker_IndexBuilds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) ControlIndexBuild(ctx context.Context, in *IndexBuildControl, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Worker_ControlIndexBuild_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) SchemaImpact(ctx context.Context, in *SchemaImpactRequest, opts ...grpc.CallOption) (*SchemaImpactResponse, error) {
+	out := new(SchemaImpactResponse)
+	err := c.cc.Invoke(ctx, Worker_SchemaImpact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) RotateEncryptionKey(ctx context.Context, in *RotateKeyRequest, opts ...grpc.CallOption) (*RotateKeyResponse, error) {
+	out := new(RotateKeyResponse)
+	err := c.cc.Invoke(ctx, Worker_RotateEncryptionKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workerClient) UpdateExtSnapshotStreamingState(ctx context.Context, in *api.UpdateExtSnapshotStreamingStateRequest, opts ...grpc.CallOption) (*Status, error) {
 	out := new(Status)
 	err := c.cc.Invoke(ctx, Worker_UpdateExtSnapshotStreamingState_FullMethodName, in, out, opts...)
@@ -1188,6 +1265,13 @@ type WorkerServer interface {
 	UpdateGraphQLSchema(context.Context, *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error)
 	DeleteNamespace(context.Context, *DeleteNsRequest) (*Status, error)
 	TaskStatus(context.Context, *TaskStatusRequest) (*TaskStatusResponse, error)
+	RunningRequests(context.Context, *RunningRequestsRequest) (*RunningRequestsResponse, error)
+	CancelRequest(context.Context, *CancelRequestRequest) (*Status, error)
+	CheckConsistency(context.Context, *ConsistencyCheckRequest) (*ConsistencyCheckResponse, error)
+	IndexBuilds(context.Context, *IndexBuildsRequest) (*IndexBuildsResponse, error)
+	ControlIndexBuild(context.Context, *IndexBuildControl) (*Status, error)
+	SchemaImpact(context.Context, *SchemaImpactRequest) (*SchemaImpactResponse, error)
+	RotateEncryptionKey(context.Context, *RotateKeyRequest) (*RotateKeyResponse, error)
 	UpdateExtSnapshotStreamingState(context.Context, *api.UpdateExtSnapshotStreamingStateRequest) (*Status, error)
 	StreamExtSnapshot(Worker_StreamExtSnapshotServer) error
 	mustEmbedUnimplementedWorkerServer()
@@ -1239,6 +1323,27 @@ func (UnimplementedWorkerServer) DeleteNamespace(context.Context, *DeleteNsReque
 func (UnimplementedWorkerServer) TaskStatus(context.Context, *TaskStatusRequest) (*TaskStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskStatus not implemented")
 }
+func (UnimplementedWorkerServer) RunningRequests(context.Context, *RunningRequestsRequest) (*RunningRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunningRequests not implemented")
+}
+func (UnimplementedWorkerServer) CancelRequest(context.Context, *CancelRequestRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRequest not implemented")
+}
+func (UnimplementedWorkerServer) CheckConsistency(context.Context, *ConsistencyCheckRequest) (*ConsistencyCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConsistency not implemented")
+}
+func (UnimplementedWorkerServer) IndexBuilds(context.Context, *IndexBuildsRequest) (*IndexBuildsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexBuilds not implemented")
+}
+func (UnimplementedWorkerServer) ControlIndexBuild(context.Context, *IndexBuildControl) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControlIndexBuild not implemented")
+}
+func (UnimplementedWorkerServer) SchemaImpact(context.Context, *SchemaImpactRequest) (*SchemaImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchemaImpact not implemented")
+}
+func (UnimplementedWorkerServer) RotateEncryptionKey(context.Context, *RotateKeyRequest) (*RotateKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateEncryptionKey not implemented")
+}
 func (UnimplementedWorkerServer) UpdateExtSnapshotStreamingState(context.Context, *api.UpdateExtSnapshotStreamingStateRequest) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateExtSnapshotStreamingState not implemented")
 }
@@ -1529,6 +1634,132 @@ func _Worker_TaskStatus_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_RunningRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunningRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).RunningRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_RunningRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).RunningRequests(ctx, req.(*RunningRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_CancelRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).CancelRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_CancelRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).CancelRequest(ctx, req.(*CancelRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_CheckConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsistencyCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).CheckConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_CheckConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).CheckConsistency(ctx, req.(*ConsistencyCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_IndexBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexBuildsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).IndexBuilds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_IndexBuilds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).IndexBuilds(ctx, req.(*IndexBuildsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_ControlIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexBuildControl)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).ControlIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_ControlIndexBuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ControlIndexBuild(ctx, req.(*IndexBuildControl))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_SchemaImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).SchemaImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_SchemaImpact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).SchemaImpact(ctx, req.(*SchemaImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_RotateEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).RotateEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_RotateEncryptionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).RotateEncryptionKey(ctx, req.(*RotateKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Worker_UpdateExtSnapshotStreamingState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.UpdateExtSnapshotStreamingStateRequest)
 	if err := dec(in); err != nil {
@@ -1624,6 +1855,34 @@ var Worker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TaskStatus",
 			Handler:    _Worker_TaskStatus_Handler,
 		},
+		{
+			MethodName: "RunningRequests",
+			Handler:    _Worker_RunningRequests_Handler,
+		},
+		{
+			MethodName: "CancelRequest",
+			Handler:    _Worker_CancelRequest_Handler,
+		},
+		{
+			MethodName: "CheckConsistency",
+			Handler:    _Worker_CheckConsistency_Handler,
+		},
+		{
+			MethodName: "IndexBuilds",
+			Handler:    _Worker_IndexBuilds_Handler,
+		},
+		{
+			MethodName: "ControlIndexBuild",
+			Handler:    _Worker_ControlIndexBuild_Handler,
+		},
+		{
+			MethodName: "SchemaImpact",
+			Handler:    _Worker_SchemaImpact_Handler,
+		},
+		{
+			MethodName: "RotateEncryptionKey",
+			Handler:    _Worker_RotateEncryptionKey_Handler,
+		},
 		{
 			MethodName: "UpdateExtSnapshotStreamingState",
 			Handler:    _Worker_UpdateExtSnapshotStreamingState_Handler,
@@ -7,6 +7,7 @@ package query
 
 import (
 	"sync"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -251,6 +252,10 @@ func evalMathTree(mNode *mathTree) error {
 	if mNode.Const.Value != nil {
 		return nil
 	}
+	if mNode.Fn == "now" {
+		mNode.Const = types.Val{Tid: types.DateTimeID, Value: time.Now()}
+		return nil
+	}
 	if mNode.Var != "" {
 		if mNode.Val.IsEmpty() {
 			glog.V(2).Infof("Variable %v not yet populated or missing.", mNode.Var)
@@ -268,6 +273,14 @@ func evalMathTree(mNode *mathTree) error {
 
 	aggName := mNode.Fn
 
+	if fn, ok := valueFunctions[aggName]; ok {
+		if len(mNode.Child) != fn.numArgs {
+			return errors.Errorf("Function %v expects %v argument. But got: %v", aggName,
+				fn.numArgs, len(mNode.Child))
+		}
+		return processValueFunc(mNode, fn)
+	}
+
 	if isUnary(aggName) {
 		if len(mNode.Child) != 1 {
 			return errors.Errorf("Function %v expects 1 argument. But got: %v", aggName,
@@ -9,9 +9,11 @@ import (
 	"math"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	geom "github.com/twpayne/go-geom"
 
 	"github.com/dgraph-io/dgraph/v25/types"
 )
@@ -1010,3 +1012,114 @@ func TestProcessTernary(t *testing.T) {
 		require.EqualValues(t, tc.out, val)
 	}
 }
+
+func TestProcessValueFunc(t *testing.T) {
+	dt := func(s string) types.Val {
+		tm, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return types.Val{Tid: types.DateTimeID, Value: tm}
+	}
+	str := func(s string) types.Val { return types.Val{Tid: types.StringID, Value: s} }
+	num := func(i int64) types.Val { return types.Val{Tid: types.IntID, Value: i} }
+
+	tests := []struct {
+		fn   string
+		args []types.Val
+		out  types.Val
+	}{
+		{fn: "concat", args: []types.Val{str("dgraph "), num(25)}, out: str("dgraph 25")},
+		{fn: "lower", args: []types.Val{str("DGraph")}, out: str("dgraph")},
+		{fn: "upper", args: []types.Val{str("DGraph")}, out: str("DGRAPH")},
+		{fn: "len", args: []types.Val{str("héllo")}, out: num(5)},
+		{fn: "substr", args: []types.Val{str("héllo"), num(1), num(3)}, out: str("éll")},
+		{fn: "substr", args: []types.Val{str("héllo"), num(3), num(10)}, out: str("lo")},
+		{fn: "substr", args: []types.Val{str("héllo"), num(10), num(1)}, out: str("")},
+		{fn: "replace", args: []types.Val{str("a-b-c"), str("-"), str("+")}, out: str("a+b+c")},
+		{fn: "year", args: []types.Val{dt("2026-03-15T10:20:30Z")}, out: num(2026)},
+		{fn: "month", args: []types.Val{dt("2026-03-15T10:20:30Z")}, out: num(3)},
+		{fn: "day", args: []types.Val{str("2026-03-15T10:20:30Z")}, out: num(15)},
+		{fn: "date_trunc", args: []types.Val{str("month"), dt("2026-03-15T10:20:30Z")},
+			out: dt("2026-03-01T00:00:00Z")},
+		{fn: "date_trunc", args: []types.Val{str("hour"), dt("2026-03-15T10:20:30Z")},
+			out: dt("2026-03-15T10:00:00Z")},
+		{fn: "date_diff", args: []types.Val{str("day"), dt("2026-03-15T10:00:00Z"),
+			dt("2026-03-18T09:00:00Z")}, out: num(2)},
+		{fn: "date_diff", args: []types.Val{str("month"), dt("2026-01-31T00:00:00Z"),
+			dt("2026-03-30T00:00:00Z")}, out: num(1)},
+		{fn: "date_diff", args: []types.Val{str("year"), dt("2026-03-15T00:00:00Z"),
+			dt("2024-03-16T00:00:00Z")}, out: num(-1)},
+		{fn: "add_duration", args: []types.Val{dt("2026-03-15T10:00:00Z"), str("2d")},
+			out: dt("2026-03-17T10:00:00Z")},
+		{fn: "add_duration", args: []types.Val{dt("2026-03-15T10:00:00Z"), str("-90m")},
+			out: dt("2026-03-15T08:30:00Z")},
+		{fn: "add_duration", args: []types.Val{dt("2026-03-15T10:00:00Z"), num(60)},
+			out: dt("2026-03-15T10:01:00Z")},
+	}
+	for _, tc := range tests {
+		t.Logf("Test: %s", tc.fn)
+		tree := &mathTree{Fn: tc.fn}
+		for _, arg := range tc.args {
+			tree.Child = append(tree.Child, &mathTree{Const: arg})
+		}
+		require.NoError(t, evalMathTree(tree))
+		require.EqualValues(t, tc.out, tree.Const)
+	}
+
+	// Variables are evaluated per uid, uids missing from one of them are skipped.
+	names := types.NewShardedMap()
+	names.Set(1, str("Alice"))
+	names.Set(2, str("Bob"))
+	suffixes := createShardedMap(1, str("!"))
+	tree := &mathTree{
+		Fn: "concat",
+		Child: []*mathTree{
+			{Fn: "upper", Child: []*mathTree{{Var: "n", Val: names}}},
+			{Var: "s", Val: suffixes},
+		},
+	}
+	require.NoError(t, evalMathTree(tree))
+	require.Equal(t, 1, tree.Val.Len())
+	val, ok := tree.Val.Get(1)
+	require.True(t, ok)
+	require.Equal(t, str("ALICE!"), val)
+
+	tree = &mathTree{Fn: "substr", Child: []*mathTree{{Const: str("abc")}, {Const: num(-1)},
+		{Const: num(1)}}}
+	require.Error(t, evalMathTree(tree))
+	tree = &mathTree{Fn: "date_trunc", Child: []*mathTree{{Const: str("week")},
+		{Const: dt("2026-03-15T10:00:00Z")}}}
+	require.Error(t, evalMathTree(tree))
+
+	tree = &mathTree{Fn: "now"}
+	require.NoError(t, evalMathTree(tree))
+	require.Equal(t, types.DateTimeID, tree.Const.Tid)
+
+	// The distance from a geo value to a point, in the given unit.
+	p, err := types.ParseGeoPoint("[-122.082506, 37.4249518]")
+	require.NoError(t, err)
+	tree = &mathTree{Fn: "geodist", Child: []*mathTree{
+		{Const: types.Val{Tid: types.GeoID, Value: p}},
+		{Const: str("[-122.080668, 37.426753]")}, {Const: str("km")}}}
+	require.NoError(t, evalMathTree(tree))
+	require.Equal(t, types.FloatID, tree.Const.Tid)
+	require.InDelta(t, 0.2578, tree.Const.Value.(float64), 0.0001)
+	tree = &mathTree{Fn: "geodist", Child: []*mathTree{
+		{Const: types.Val{Tid: types.GeoID, Value: p}},
+		{Const: str("[-122.080668, 37.426753]")}, {Const: str("parsec")}}}
+	require.Error(t, evalMathTree(tree))
+
+	// The length of a line, and the distance from a point to it.
+	line := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0, 0}, {1, 0}, {1, 1}})
+	tree = &mathTree{Fn: "length", Child: []*mathTree{
+		{Const: types.Val{Tid: types.GeoID, Value: line}}, {Const: str("km")}}}
+	require.NoError(t, evalMathTree(tree))
+	require.InDelta(t, 222.39, tree.Const.Value.(float64), 0.01)
+	tree = &mathTree{Fn: "geodist", Child: []*mathTree{
+		{Const: types.Val{Tid: types.GeoID, Value: line}}, {Const: str("[0.5, 0.5]")},
+		{Const: str("km")}}}
+	require.NoError(t, evalMathTree(tree))
+	require.InDelta(t, 55.6, tree.Const.Value.(float64), 0.1)
+	tree = &mathTree{Fn: "length", Child: []*mathTree{
+		{Const: types.Val{Tid: types.GeoID, Value: p}}, {Const: str("km")}}}
+	require.Error(t, evalMathTree(tree))
+}
@@ -0,0 +1,424 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package query
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
+
+	"github.com/dgraph-io/dgraph/v25/types"
+)
+
+// valueFunc evaluates one of the string, datetime or geo functions supported in math blocks.
+type valueFunc struct {
+	numArgs int
+	apply   func(args []types.Val) (types.Val, error)
+}
+
+var valueFunctions = map[string]valueFunc{
+	"concat":       {2, applyConcat},
+	"lower":        {1, applyLower},
+	"upper":        {1, applyUpper},
+	"len":          {1, applyLen},
+	"substr":       {3, applySubstr},
+	"replace":      {3, applyReplace},
+	"date_trunc":   {2, applyDateTrunc},
+	"date_diff":    {3, applyDateDiff},
+	"add_duration": {2, applyAddDuration},
+	"year":         {1, datePart(func(t time.Time) int64 { return int64(t.Year()) })},
+	"month":        {1, datePart(func(t time.Time) int64 { return int64(t.Month()) })},
+	"day":          {1, datePart(func(t time.Time) int64 { return int64(t.Day()) })},
+	"geodist":      {3, applyGeoDist},
+	"length":       {2, applyLength},
+}
+
+// convertMathVal converts a value held in memory by a math expression to the given type.
+func convertMathVal(v types.Val, toID types.TypeID) (types.Val, error) {
+	if v.Tid == toID {
+		return v, nil
+	}
+	if _, ok := v.Value.([]byte); !ok {
+		bin := types.Val{Tid: types.BinaryID}
+		if err := types.Marshal(v, &bin); err != nil {
+			return types.Val{}, err
+		}
+		v = types.Val{Tid: v.Tid, Value: bin.Value}
+	}
+	return types.Convert(v, toID)
+}
+
+func mathToString(v types.Val) (string, error) {
+	if s, ok := v.Value.(string); ok {
+		return s, nil
+	}
+	res, err := convertMathVal(v, types.StringID)
+	if err != nil {
+		return "", err
+	}
+	return res.Value.(string), nil
+}
+
+func mathToInt(v types.Val) (int64, error) {
+	res, err := convertMathVal(v, types.IntID)
+	if err != nil {
+		return 0, err
+	}
+	return res.Value.(int64), nil
+}
+
+func mathToDateTime(v types.Val) (time.Time, error) {
+	if t, ok := v.Value.(time.Time); ok {
+		return t, nil
+	}
+	res, err := convertMathVal(v, types.DateTimeID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return res.Value.(time.Time), nil
+}
+
+func mathToGeo(v types.Val) (geom.T, error) {
+	if g, ok := v.Value.(geom.T); ok {
+		return g, nil
+	}
+	res, err := convertMathVal(v, types.GeoID)
+	if err != nil {
+		return nil, err
+	}
+	return res.Value.(geom.T), nil
+}
+
+// mathToPoint accepts a geo value or a string like the point arguments of the geo functions.
+func mathToPoint(v types.Val) (*geom.Point, error) {
+	if v.Tid != types.GeoID {
+		s, err := mathToString(v)
+		if err != nil {
+			return nil, err
+		}
+		return types.ParseGeoPoint(s)
+	}
+	g, err := mathToGeo(v)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := g.(*geom.Point)
+	if !ok {
+		return nil, errors.Errorf("Expected a point, but got a geometry of type %T", g)
+	}
+	return p, nil
+}
+
+func stringVal(s string) types.Val {
+	return types.Val{Tid: types.StringID, Value: s}
+}
+
+func applyConcat(args []types.Val) (types.Val, error) {
+	var sb strings.Builder
+	for _, arg := range args {
+		s, err := mathToString(arg)
+		if err != nil {
+			return types.Val{}, errors.Wrapf(err, "invalid argument for func concat")
+		}
+		sb.WriteString(s)
+	}
+	return stringVal(sb.String()), nil
+}
+
+func applyLower(args []types.Val) (types.Val, error) {
+	s, err := mathToString(args[0])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid argument for func lower")
+	}
+	return stringVal(strings.ToLower(s)), nil
+}
+
+func applyUpper(args []types.Val) (types.Val, error) {
+	s, err := mathToString(args[0])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid argument for func upper")
+	}
+	return stringVal(strings.ToUpper(s)), nil
+}
+
+func applyLen(args []types.Val) (types.Val, error) {
+	s, err := mathToString(args[0])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid argument for func len")
+	}
+	return types.Val{Tid: types.IntID, Value: int64(utf8.RuneCountInString(s))}, nil
+}
+
+// applySubstr returns length characters of the string starting at start (0-based). The result is
+// cut short if the string ends before that.
+func applySubstr(args []types.Val) (types.Val, error) {
+	s, err := mathToString(args[0])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid argument for func substr")
+	}
+	start, err := mathToInt(args[1])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid start for func substr")
+	}
+	length, err := mathToInt(args[2])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid length for func substr")
+	}
+	if start < 0 || length < 0 {
+		return types.Val{}, errors.Errorf("start and length for func substr can't be negative")
+	}
+	runes := []rune(s)
+	if start > int64(len(runes)) {
+		return stringVal(""), nil
+	}
+	end := min(start+length, int64(len(runes)))
+	return stringVal(string(runes[start:end])), nil
+}
+
+func applyReplace(args []types.Val) (types.Val, error) {
+	var strs [3]string
+	for i := range strs {
+		s, err := mathToString(args[i])
+		if err != nil {
+			return types.Val{}, errors.Wrapf(err, "invalid argument for func replace")
+		}
+		strs[i] = s
+	}
+	return stringVal(strings.ReplaceAll(strs[0], strs[1], strs[2])), nil
+}
+
+func truncTime(unit string, t time.Time) (time.Time, error) {
+	switch strings.ToLower(unit) {
+	case "year":
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location()), nil
+	case "month":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()), nil
+	case "day":
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()), nil
+	case "hour":
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()), nil
+	case "minute":
+		return t.Truncate(time.Minute), nil
+	case "second":
+		return t.Truncate(time.Second), nil
+	}
+	return time.Time{}, errors.Errorf("invalid unit %q, expected one of year, month, day, "+
+		"hour, minute or second", unit)
+}
+
+// applyDateTrunc truncates the datetime to the given unit, e.g. date_trunc("month", dt).
+func applyDateTrunc(args []types.Val) (types.Val, error) {
+	unit, err := mathToString(args[0])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid unit for func date_trunc")
+	}
+	t, err := mathToDateTime(args[1])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid datetime for func date_trunc")
+	}
+	res, err := truncTime(unit, t)
+	if err != nil {
+		return types.Val{}, err
+	}
+	return types.Val{Tid: types.DateTimeID, Value: res}, nil
+}
+
+// applyDateDiff returns the number of whole units between start and end, e.g.
+// date_diff("day", start, end). The result is negative if end is before start.
+func applyDateDiff(args []types.Val) (types.Val, error) {
+	unit, err := mathToString(args[0])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid unit for func date_diff")
+	}
+	start, err := mathToDateTime(args[1])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid start for func date_diff")
+	}
+	end, err := mathToDateTime(args[2])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid end for func date_diff")
+	}
+
+	var diff int64
+	switch strings.ToLower(unit) {
+	case "year", "month":
+		months := int64(end.Year()-start.Year())*12 + int64(end.Month()-start.Month())
+		// Don't count the last month if it hasn't been completed yet.
+		if months > 0 && end.Before(start.AddDate(0, int(months), 0)) {
+			months--
+		} else if months < 0 && end.After(start.AddDate(0, int(months), 0)) {
+			months++
+		}
+		diff = months
+		if strings.ToLower(unit) == "year" {
+			diff = months / 12
+		}
+	case "day":
+		diff = int64(end.Sub(start) / (24 * time.Hour))
+	case "hour":
+		diff = int64(end.Sub(start) / time.Hour)
+	case "minute":
+		diff = int64(end.Sub(start) / time.Minute)
+	case "second":
+		diff = int64(end.Sub(start) / time.Second)
+	default:
+		return types.Val{}, errors.Errorf("invalid unit %q, expected one of year, month, day, "+
+			"hour, minute or second", unit)
+	}
+	return types.Val{Tid: types.IntID, Value: diff}, nil
+}
+
+// parseMathDuration parses durations like "1h30m" or "-2d". An integer is taken as a number of
+// seconds.
+func parseMathDuration(v types.Val) (time.Duration, error) {
+	if v.Tid == types.IntID {
+		return time.Duration(v.Value.(int64)) * time.Second, nil
+	}
+	s, err := mathToString(v)
+	if err != nil {
+		return 0, err
+	}
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.ParseInt(days, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// applyAddDuration adds the duration to the datetime, e.g. add_duration(dt, "36h").
+func applyAddDuration(args []types.Val) (types.Val, error) {
+	t, err := mathToDateTime(args[0])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid datetime for func add_duration")
+	}
+	d, err := parseMathDuration(args[1])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid duration for func add_duration")
+	}
+	return types.Val{Tid: types.DateTimeID, Value: t.Add(d)}, nil
+}
+
+// applyGeoDist returns the distance from the geometry to the point in the given unit, e.g.
+// geodist(loc, "[-122.08, 37.42]", "km"). It is 0 for a polygon that contains the point.
+func applyGeoDist(args []types.Val) (types.Val, error) {
+	g, err := mathToGeo(args[0])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid geometry for func geodist")
+	}
+	p, err := mathToPoint(args[1])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid point for func geodist")
+	}
+	unit, err := mathToString(args[2])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid unit for func geodist")
+	}
+	meters, err := types.DistanceUnit(unit)
+	if err != nil {
+		return types.Val{}, err
+	}
+	dist, err := types.GeoDistance(g, p)
+	if err != nil {
+		return types.Val{}, err
+	}
+	return types.Val{Tid: types.FloatID, Value: dist / meters}, nil
+}
+
+// applyLength returns the length of a line in the given unit, e.g. length(route, "km").
+func applyLength(args []types.Val) (types.Val, error) {
+	g, err := mathToGeo(args[0])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid geometry for func length")
+	}
+	unit, err := mathToString(args[1])
+	if err != nil {
+		return types.Val{}, errors.Wrapf(err, "invalid unit for func length")
+	}
+	meters, err := types.DistanceUnit(unit)
+	if err != nil {
+		return types.Val{}, err
+	}
+	length, err := types.GeoLength(g)
+	if err != nil {
+		return types.Val{}, err
+	}
+	return types.Val{Tid: types.FloatID, Value: length / meters}, nil
+}
+
+func datePart(part func(t time.Time) int64) func(args []types.Val) (types.Val, error) {
+	return func(args []types.Val) (types.Val, error) {
+		t, err := mathToDateTime(args[0])
+		if err != nil {
+			return types.Val{}, errors.Wrapf(err, "invalid datetime")
+		}
+		return types.Val{Tid: types.IntID, Value: part(t)}, nil
+	}
+}
+
+// processValueFunc evaluates the string, datetime and geo functions. Unlike the arithmetic
+// operators, a missing argument doesn't default to zero, the result is just not set for that uid.
+func processValueFunc(mNode *mathTree, fn valueFunc) error {
+	args := make([]types.Val, len(mNode.Child))
+	var maps []*types.ShardedMap
+	var varIdx []int
+	for i, ch := range mNode.Child {
+		switch {
+		case ch.Const.Value != nil:
+			args[i] = ch.Const
+		case ch.Val.Len() == 1:
+			// The output of an aggregation is stored against uid 0 and applies to all uids.
+			if val, ok := ch.Val.Get(0); ok {
+				args[i] = val
+				continue
+			}
+			fallthrough
+		default:
+			maps = append(maps, ch.Val)
+			varIdx = append(varIdx, i)
+		}
+	}
+
+	if len(maps) == 0 {
+		res, err := fn.apply(args)
+		if err != nil {
+			return err
+		}
+		mNode.Const = res
+		return nil
+	}
+
+	destMap := types.NewShardedMap()
+	err := maps[0].Iterate(func(k uint64, val types.Val) error {
+		callArgs := make([]types.Val, len(args))
+		copy(callArgs, args)
+		callArgs[varIdx[0]] = val
+		for i := 1; i < len(maps); i++ {
+			v, ok := maps[i].Get(k)
+			if !ok {
+				return nil
+			}
+			callArgs[varIdx[i]] = v
+		}
+		res, err := fn.apply(callArgs)
+		if err != nil {
+			return err
+		}
+		destMap.Set(k, res)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	mNode.Val = destMap
+	return nil
+}
@@ -62,7 +62,8 @@ func ExpandEdges(ctx context.Context, m *pb.Mutations) ([]*pb.DirectedEdge, erro
 	}(namespace)
 
 	for _, edge := range m.Edges {
-		x.AssertTrue(edge.Op == pb.DirectedEdge_DEL || edge.Op == pb.DirectedEdge_SET)
+		x.AssertTrue(edge.Op == pb.DirectedEdge_DEL || edge.Op == pb.DirectedEdge_SET ||
+			edge.Op == pb.DirectedEdge_EXPIRE)
 		if isGalaxyQuery {
 			// The caller should make sure that the directed edges contain the namespace we want
 			// to insert into. Now, attach the namespace in the context, so that further query
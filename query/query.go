@@ -295,6 +295,9 @@ type SubGraph struct {
 	pathMeta *pathMetadata
 
 	vectorMetrics map[string]uint64
+	// geoDistances are the distances in meters computed by a near or knn_geo root function, if
+	// read with geodist.
+	geoDistances map[uint64]float64
 }
 
 func (sg *SubGraph) recurse(set func(sg *SubGraph)) {
@@ -317,6 +320,20 @@ func (sg *SubGraph) IsInternal() bool {
 	return sg.Params.IsInternal
 }
 
+// geoDistFunc is the name of the block item that reads the distances computed by near or knn_geo.
+const geoDistFunc = "geodist"
+
+// readsGeoDistances returns whether a child reads the distances computed by the function of the
+// SubGraph with geodist.
+func (sg *SubGraph) readsGeoDistances() bool {
+	for _, child := range sg.Children {
+		if child.IsInternal() && child.Attr == geoDistFunc {
+			return true
+		}
+	}
+	return false
+}
+
 func (sg *SubGraph) createSrcFunction(gf *dql.Function) {
 	if gf == nil {
 		return
@@ -604,6 +621,11 @@ func treeCopy(gq *dql.GraphQuery, sg *SubGraph) error {
 			return errors.Errorf("Cannot specify order at both args and facets")
 		}
 
+		if gchild.IsInternal && gchild.Attr == geoDistFunc && !isGeoDistFn(sg.SrcFunc) {
+			return errors.Errorf("geodist can only be used in a block whose function is near " +
+				"or knn_geo")
+		}
+
 		dst := &SubGraph{
 			Attr:   gchild.Attr,
 			Params: args,
@@ -976,6 +998,7 @@ func createTaskQuery(ctx context.Context, sg *SubGraph) (*pb.Query, error) {
 		First:        first,
 		Offset:       offset,
 		Order:        sortMsg,
+		GeoDistances: sg.readsGeoDistances(),
 	}
 
 	if sg.SrcUIDs != nil {
@@ -1369,6 +1392,18 @@ func (sg *SubGraph) valueVarAggregation(doneVars map[string]varValue, path []*Su
 	case sg.Attr == "uid" && sg.Params.DoCount:
 		// This is the count(uid) case.
 		// We will do the computation later while constructing the result.
+	case sg.Attr == geoDistFunc:
+		mp, err := parent.geoDistanceVals()
+		if err != nil {
+			return err
+		}
+		if sg.Params.Var != "" {
+			it := doneVars[sg.Params.Var]
+			it.Vals = mp
+			it.path = path
+			doneVars[sg.Params.Var] = it
+		}
+		sg.Params.UidToVal = mp
 	default:
 		return errors.Errorf("Unhandled pb.node <%v> with parent <%v>", sg.Attr, parent.Attr)
 	}
@@ -1376,6 +1411,25 @@ func (sg *SubGraph) valueVarAggregation(doneVars map[string]varValue, path []*Su
 	return nil
 }
 
+// geoDistanceVals returns the distances computed by the near or knn_geo function of the SubGraph,
+// in the unit given to the function, meters by default.
+func (sg *SubGraph) geoDistanceVals() (*types.ShardedMap, error) {
+	unit := 1.0
+	if len(sg.SrcFunc.Args) == 3 {
+		var err error
+		if unit, err = types.DistanceUnit(sg.SrcFunc.Args[2].Value); err != nil {
+			return nil, err
+		}
+	}
+	mp := types.NewShardedMap()
+	for _, uid := range sg.DestUIDs.GetUids() {
+		if d, ok := sg.geoDistances[uid]; ok && !math.IsInf(d, 1) {
+			mp.Set(uid, types.Val{Tid: types.FloatID, Value: d / unit})
+		}
+	}
+	return mp, nil
+}
+
 func (sg *SubGraph) populatePostAggregation(doneVars map[string]varValue, path []*SubGraph,
 	parent *SubGraph) error {
 
@@ -2256,6 +2310,10 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				rch <- err
 				return
 			}
+			if err := worker.CheckFuncArgs(ctx, taskQuery); err != nil {
+				rch <- err
+				return
+			}
 			result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
 			switch {
 			case err != nil && strings.Contains(err.Error(), worker.ErrNonExistentTabletMessage):
@@ -2267,6 +2325,12 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				return
 			}
 
+			// The values of an encrypted predicate are decrypted for the users allowed to.
+			if err := worker.DecryptValues(ctx, taskQuery.Attr, result.ValueMatrix); err != nil {
+				rch <- err
+				return
+			}
+
 			sg.uidMatrix = result.UidMatrix
 			sg.valueMatrix = result.ValueMatrix
 			sg.facetsMatrix = result.FacetMatrix
@@ -2274,6 +2338,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			sg.LangTags = result.LangMatrix
 			sg.List = result.List
 			sg.vectorMetrics = result.VectorMetrics
+			sg.geoDistances = result.GeoDistances
 
 			if sg.Params.DoCount {
 				if len(sg.Filters) == 0 {
@@ -2751,7 +2816,7 @@ func isValidArg(a string) bool {
 func isValidFuncName(f string) bool {
 	switch f {
 	case "anyofterms", "allofterms", "val", "regexp", "anyoftext", "alloftext", "ngram",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to", "facet":
 		return true
 	}
 	return isInequalityFn(f) || types.IsGeoFunc(f)
@@ -2765,6 +2830,11 @@ func isInequalityFn(f string) bool {
 	return false
 }
 
+// isGeoDistFn returns whether the function computes the distances read with geodist.
+func isGeoDistFn(f *Function) bool {
+	return f != nil && (f.Name == "near" || f.Name == "knn_geo")
+}
+
 func isAggregatorFn(f string) bool {
 	switch f {
 	case "min", "max", "sum", "avg":
@@ -1677,6 +1677,136 @@ func TestNearGeneratorErrorMissDist(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestNearGeneratorUnit(t *testing.T) {
+
+	query := `{
+		me(func:near(loc, [1.1,2.0], 0.005001, "km")) @filter(not uid(25)) {
+			name
+			gender
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne","gender":"female"},{"name":"Rick Grimes","gender": "male"},{"name":"Glenn Rhee"}]}}`, js)
+}
+
+func TestNearGeneratorErrorUnit(t *testing.T) {
+
+	query := `{
+		me(func:near(loc, [1.1,2.0], 5, "parsec")) {
+			name
+		}
+	}`
+
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid unit of distance")
+}
+
+func TestKnnGeo(t *testing.T) {
+
+	query := `{
+		me(func: knn_geo(loc, [1.2, 1.9], 2)) {
+			name
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Rick Grimes"},{"name":"Glenn Rhee"}]}}`, js)
+}
+
+func TestKnnGeoOrderByDistance(t *testing.T) {
+
+	query := `{
+		var(func: knn_geo(loc, [1.2, 1.9], 3)) {
+			l as loc
+			d as math(geodist(l, "[1.2, 1.9]", "km"))
+		}
+		me(func: uid(d), orderdesc: val(d)) {
+			name
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"},{"name":"Glenn Rhee"},
+		{"name":"Rick Grimes"}]}}`, js)
+}
+
+func TestKnnGeoDistance(t *testing.T) {
+
+	query := `{
+		var(func: knn_geo(loc, [1.2, 1.9], 3)) {
+			d as geodist
+		}
+		me(func: uid(d), orderdesc: val(d)) {
+			name
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"},{"name":"Glenn Rhee"},
+		{"name":"Rick Grimes"}]}}`, js)
+}
+
+func TestNearDistance(t *testing.T) {
+
+	// Michonne and Daryl Dixon are at the point, which lies in the polygon of Rick Grimes.
+	query := `{
+		var(func: near(loc, [1.1, 2.0], 5.001)) {
+			d as geodist
+		}
+		me(func: uid(d)) @filter(lt(val(d), 1.0)) {
+			name
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"},{"name":"Rick Grimes"},
+		{"name":"Daryl Dixon"}]}}`, js)
+
+	// Glenn Rhee is about 1.1 meters away.
+	query = `{
+		var(func: near(loc, [1.1, 2.0], 0.005001, "km")) {
+			d as geodist
+		}
+		me(func: uid(d)) @filter(gt(val(d), 0.001) AND lt(val(d), 0.0012)) {
+			name
+		}
+	}`
+
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Glenn Rhee"}]}}`, js)
+}
+
+func TestGeoDistanceWithoutGeoFunc(t *testing.T) {
+
+	query := `{
+		var(func: uid(1)) {
+			d as geodist
+		}
+		me(func: uid(d)) {
+			name
+		}
+	}`
+
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "geodist can only be used in a block whose function is near")
+}
+
+func TestKnnGeoError(t *testing.T) {
+
+	query := `{
+		me(func: knn_geo(loc, [1.2, 1.9], 0)) {
+			name
+		}
+	}`
+
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid number of neighbors")
+}
+
 func TestWithinGeneratorError(t *testing.T) {
 
 	query := `{
@@ -2649,6 +2779,19 @@ func TestNearPoint2(t *testing.T) {
 	require.JSONEq(t, expected, js)
 }
 
+func TestDWithinLine(t *testing.T) {
+
+	query := `{
+		me(func: dwithin_line(geometry, [[-122.09, 37.42], [-122.07, 37.43]], 500)) {
+			name
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	expected := `{"data": {"me":[{"name":"Googleplex"},{"name":"Shoreline Amphitheater"}, {"name": "SF Bay area"}, {"name": "Mountain View"}]}}`
+	require.JSONEq(t, expected, js)
+}
+
 func TestIntersectsPolygon1(t *testing.T) {
 
 	query := `{
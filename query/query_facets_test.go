@@ -9,6 +9,7 @@
 package query
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -2356,3 +2357,36 @@ func TestCountFacetsFilteringScalarListPredicate(t *testing.T) {
 		}
 	}`, js)
 }
+
+func TestFacetIndexRootQuery(t *testing.T) {
+	setSchema(`follows: [uid] @facets(since: datetime @index(year), weight: int @index(int)) .`)
+	defer dropPredicate("follows")
+	require.NoError(t, addTriplesToCluster(`
+		<1> <follows> <23> (since = 2019-12-31T23:00:00, weight = 3) .
+		<1> <follows> <24> (since = 2020-05-01T00:00:00, weight = 1) .
+		<23> <follows> <24> (since = 2020-01-01T00:00:00, weight = "5") .
+		<31> <follows> <1> (since = 2021-01-01T00:00:00) .
+	`))
+
+	tests := []struct {
+		fn       string
+		expected string
+	}{
+		{`facet(follows, since, ge, "2020-01-01")`, `[{"uid":"0x1"},{"uid":"0x17"},{"uid":"0x1f"}]`},
+		{`facet(follows, since, lt, "2020-01-01")`, `[{"uid":"0x1"}]`},
+		{`facet(follows, since, between, "2020-02-01", "2020-12-31")`, `[{"uid":"0x1"}]`},
+		{`facet(follows, weight, eq, 5)`, `[{"uid":"0x17"}]`},
+		{`facet(follows, weight, gt, 1)`, `[{"uid":"0x1"},{"uid":"0x17"}]`},
+	}
+	for _, tc := range tests {
+		js := processQueryNoErr(t, fmt.Sprintf(`{ q(func: %s) { uid } }`, tc.fn))
+		require.JSONEq(t, fmt.Sprintf(`{"data": {"q": %s}}`, tc.expected), js, tc.fn)
+	}
+
+	// Facets declared in the schema must be of the declared type.
+	err := addTriplesToCluster(`<1> <follows> <25> (weight = "heavy") .`)
+	require.ErrorContains(t, err, "invalid value for facet weight")
+
+	_, err = processQuery(context.Background(), t, `{ q(func: facet(follows, tag, eq, 1)) { uid } }`)
+	require.ErrorContains(t, err, "Facet tag of predicate follows is not indexed")
+}
@@ -151,10 +151,10 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 			out = append(out, exp...)
 		}
 
-		if numEdges > x.Config.LimitQueryEdge {
+		if limit := x.QueryEdgeLimit(ctx); numEdges > limit {
 			// If we've seen too many edges, stop the query.
 			return errors.Errorf("Exceeded query edge limit = %v. Found %v edges.",
-				x.Config.LimitQueryEdge, numEdges)
+				limit, numEdges)
 		}
 
 		if len(out) == 0 {
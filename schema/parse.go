@@ -7,11 +7,15 @@ package schema
 
 import (
 	"math"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/dgraph-io/dgraph/v25/lex"
 	"github.com/dgraph-io/dgraph/v25/protos/pb"
@@ -52,13 +56,14 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 		}
 		schema.Directive = pb.SchemaUpdate_REVERSE
 	case "index":
-		tokenizer, vectorSpecs, err := parseIndexDirective(it, schema.Predicate, t)
+		tokenizer, vectorSpecs, analyzers, err := parseIndexDirective(it, schema.Predicate, t)
 		if err != nil {
 			return err
 		}
 		schema.Directive = pb.SchemaUpdate_INDEX
 		schema.Tokenizer = tokenizer
 		schema.IndexSpecs = vectorSpecs
+		schema.Analyzers = analyzers
 	case "count":
 		schema.Count = true
 	case "upsert":
@@ -67,6 +72,20 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 		schema.Unique = true
 	case "noconflict":
 		schema.NoConflict = true
+	case "facets":
+		facets, err := parseFacetsDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.Facets = facets
+	case "ttl":
+		if err := parseTTLDirective(it, schema, t); err != nil {
+			return err
+		}
+	case "encrypted":
+		if err := parseEncryptedDirective(it, schema, t); err != nil {
+			return err
+		}
 	case "lang":
 		if t != types.StringID || schema.List {
 			return next.Errorf("@lang directive can only be specified for string type."+
@@ -165,6 +184,218 @@ func parseScalarPair(it *lex.ItemIterator, predicate string, ns uint64) (*pb.Sch
 	return schema, nil
 }
 
+// parseFacetsDirective works on "@facets(name: type, name: type @index(tokenizer), ...)".
+// We assume that the "@facets" has already been found. The declared facets are typed, and
+// can be indexed using a sortable tokenizer of their type.
+func parseFacetsDirective(it *lex.ItemIterator, predicate string) ([]*pb.FacetSchema, error) {
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return nil, it.Item().Errorf("Expected '(' after @facets for predicate %s",
+			x.ParseAttr(predicate))
+	}
+
+	var facets []*pb.FacetSchema
+	seen := make(map[string]bool)
+	for {
+		it.Next()
+		next := it.Item()
+		if next.Typ != itemText {
+			return nil, next.Errorf("Expected facet name but found '%s'", next.Val)
+		}
+		name := next.Val
+		if seen[name] {
+			return nil, next.Errorf("Duplicate facet %s for predicate %s", name,
+				x.ParseAttr(predicate))
+		}
+		seen[name] = true
+
+		it.Next()
+		if next = it.Item(); next.Typ != itemColon {
+			return nil, next.Errorf("Missing colon after facet %s", name)
+		}
+		it.Next()
+		next = it.Item()
+		if next.Typ != itemText {
+			return nil, next.Errorf("Missing type for facet %s", name)
+		}
+		typ, ok := types.TypeForName(strings.ToLower(next.Val))
+		if !ok || !isFacetType(typ) {
+			return nil, next.Errorf("Invalid type %s for facet %s", next.Val, name)
+		}
+		facet := &pb.FacetSchema{Name: name, ValueType: typ.Enum()}
+
+		it.Next()
+		next = it.Item()
+		if next.Typ == itemAt {
+			tokenizer, err := parseFacetIndex(it, name, typ)
+			if err != nil {
+				return nil, err
+			}
+			facet.Tokenizer = tokenizer
+			it.Next()
+			next = it.Item()
+		}
+		facets = append(facets, facet)
+
+		switch next.Typ {
+		case itemRightRound:
+			return facets, nil
+		case itemComma:
+		default:
+			return nil, next.Errorf("Expected ',' or ')' but found '%s' for predicate '%s'",
+				next.Val, x.ParseAttr(predicate))
+		}
+	}
+}
+
+// parseTTLDirective works on "@ttl(duration)" and "@ttl". We assume that the "@ttl" has already
+// been found. Without a duration, the datetime values of the predicate are their expiry time.
+func parseTTLDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeID) error {
+	next, ok := it.PeekOne()
+	if !ok || next.Typ != itemLeftRound {
+		if t != types.DateTimeID {
+			return it.Item().Errorf("@ttl without a duration can only be specified for "+
+				"datetime type. Got: [%v] for attr: [%v]", t.Name(), x.ParseAttr(schema.Predicate))
+		}
+		schema.TtlFromValue = true
+		return nil
+	}
+	it.Next()
+
+	// A duration like 1h30m is lexed as a number followed by text.
+	var dur strings.Builder
+	for it.Next() {
+		next = it.Item()
+		if next.Typ == itemRightRound || next.Typ == lex.ItemEOF {
+			break
+		}
+		if next.Typ != itemNumber && next.Typ != itemText {
+			return next.Errorf("Invalid duration in @ttl for predicate %s",
+				x.ParseAttr(schema.Predicate))
+		}
+		dur.WriteString(next.Val)
+	}
+	if next.Typ != itemRightRound {
+		return next.Errorf("Expected ')' after @ttl for predicate %s", x.ParseAttr(schema.Predicate))
+	}
+	ttl, err := parseTTL(dur.String())
+	if err != nil {
+		return next.Errorf("Invalid duration %q in @ttl for predicate %s: %v", dur.String(),
+			x.ParseAttr(schema.Predicate), err)
+	}
+	schema.Ttl = int64(ttl / time.Second)
+	return nil
+}
+
+// parseEncryptedDirective works on "@encrypted(key: "name", mode: "deterministic",
+// groups: "g1,g2")". We assume that the "@encrypted" has already been found. The mode is
+// "random" by default, and only the users of the groups can read the values decrypted.
+func parseEncryptedDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeID) error {
+	if t != types.StringID {
+		return it.Item().Errorf("@encrypted directive can only be specified for string type."+
+			" Got: [%v] for attr: [%v]", t.Name(), x.ParseAttr(schema.Predicate))
+	}
+	opts, err := parseTokenOptions(it)
+	if err != nil {
+		return err
+	}
+	enc := &pb.FieldEncryption{}
+	for _, opt := range opts {
+		switch opt.Key {
+		case "key":
+			enc.Key = opt.Value
+		case "mode":
+			switch opt.Value {
+			case "deterministic":
+				enc.Deterministic = true
+			case "random":
+			default:
+				return it.Item().Errorf("Invalid mode %q in @encrypted for predicate %s, it "+
+					"must be deterministic or random", opt.Value, x.ParseAttr(schema.Predicate))
+			}
+		case "groups":
+			for _, g := range strings.Split(opt.Value, ",") {
+				if g = strings.TrimSpace(g); g != "" {
+					enc.Groups = append(enc.Groups, g)
+				}
+			}
+		default:
+			return it.Item().Errorf("Invalid option %s in @encrypted for predicate %s",
+				opt.Key, x.ParseAttr(schema.Predicate))
+		}
+	}
+	if enc.Key == "" {
+		return it.Item().Errorf("Missing key in @encrypted for predicate %s",
+			x.ParseAttr(schema.Predicate))
+	}
+	schema.Encryption = enc
+	return nil
+}
+
+// parseTTL parses a duration, which can also be given in days, like 30d or 1d12h.
+func parseTTL(s string) (time.Duration, error) {
+	var days time.Duration
+	if idx := strings.Index(s, "d"); idx > 0 {
+		n, err := strconv.ParseUint(s[:idx], 10, 32)
+		if err != nil {
+			return 0, err
+		}
+		days = time.Duration(n) * 24 * time.Hour
+		if s = s[idx+1:]; s == "" {
+			s = "0s"
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d += days; d < time.Second {
+		return 0, errors.Errorf("duration must be at least a second")
+	}
+	return d, nil
+}
+
+// parseFacetIndex works on "@index(tokenizer)" for a facet, with the iterator at the '@'.
+func parseFacetIndex(it *lex.ItemIterator, facet string, typ types.TypeID) (string, error) {
+	it.Next()
+	if next := it.Item(); next.Typ != itemText || next.Val != "index" {
+		return "", next.Errorf("Only @index is allowed for facet %s", facet)
+	}
+	it.Next()
+	if next := it.Item(); next.Typ != itemLeftRound {
+		return "", next.Errorf("Require type of tokenizer for facet %s for indexing.", facet)
+	}
+	it.Next()
+	next := it.Item()
+	if next.Typ != itemText {
+		return "", next.Errorf("Expected tokenizer name but found '%s'", next.Val)
+	}
+	tokenizer, ok := tok.GetTokenizer(strings.ToLower(next.Val))
+	if !ok {
+		return "", next.Errorf("Invalid tokenizer %s", next.Val)
+	}
+	if tokenizerType, _ := types.TypeForName(tokenizer.Type()); tokenizerType != typ {
+		return "", next.Errorf("Tokenizer: %s isn't valid for facet: %s of type: %s",
+			tokenizer.Name(), facet, typ.Name())
+	}
+	if !tokenizer.IsSortable() {
+		return "", next.Errorf("Tokenizer: %s can't be used for facet: %s, facets can only"+
+			" be indexed with a sortable tokenizer", tokenizer.Name(), facet)
+	}
+	it.Next()
+	if next = it.Item(); next.Typ != itemRightRound {
+		return "", next.Errorf("Expected ')' but found '%s' for facet %s", next.Val, facet)
+	}
+	return tokenizer.Name(), nil
+}
+
+func isFacetType(typ types.TypeID) bool {
+	switch typ {
+	case types.IntID, types.FloatID, types.BoolID, types.DateTimeID, types.StringID:
+		return true
+	}
+	return false
+}
+
 // parseIndexDirective works on "@index" or "@index(customtokenizer)"
 // or @index(tok1(opt1:"opt1val",opt2:"opt2val"), tok2, tok3)
 // We assume that the "@index" has already been found, so we just need
@@ -179,51 +410,60 @@ func parseScalarPair(it *lex.ItemIterator, predicate string, ns uint64) (*pb.Sch
 //	Tokens ::= '(' TokenList ')'
 //	TokenList ::= Token [',' TokeniList]
 //
-// It then defers to parseTokenOrVectorIndexSpec to parse Token.
+// It then defers to parseTokenOrVectorIndexSpec to parse Token. The analyzers of the tokenizers
+// are returned by name, and resolved to their declaration once the whole schema is parsed.
 func parseIndexDirective(it *lex.ItemIterator, predicate string,
-	typ types.TypeID) ([]string, []*pb.VectorIndexSpec, error) {
+	typ types.TypeID) ([]string, []*pb.VectorIndexSpec, map[string]*pb.Analyzer, error) {
 	tokenizers := []string{}
 	var vectorSpecs []*pb.VectorIndexSpec
+	var analyzers map[string]*pb.Analyzer
 	var seen = make(map[string]bool)
 	var seenSortableTok bool
 
 	if typ == types.UidID || typ == types.DefaultID || typ == types.PasswordID {
-		return tokenizers, vectorSpecs,
+		return tokenizers, vectorSpecs, nil,
 			it.Item().Errorf("Indexing not allowed on predicate %s of type %s",
 				predicate, typ.Name())
 	}
 	if !it.Next() {
 		// Nothing to read.
-		return tokenizers, vectorSpecs, it.Item().Errorf("Invalid ending.")
+		return tokenizers, vectorSpecs, nil, it.Item().Errorf("Invalid ending.")
 	}
 	next := it.Item()
 	if next.Typ != itemLeftRound {
 		it.Prev() // Backup.
-		return tokenizers, vectorSpecs,
+		return tokenizers, vectorSpecs, nil,
 			it.Item().Errorf("Require type of tokenizer for pred: %s for indexing.",
 				predicate)
 	}
 
 	// Look for tokenizers and IndexFactories (vectorSpecs).
 	for {
-		tokenText, vectorSpec, sortable, err := parseTokenOrVectorIndexSpec(it, predicate, typ)
+		tokenText, vectorSpec, analyzer, sortable, err :=
+			parseTokenOrVectorIndexSpec(it, predicate, typ)
 		if err != nil {
-			return tokenizers, vectorSpecs, err
+			return tokenizers, vectorSpecs, nil, err
 		}
 		if sortable && seenSortableTok {
-			return tokenizers, vectorSpecs,
+			return tokenizers, vectorSpecs, nil,
 				next.Errorf("Only one index tokenizer can be sortable for %s",
 					predicate)
 		}
 		seenSortableTok = sortable
 		if tokenText != "" {
 			if _, found := seen[tokenText]; found {
-				return tokenizers, vectorSpecs,
+				return tokenizers, vectorSpecs, nil,
 					next.Errorf("Duplicate tokenizers defined for predicate %v",
 						predicate)
 			}
 			tokenizers = append(tokenizers, tokenText)
 			seen[tokenText] = true
+			if analyzer != "" {
+				if analyzers == nil {
+					analyzers = make(map[string]*pb.Analyzer)
+				}
+				analyzers[tokenText] = &pb.Analyzer{Name: analyzer}
+			}
 		} else {
 			// parseTokenOrVectorIndexSpec should have returned either
 			// non-empty tokenText or non-nil vectorsSpec or an error.
@@ -243,12 +483,12 @@ func parseIndexDirective(it *lex.ItemIterator, predicate string,
 			break
 		}
 		if next.Typ != itemComma {
-			return tokenizers, vectorSpecs, next.Errorf(
+			return tokenizers, vectorSpecs, nil, next.Errorf(
 				"Expected ',' or ')' but found '%s' for predicate '%s'",
 				next.Val, predicate)
 		}
 	}
-	return tokenizers, vectorSpecs, nil
+	return tokenizers, vectorSpecs, analyzers, nil
 }
 
 // parseTokenOrVectorIndexSpec(it, predicate, typ) will parse a "Token" according to the
@@ -259,16 +499,17 @@ func parseIndexDirective(it *lex.ItemIterator, predicate string,
 //
 // For TokenOptions, it defers to parseTokenOptions parsing.
 // We expect either to find the name of a Tokenizer or else the name of an IndexFactory
-// along with its options. We also return a boolean value indicating whether or
+// along with its options. The only option of a Tokenizer is the name of its analyzer, for the
+// fulltext and term tokenizers. We also return a boolean value indicating whether or
 // not the found index is Sortable.
 func parseTokenOrVectorIndexSpec(
 	it *lex.ItemIterator,
 	predicate string,
-	typ types.TypeID) (string, *pb.VectorIndexSpec, bool, error) {
+	typ types.TypeID) (string, *pb.VectorIndexSpec, string, bool, error) {
 	it.Next()
 	next := it.Item()
 	if next.Typ != itemText {
-		return "", nil, false, next.Errorf(
+		return "", nil, "", false, next.Errorf(
 			"Expected token or VectorFactory name, but found '%s'",
 			next.Val)
 	}
@@ -278,19 +519,19 @@ func parseTokenOrVectorIndexSpec(
 		// TODO: Consider allowing IndexFactory types not related to
 		//       VectorIndex objects.
 		if typ != types.VFloatID {
-			return "", nil, false,
+			return "", nil, "", false,
 				next.Errorf("IndexFactory: %s isn't valid for predicate: %s of type: %s",
 					factory.Name(), x.ParseAttr(predicate), typ.Name())
 		}
-		tokenOpts, err := parseTokenOptions(it, factory)
+		tokenOpts, err := parseTokenOptions(it)
 		if err != nil {
-			return "", nil, false, err
+			return "", nil, "", false, err
 		}
 		allowedOpts := factory.AllowedOptions()
 		for _, pair := range tokenOpts {
 			_, err := allowedOpts.GetParsedOption(pair.Key, pair.Value)
 			if err != nil {
-				return "", nil, false,
+				return "", nil, "", false,
 					next.Errorf("IndexFactory: %s issues this error: '%s'",
 						factory.Name(), err)
 			}
@@ -299,23 +540,38 @@ func parseTokenOrVectorIndexSpec(
 			Name:    tokenOrFactoryName,
 			Options: tokenOpts,
 		}
-		return "", vs, factory.IsSortable(), err
+		return "", vs, "", factory.IsSortable(), err
 	}
 
 	// Look for custom tokenizer, and validate its type.
 	tokenizer, has := tok.GetTokenizer(tokenOrFactoryName)
 	if !has {
-		return tokenOrFactoryName, nil, false,
+		return tokenOrFactoryName, nil, "", false,
 			next.Errorf("Invalid tokenizer %s", next.Val)
 	}
 	tokenizerType, ok := types.TypeForName(tokenizer.Type())
 	x.AssertTrue(ok) // Type is validated during tokenizer loading.
 	if tokenizerType != typ {
-		return tokenOrFactoryName, nil, false,
+		return tokenOrFactoryName, nil, "", false,
 			next.Errorf("Tokenizer: %s isn't valid for predicate: %s of type: %s",
 				tokenizer.Name(), x.ParseAttr(predicate), typ.Name())
 	}
-	return tokenOrFactoryName, nil, tokenizer.IsSortable(), nil
+
+	var analyzer string
+	if allowedOpts, ok := tok.TokenizerOptions(tokenOrFactoryName); ok {
+		tokenOpts, err := parseTokenOptions(it)
+		if err != nil {
+			return "", nil, "", false, err
+		}
+		for _, pair := range tokenOpts {
+			if _, err := allowedOpts.GetParsedOption(pair.Key, pair.Value); err != nil {
+				return "", nil, "", false,
+					next.Errorf("Tokenizer: %s issues this error: '%s'", tokenizer.Name(), err)
+			}
+			analyzer = pair.Value
+		}
+	}
+	return tokenOrFactoryName, nil, analyzer, tokenizer.IsSortable(), nil
 }
 
 // parseTokenOptions(it, factory) will parse "TokenOptions" according to the
@@ -333,10 +589,11 @@ func parseTokenOrVectorIndexSpec(
 // discovered by it is a comma or end-parenthesis. (In the context where we
 // invoke this, a comma indicates another tokenizer, and an end-parenthesis
 // indicates the end of a list of tokenizers.
+//
 // TokenOptions provide the OptionKey-OptionValue pairs needed for building
-// a VectorIndex. The factory is used to validate that any option name given
-// is specified as an AllowedOption.
-func parseTokenOptions(it *lex.ItemIterator, factory tok.IndexFactory) ([]*pb.OptionPair, error) {
+// a VectorIndex, an analyzer stage or for choosing the analyzer of a tokenizer. The
+// caller validates that any option name given is specified as an AllowedOption.
+func parseTokenOptions(it *lex.ItemIterator) ([]*pb.OptionPair, error) {
 	retVal := []*pb.OptionPair{}
 	nextItem, found := it.PeekOne()
 	if !found {
@@ -352,7 +609,7 @@ func parseTokenOptions(it *lex.ItemIterator, factory tok.IndexFactory) ([]*pb.Op
 	}
 	it.Next() // Reads initial '('
 	for {
-		optPair, err := parseTokenOption(it, factory)
+		optPair, err := parseTokenOption(it)
 		if err != nil {
 			return retVal, err
 		}
@@ -370,13 +627,12 @@ func parseTokenOptions(it *lex.ItemIterator, factory tok.IndexFactory) ([]*pb.Op
 	}
 }
 
-// parseTokenOption(it, factory) constructs OptionPair instances
-// and validates that the options are okay via the factory.
+// parseTokenOption(it) constructs OptionPair instances.
 //
 //	TokenOption ::= OptionName ':' OptionValue
 //	OptionName ::= {itemText from Lexer}
 //	OptionValue ::= {itemQuotedText from Lexer}
-func parseTokenOption(it *lex.ItemIterator, factory tok.IndexFactory) (*pb.OptionPair, error) {
+func parseTokenOption(it *lex.ItemIterator) (*pb.OptionPair, error) {
 	it.Next()
 	nextItem := it.Item()
 	if nextItem.Typ != itemText {
@@ -435,6 +691,17 @@ func resolveTokenizers(updates []*pb.SchemaUpdate) error {
 			return errors.Errorf("Tokenizers present without indexing on attr %s",
 				x.ParseAttr(schema.Predicate))
 		}
+		// The equal values of a predicate encrypted in the deterministic mode have equal
+		// ciphertexts, which only the equality indexes can use.
+		if enc := schema.GetEncryption(); enc != nil {
+			for _, t := range schema.Tokenizer {
+				if !enc.Deterministic || (t != "exact" && t != "hash") {
+					return errors.Errorf("Tokenizer %s isn't allowed on predicate %s, which is "+
+						"encrypted. Only exact and hash are, in the deterministic mode",
+						t, x.ParseAttr(schema.Predicate))
+				}
+			}
+		}
 		// check for valid tokenizer types and duplicates
 		var seen = make(map[string]bool)
 		var seenSortableTok bool
@@ -480,6 +747,12 @@ func parseTypeDeclaration(it *lex.ItemIterator, ns uint64) (*pb.TypeUpdate, erro
 	typeUpdate := &pb.TypeUpdate{TypeName: x.NamespaceAttr(ns, it.Item().Val)}
 
 	it.Next()
+	for it.Item().Typ == itemAt {
+		if err := parseTypeDirective(it, typeUpdate, ns); err != nil {
+			return nil, err
+		}
+		it.Next()
+	}
 	if it.Item().Typ != itemLeftCurl {
 		return nil, it.Item().Errorf("Expected {. Got %v", it.Item().Val)
 	}
@@ -506,6 +779,21 @@ func parseTypeDeclaration(it *lex.ItemIterator, ns uint64) (*pb.TypeUpdate, erro
 
 				fieldSet[field.GetPredicate()] = struct{}{}
 			}
+			for _, tuple := range typeUpdate.Unique {
+				for _, pred := range tuple.Predicates {
+					if _, ok := fieldSet[pred]; !ok {
+						return nil, it.Item().Errorf("@unique of type %s names %s, which isn't "+
+							"a field of the type", x.ParseAttr(typeUpdate.TypeName), x.ParseAttr(pred))
+					}
+				}
+			}
+
+			if pred := typeUpdate.TtlPredicate; pred != "" {
+				if _, ok := fieldSet[pred]; !ok {
+					return nil, it.Item().Errorf("@ttl of type %s names %s, which isn't "+
+						"a field of the type", x.ParseAttr(typeUpdate.TypeName), x.ParseAttr(pred))
+				}
+			}
 
 			typeUpdate.Fields = fields
 			return typeUpdate, nil
@@ -524,10 +812,63 @@ func parseTypeDeclaration(it *lex.ItemIterator, ns uint64) (*pb.TypeUpdate, erro
 	return nil, errors.Errorf("Shouldn't reach here.")
 }
 
+// parseTypeDirective parses a directive of a type declaration: @closed, @unique over a tuple of
+// its fields, like @unique(tenant, email), or @ttl over the datetime field that holds the expiry
+// time of the nodes, like @ttl(expires_at).
+func parseTypeDirective(it *lex.ItemIterator, typeUpdate *pb.TypeUpdate, ns uint64) error {
+	it.Next()
+	next := it.Item()
+	typeName := x.ParseAttr(typeUpdate.TypeName)
+	if next.Typ != itemText {
+		return next.Errorf("Invalid directive @%s for type %s", next.Val, typeName)
+	}
+	switch next.Val {
+	case "closed":
+		typeUpdate.Closed = true
+	case "unique":
+		args, err := parseConstraintArgs(it, next.Val, "type "+typeName)
+		if err != nil {
+			return err
+		}
+		if len(args) < 2 {
+			return next.Errorf("@unique of type %s needs at least two fields, got %v",
+				typeName, args)
+		}
+		tuple := &pb.UniqueTuple{}
+		for _, arg := range args {
+			pred := x.NamespaceAttr(ns, arg)
+			if slices.Contains(tuple.Predicates, pred) {
+				return next.Errorf("@unique of type %s names %s more than once", typeName, arg)
+			}
+			tuple.Predicates = append(tuple.Predicates, pred)
+		}
+		typeUpdate.Unique = append(typeUpdate.Unique, tuple)
+	case "ttl":
+		args, err := parseConstraintArgs(it, next.Val, "type "+typeName)
+		if err != nil {
+			return err
+		}
+		if len(args) != 1 {
+			return next.Errorf("@ttl of type %s needs the datetime field that holds the expiry "+
+				"time of its nodes, got %v", typeName, args)
+		}
+		typeUpdate.TtlPredicate = x.NamespaceAttr(ns, args[0])
+	default:
+		return next.Errorf("Invalid directive @%s for type %s", next.Val, typeName)
+	}
+	return nil
+}
+
 func parseTypeField(it *lex.ItemIterator, typeName string, ns uint64) (*pb.SchemaUpdate, error) {
 	field := &pb.SchemaUpdate{Predicate: x.NamespaceAttr(ns, it.Item().Val)}
 	var list bool
 	it.Next()
+	for it.Item().Typ == itemAt {
+		if err := parseFieldConstraint(it, field); err != nil {
+			return nil, err
+		}
+		it.Next()
+	}
 
 	// Simplified type definitions only require the field name. If a new line is found,
 	// proceed to the next field in the type.
@@ -578,6 +919,129 @@ func parseTypeField(it *lex.ItemIterator, typeName string, ns uint64) (*pb.Schem
 	return field, nil
 }
 
+// parseFieldConstraint works on a directive of a field of a type, which constrains the values of
+// the field on the nodes of the type. The iterator is at the '@'. The supported directives are
+// @required, @min(n), @max(n), @length(min[, max]), @regex("..."), @enum("a", ...) and
+// @target(Type). Numbers that the lexer can't read, like negative ones, can be quoted.
+func parseFieldConstraint(it *lex.ItemIterator, field *pb.SchemaUpdate) error {
+	it.Next()
+	next := it.Item()
+	if next.Typ != itemText {
+		return next.Errorf("Missing directive name")
+	}
+	if field.Constraint == nil {
+		field.Constraint = &pb.FieldConstraint{}
+	}
+	c := field.Constraint
+	name := next.Val
+	pred := x.ParseAttr(field.Predicate)
+	switch name {
+	case "required":
+		c.Required = true
+		return nil
+	case "min", "max", "length", "regex", "enum", "target":
+	default:
+		return next.Errorf("Invalid directive @%s for field %s", name, pred)
+	}
+
+	args, err := parseConstraintArgs(it, name, "field "+pred)
+	if err != nil {
+		return err
+	}
+	argsErr := func(want string) error {
+		return next.Errorf("@%s expects %s for field %s, got %v", name, want, pred, args)
+	}
+	switch name {
+	case "min", "max":
+		if len(args) != 1 {
+			return argsErr("a number")
+		}
+		v, err := strconv.ParseFloat(args[0], 64)
+		if err != nil {
+			return argsErr("a number")
+		}
+		if name == "min" {
+			c.Min = &v
+		} else {
+			c.Max = &v
+		}
+	case "length":
+		if len(args) == 0 || len(args) > 2 {
+			return argsErr("a minimum and an optional maximum length")
+		}
+		var lengths []int64
+		for _, arg := range args {
+			n, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil || n < 0 {
+				return argsErr("a minimum and an optional maximum length")
+			}
+			lengths = append(lengths, n)
+		}
+		c.MinLength = &lengths[0]
+		if len(lengths) == 2 {
+			if lengths[1] < lengths[0] {
+				return argsErr("a maximum length not less than the minimum")
+			}
+			c.MaxLength = &lengths[1]
+		}
+	case "regex":
+		if len(args) != 1 {
+			return argsErr("a regular expression")
+		}
+		if _, err := regexp.Compile(args[0]); err != nil {
+			return next.Errorf("Invalid regular expression for field %s: %v", pred, err)
+		}
+		c.Regex = args[0]
+	case "enum":
+		if len(args) == 0 {
+			return argsErr("at least one value")
+		}
+		c.Enum = args
+	case "target":
+		if len(args) != 1 {
+			return argsErr("a type name")
+		}
+		c.TargetType = args[0]
+	}
+	return nil
+}
+
+// parseConstraintArgs parses the arguments of a directive of a field or a type, like (1, 10) or
+// ("a", "b"). The owner names the field or the type in the errors.
+func parseConstraintArgs(it *lex.ItemIterator, name, owner string) ([]string, error) {
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return nil, it.Item().Errorf("Expected '(' after @%s for %s", name, owner)
+	}
+	var args []string
+	var arg strings.Builder
+	for it.Next() {
+		next := it.Item()
+		switch next.Typ {
+		case itemQuotedText:
+			val, err := strconv.Unquote(next.Val)
+			if err != nil {
+				val = next.Val[1 : len(next.Val)-1]
+			}
+			arg.WriteString(val)
+		case itemNumber, itemDot, itemText:
+			// A number like 1.5 is lexed as a number, a dot and a number.
+			arg.WriteString(next.Val)
+		case itemComma, itemRightRound:
+			if arg.Len() == 0 {
+				return nil, next.Errorf("Missing value in @%s for %s", name, owner)
+			}
+			args = append(args, arg.String())
+			arg.Reset()
+			if next.Typ == itemRightRound {
+				return args, nil
+			}
+		default:
+			return nil, next.Errorf("Unexpected '%s' in @%s for %s", next.Val, name, owner)
+		}
+	}
+	return nil, it.Item().Errorf("Expected ')' after @%s for %s", name, owner)
+}
+
 func parseNamespace(it *lex.ItemIterator) (uint64, error) {
 	nextItems, err := it.Peek(2)
 	if err != nil {
@@ -618,13 +1082,139 @@ func isTypeDeclaration(item lex.Item, it *lex.ItemIterator) bool {
 	case nextItems[0].Typ != itemText:
 		return false
 
-	case nextItems[1].Typ != itemLeftCurl:
+	case nextItems[1].Typ != itemLeftCurl && nextItems[1].Typ != itemAt:
+		// The name of the type is followed by its fields, or by a directive like @closed.
 		return false
 	}
 
 	return true
 }
 
+func isAnalyzerDeclaration(item lex.Item, it *lex.ItemIterator) bool {
+	if item.Val != "analyzer" {
+		return false
+	}
+	nextItems, err := it.Peek(2)
+	if err != nil || len(nextItems) != 2 {
+		return false
+	}
+	return nextItems[0].Typ == itemText && nextItems[1].Typ == itemLeftCurl
+}
+
+// parseAnalyzerDeclaration parses the declaration of a text analyzer, which the fulltext and term
+// indexes of the schema can reference by name:
+//
+//	analyzer product_en {
+//		char_filter: html
+//		tokenizer: unicode
+//		filter: lowercase
+//		filter: stop(lang: "en")
+//		filter: synonyms(words: "tv,television;laptop,notebook")
+//	}
+//
+// The stages run in the order in which they are declared.
+func parseAnalyzerDeclaration(it *lex.ItemIterator) (*pb.Analyzer, error) {
+	// Iterator is currently on the token corresponding to the keyword analyzer.
+	it.Next()
+	if it.Item().Typ != itemText {
+		return nil, it.Item().Errorf("Expected analyzer name. Got %v", it.Item().Val)
+	}
+	name := it.Item().Val
+	analyzer := &pb.Analyzer{Name: name}
+
+	it.Next()
+	if it.Item().Typ != itemLeftCurl {
+		return nil, it.Item().Errorf("Expected {. Got %v", it.Item().Val)
+	}
+
+	for it.Next() {
+		item := it.Item()
+		switch item.Typ {
+		case itemRightCurl:
+			it.Next()
+			if it.Item().Typ != itemNewLine && it.Item().Typ != lex.ItemEOF {
+				return nil, it.Item().Errorf(
+					"Expected new line or EOF after analyzer declaration. Got %v", it.Item())
+			}
+			it.Prev()
+			if err := tok.ValidateAnalyzer(analyzer); err != nil {
+				return nil, item.Errorf("Invalid analyzer %s: %v", name, err)
+			}
+			return analyzer, nil
+		case itemText:
+			kind := item.Val
+			it.Next()
+			if it.Item().Typ != itemColon {
+				return nil, it.Item().Errorf("Expected ':' after %s in analyzer %s. Got %v",
+					kind, name, it.Item().Val)
+			}
+			stage, err := parseAnalyzerStage(it)
+			if err != nil {
+				return nil, err
+			}
+			switch kind {
+			case "char_filter":
+				analyzer.CharFilters = append(analyzer.CharFilters, stage)
+			case "tokenizer":
+				if analyzer.Tokenizer != nil {
+					return nil, item.Errorf("Analyzer %s has more than one tokenizer", name)
+				}
+				analyzer.Tokenizer = stage
+			case "filter":
+				analyzer.Filters = append(analyzer.Filters, stage)
+			default:
+				return nil, item.Errorf("Invalid stage %s in analyzer %s, expected char_filter, "+
+					"tokenizer or filter", kind, name)
+			}
+		case itemNewLine:
+			// Ignore empty lines.
+		default:
+			return nil, it.Item().Errorf("Unexpected token. Got %v", it.Item().Val)
+		}
+	}
+	return nil, errors.Errorf("Shouldn't reach here.")
+}
+
+// parseAnalyzerStage parses a stage of an analyzer, which is the name of the stage followed by
+// optional TokenOptions, like stop(lang: "en").
+func parseAnalyzerStage(it *lex.ItemIterator) (*pb.AnalyzerStage, error) {
+	it.Next()
+	if it.Item().Typ != itemText {
+		return nil, it.Item().Errorf("Expected analyzer stage name. Got %v", it.Item().Val)
+	}
+	stage := &pb.AnalyzerStage{Name: strings.ToLower(it.Item().Val)}
+	if next, ok := it.PeekOne(); !ok || next.Typ != itemLeftRound {
+		return stage, nil
+	}
+	options, err := parseTokenOptions(it)
+	if err != nil {
+		return nil, err
+	}
+	stage.Options = options
+	return stage, nil
+}
+
+// resolveAnalyzers replaces the analyzers that the indexes reference by name with their
+// declaration, which is either in the same schema or in the schema of an existing predicate of
+// the namespace. The declaration is stored with every predicate that uses it.
+func resolveAnalyzers(updates []*pb.SchemaUpdate, declared map[string]*pb.Analyzer) error {
+	for _, su := range updates {
+		ns := x.ParseNamespace(su.Predicate)
+		for tokenizer, ref := range su.Analyzers {
+			analyzer, ok := declared[x.NamespaceAttr(ns, ref.Name)]
+			if !ok && pstate != nil {
+				analyzer, ok = State().Analyzer(ns, ref.Name)
+			}
+			if !ok {
+				return errors.Errorf("Analyzer %s of the %s index of predicate %s isn't declared",
+					ref.Name, tokenizer, x.ParseAttr(su.Predicate))
+			}
+			su.Analyzers[tokenizer] = proto.Clone(analyzer).(*pb.Analyzer)
+		}
+	}
+	return nil
+}
+
 // parse parses a schema string and returns the schema representation for it.
 // If namespace == math.MaxUint64, then it preserves the namespace. Else it forces the passed
 // namespace on schema/types.
@@ -645,7 +1235,20 @@ func parse(s string, namespace uint64) (*ParsedSchema, error) {
 		return nil, err
 	}
 
+	analyzers := make(map[string]*pb.Analyzer)
 	parseTypeOrSchema := func(item lex.Item, it *lex.ItemIterator, ns uint64) error {
+		if isAnalyzerDeclaration(item, it) {
+			analyzer, err := parseAnalyzerDeclaration(it)
+			if err != nil {
+				return err
+			}
+			key := x.NamespaceAttr(ns, analyzer.Name)
+			if prev, ok := analyzers[key]; ok && !proto.Equal(prev, analyzer) {
+				return item.Errorf("Analyzer %s is declared more than once", analyzer.Name)
+			}
+			analyzers[key] = analyzer
+			return nil
+		}
 		if isTypeDeclaration(item, it) {
 			typeUpdate, err := parseTypeDeclaration(it, ns)
 			if err != nil {
@@ -668,9 +1271,15 @@ func parse(s string, namespace uint64) (*ParsedSchema, error) {
 		item := it.Item()
 		switch item.Typ {
 		case lex.ItemEOF:
+			if err := resolveAnalyzers(result.Preds, analyzers); err != nil {
+				return nil, err
+			}
 			if err := resolveTokenizers(result.Preds); err != nil {
 				return nil, errors.Wrapf(err, "failed to enrich schema")
 			}
+			if err := checkTypeTTL(&result); err != nil {
+				return nil, err
+			}
 			return &result, nil
 
 		case itemText:
@@ -710,6 +1319,24 @@ func parse(s string, namespace uint64) (*ParsedSchema, error) {
 	return nil, errors.Errorf("Shouldn't reach here")
 }
 
+// checkTypeTTL checks that the expiry time of the nodes of the types with a @ttl is held by a
+// datetime predicate, if the schema declares it.
+func checkTypeTTL(result *ParsedSchema) error {
+	for _, typ := range result.Types {
+		if typ.TtlPredicate == "" {
+			continue
+		}
+		for _, pred := range result.Preds {
+			if pred.Predicate == typ.TtlPredicate && (pred.ValueType != pb.Posting_DATETIME ||
+				pred.List) {
+				return errors.Errorf("@ttl of type %s needs %s to be a datetime predicate",
+					x.ParseAttr(typ.TypeName), x.ParseAttr(pred.Predicate))
+			}
+		}
+	}
+	return nil
+}
+
 // Parse parses the schema with namespace preserved. For the types/predicates for which the
 // namespace is not specified, it uses default.
 func Parse(s string) (*ParsedSchema, error) {
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/dgraph-io/badger/v4"
 	"github.com/dgraph-io/dgraph/v25/protos/pb"
@@ -389,6 +390,102 @@ func TestParseUpsert(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseFacets(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		follows: [uid] @reverse @facets(since: datetime @index(day), weight: float) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Preds))
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate: x.AttrInRootNamespace("follows"),
+		ValueType: 7,
+		List:      true,
+		Directive: pb.SchemaUpdate_REVERSE,
+		Facets: []*pb.FacetSchema{
+			{Name: "since", ValueType: pb.Posting_DATETIME, Tokenizer: "day"},
+			{Name: "weight", ValueType: pb.Posting_FLOAT},
+		},
+	}, result.Preds[0])
+}
+
+func TestParseFacetsError(t *testing.T) {
+	tests := map[string]string{
+		"follows: [uid] @facets(since: geo) .":                         "Invalid type geo for facet since",
+		"follows: [uid] @facets(since: int, since: int) .":             "Duplicate facet since",
+		"follows: [uid] @facets(since: int @index(exact)) .":           "isn't valid for facet: since",
+		"follows: [uid] @facets(since: string @index(term)) .":         "can only be indexed with a sortable tokenizer",
+		"follows: [uid] @facets(since: int @count) .":                  "Only @index is allowed for facet since",
+		"follows: [uid] @facets(since int) .":                          "Missing colon after facet since",
+		"follows: [uid] @facets(since: int @index(int) weight: int) .": "Expected ',' or ')'",
+	}
+	for schema, msg := range tests {
+		reset()
+		_, err := Parse(schema)
+		require.ErrorContains(t, err, msg, schema)
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		session: string @index(exact) @ttl(30d) .
+		token: [uid] @reverse @ttl(1d12h) .
+		hit: int @ttl(90m) .
+		expiresAt: datetime @ttl .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(result.Preds))
+	require.Equal(t, int64(30*24*3600), result.Preds[0].Ttl)
+	require.Equal(t, int64(36*3600), result.Preds[1].Ttl)
+	require.Equal(t, pb.SchemaUpdate_REVERSE, result.Preds[1].Directive)
+	require.Equal(t, int64(90*60), result.Preds[2].Ttl)
+	require.Zero(t, result.Preds[3].Ttl)
+	require.True(t, result.Preds[3].TtlFromValue)
+}
+
+func TestParseTTLError(t *testing.T) {
+	tests := map[string]string{
+		"session: string @ttl .":         "@ttl without a duration can only be specified for datetime",
+		"session: string @ttl(30x) .":    "Invalid duration",
+		"session: string @ttl(10ms) .":   "duration must be at least a second",
+		"session: string @ttl(\"1d\") .": "Invalid duration in @ttl",
+		"session: string @ttl(1d":        "Expected ')' after @ttl",
+	}
+	for schema, msg := range tests {
+		reset()
+		_, err := Parse(schema)
+		require.ErrorContains(t, err, msg, schema)
+	}
+}
+
+func TestParseTypeTTL(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		token: string .
+		expires_at: datetime .
+		type Session @ttl(expires_at) {
+			token
+			expires_at
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, x.AttrInRootNamespace("expires_at"), result.Types[0].TtlPredicate)
+
+	tests := map[string]string{
+		"type Session @ttl(expires_at) {\n token\n}": "@ttl of type Session names expires_at, " +
+			"which isn't a field of the type",
+		"type Session @ttl(a, b) {\n a\n b\n}": "@ttl of type Session needs the datetime field",
+		"expires_at: string .\ntype Session @ttl(expires_at) {\n expires_at\n}": "@ttl of " +
+			"type Session needs expires_at to be a datetime predicate",
+	}
+	for schema, msg := range tests {
+		reset()
+		_, err := Parse(schema)
+		require.ErrorContains(t, err, msg, schema)
+	}
+}
+
 func TestParseEmptyType(t *testing.T) {
 	reset()
 	result, err := Parse(`
@@ -404,6 +501,94 @@ func TestParseEmptyType(t *testing.T) {
 
 }
 
+func TestParseTypeConstraints(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type Person @closed {
+			name @required @length(1, 100) @regex("^[A-Z]\\w*$")
+			age @min(0) @max(150.5)
+			balance @min("-10")
+			status @enum("active", "inactive")
+			friend @target(Person)
+			nick
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Types))
+	typ := result.Types[0]
+	require.True(t, typ.Closed)
+	require.Equal(t, 6, len(typ.Fields))
+
+	name := typ.Fields[0].Constraint
+	require.True(t, name.Required)
+	require.Equal(t, int64(1), name.GetMinLength())
+	require.Equal(t, int64(100), name.GetMaxLength())
+	require.Equal(t, `^[A-Z]\w*$`, name.Regex)
+
+	age := typ.Fields[1].Constraint
+	require.Equal(t, float64(0), age.GetMin())
+	require.Equal(t, 150.5, age.GetMax())
+	require.Equal(t, float64(-10), typ.Fields[2].Constraint.GetMin())
+	require.Nil(t, typ.Fields[2].Constraint.Max)
+	require.Equal(t, []string{"active", "inactive"}, typ.Fields[3].Constraint.Enum)
+	require.Equal(t, "Person", typ.Fields[4].Constraint.TargetType)
+	require.Nil(t, typ.Fields[5].Constraint)
+}
+
+func TestParseTypeConstraintsError(t *testing.T) {
+	tests := map[string]string{
+		"type Person @open {\n name\n}":                  "Invalid directive @open for type Person",
+		"type Person {\n name @unique\n}":                "Invalid directive @unique for field name",
+		"type Person {\n age @min(a)\n}":                 "@min expects a number for field age",
+		"type Person {\n name @length(5, 1)\n}":          "maximum length not less than the minimum",
+		"type Person {\n name @regex(\"[a-\")\n}":        "Invalid regular expression for field name",
+		"type Person {\n status @enum()\n}":              "Missing value in @enum for field status",
+		"type Person {\n friend @target\n}":              "Expected '(' after @target for field friend",
+		"type Person {\n friend @target(Person, Dog)\n}": "@target expects a type name",
+	}
+	for schema, msg := range tests {
+		reset()
+		_, err := Parse(schema)
+		require.ErrorContains(t, err, msg, schema)
+	}
+}
+
+func TestParseTypeUniqueTuples(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type User @closed @unique(tenant, email) @unique(tenant, handle) {
+			tenant
+			email
+			handle
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Types))
+	typ := result.Types[0]
+	require.True(t, typ.Closed)
+	require.Equal(t, 2, len(typ.Unique))
+	require.Equal(t, []string{x.AttrInRootNamespace("tenant"), x.AttrInRootNamespace("email")},
+		typ.Unique[0].Predicates)
+	require.Equal(t, []string{x.AttrInRootNamespace("tenant"), x.AttrInRootNamespace("handle")},
+		typ.Unique[1].Predicates)
+	require.Equal(t, x.AttrInRootNamespace("dgraph.unique.User.tenant.email"),
+		CompositeUniquePredicate(typ.TypeName, typ.Unique[0]))
+	require.True(t, hasConstraints(typ))
+
+	tests := map[string]string{
+		"type User @unique(email) {\n email\n}":            "needs at least two fields",
+		"type User @unique(email, email) {\n email\n}":     "names email more than once",
+		"type User @unique(tenant, email) {\n email\n}":    "names tenant, which isn't a field",
+		"type User @unique {\n email\n}":                   "Expected '(' after @unique for type User",
+		"type User @unique(tenant,) {\n tenant\n email\n}": "Missing value in @unique for type User",
+	}
+	for schema, msg := range tests {
+		reset()
+		_, err := Parse(schema)
+		require.ErrorContains(t, err, msg, schema)
+	}
+}
+
 func TestParseTypeEOF(t *testing.T) {
 	reset()
 	result, err := Parse(`
@@ -674,6 +859,103 @@ func TestParseWithNamespace(t *testing.T) {
 	}, result.Types[0])
 }
 
+func TestParseAnalyzer(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		analyzer product_en {
+			char_filter: html
+			tokenizer: unicode
+			filter: lowercase
+			filter: stop(lang: "en")
+			filter: synonyms(words: "tv,television")
+		}
+		title: string @index(fulltext(analyzer: "product_en"), term) .
+		summary: string @index(term(analyzer: "product_en")) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Preds))
+
+	analyzer := &pb.Analyzer{
+		Name:        "product_en",
+		CharFilters: []*pb.AnalyzerStage{{Name: "html"}},
+		Tokenizer:   &pb.AnalyzerStage{Name: "unicode"},
+		Filters: []*pb.AnalyzerStage{
+			{Name: "lowercase"},
+			{Name: "stop", Options: []*pb.OptionPair{{Key: "lang", Value: "en"}}},
+			{Name: "synonyms", Options: []*pb.OptionPair{{Key: "words", Value: "tv,television"}}},
+		},
+	}
+	require.Equal(t, []string{"fulltext", "term"}, result.Preds[0].Tokenizer)
+	require.Len(t, result.Preds[0].Analyzers, 1)
+	require.True(t, proto.Equal(analyzer, result.Preds[0].Analyzers["fulltext"]))
+	require.Len(t, result.Preds[1].Analyzers, 1)
+	require.True(t, proto.Equal(analyzer, result.Preds[1].Analyzers["term"]))
+
+	// An analyzer used by an existing predicate can be referenced without declaring it again.
+	State().Set(result.Preds[0].Predicate, result.Preds[0])
+	result, err = Parse(`description: string @index(fulltext(analyzer: "product_en")) .`)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(analyzer, result.Preds[0].Analyzers["fulltext"]))
+
+	State().Set(result.Preds[0].Predicate, result.Preds[0])
+	tokenizers := State().Tokenizer(context.Background(), result.Preds[0].Predicate)
+	require.Len(t, tokenizers, 1)
+	tokens, err := tokenizers[0].Tokens("<b>The</b> new Television")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"new", "tv"}, tokens)
+
+	_, err = ParseWithNamespace(`description: string @index(fulltext(analyzer: "product_en")) .`, 1)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Analyzer product_en of the fulltext index of predicate "+
+		"description isn't declared")
+}
+
+func TestParseAnalyzerErrors(t *testing.T) {
+	reset()
+	tests := map[string]string{
+		"name: string @index(fulltext(analyzer: \"missing\")) .":                      "isn't declared",
+		"name: string @index(exact(analyzer: \"a\")) .":                               "Expected ',' or ')'",
+		"name: string @index(term(stemmer: \"en\")) .":                                "issues this error",
+		"analyzer a {\n filter: porter\n}":                                            "unknown filter porter",
+		"analyzer a {\n filter: stop(words: \"a\", lang: \"en\")\n}":                  "either words or lang",
+		"analyzer a {\n tokenizer: letter\n tokenizer: keyword\n}":                    "more than one tokenizer",
+		"analyzer a {\n stemmer: en\n}":                                               "Invalid stage stemmer",
+		"analyzer a {\n filter: lowercase\n}\nanalyzer a {\n filter: asciifolding\n}": "declared more than once",
+	}
+	for schema, msg := range tests {
+		_, err := Parse(schema)
+		require.Error(t, err, schema)
+		require.Contains(t, err.Error(), msg, schema)
+	}
+}
+
+func TestParseEncrypted(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		ssn: string @index(exact) @encrypted(key: "pii", mode: "deterministic", groups: "hr,audit") .
+		notes: string @encrypted(key: "pii") .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Preds))
+	require.True(t, proto.Equal(&pb.FieldEncryption{Key: "pii", Deterministic: true,
+		Groups: []string{"hr", "audit"}}, result.Preds[0].Encryption))
+	require.True(t, proto.Equal(&pb.FieldEncryption{Key: "pii"}, result.Preds[1].Encryption))
+
+	tests := map[string]string{
+		"age: int @encrypted(key: \"pii\") .":                                          "only be specified for string type",
+		"ssn: string @encrypted(mode: \"random\") .":                                   "Missing key",
+		"ssn: string @encrypted(key: \"pii\", mode: \"ecb\") .":                        "Invalid mode",
+		"ssn: string @encrypted(key: \"pii\", salt: \"x\") .":                          "Invalid option salt",
+		"ssn: string @index(exact) @encrypted(key: \"pii\") .":                         "isn't allowed",
+		"ssn: string @index(term) @encrypted(key: \"pii\", mode: \"deterministic\") .": "isn't allowed",
+	}
+	for schema, msg := range tests {
+		_, err := Parse(schema)
+		require.Error(t, err, schema)
+		require.Contains(t, err.Error(), msg, schema)
+	}
+}
+
 var ps *badger.DB
 
 func TestMain(m *testing.M) {
@@ -11,7 +11,9 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -64,6 +66,14 @@ type state struct {
 	elog      trace.EventLog
 	// mutSchema holds the schema update that is being applied in the background.
 	mutSchema map[string]*pb.SchemaUpdate
+	// hasTTL is set once a predicate with a TTL is added to the schema. It lets the reads of
+	// the posting lists skip the lookup of the TTL when no predicate has one.
+	hasTTL atomic.Bool
+	// hasConstraints is set once a type with constraints is added to the schema.
+	hasConstraints atomic.Bool
+	// expiringFields holds the predicates whose values expire with the nodes of the types with a
+	// @ttl: their fields, dgraph.type and the indexes of their @unique tuples.
+	expiringFields map[string]struct{}
 }
 
 // State returns the struct holding the current schema.
@@ -86,6 +96,9 @@ func (s *state) DeleteAll() {
 	for pred := range s.mutSchema {
 		delete(s.mutSchema, pred)
 	}
+	s.hasTTL.Store(false)
+	s.hasConstraints.Store(false)
+	s.expiringFields = nil
 }
 
 // Delete updates the schema in memory and disk
@@ -130,6 +143,7 @@ func (s *state) DeleteType(typeName string, ts uint64) error {
 	}
 
 	delete(s.types, typeName)
+	s.setExpiringFields()
 	return nil
 }
 
@@ -198,6 +212,9 @@ func (s *state) Set(pred string, schema *pb.SchemaUpdate) {
 	s.Lock()
 	defer s.Unlock()
 	s.predicate[pred] = schema
+	if hasTTL(schema) {
+		s.hasTTL.Store(true)
+	}
 	s.elog.Printf(logUpdate(schema, pred))
 }
 
@@ -206,6 +223,9 @@ func (s *state) SetMutSchema(pred string, schema *pb.SchemaUpdate) {
 	s.Lock()
 	defer s.Unlock()
 	s.mutSchema[pred] = schema
+	if hasTTL(schema) {
+		s.hasTTL.Store(true)
+	}
 }
 
 // DeleteMutSchema deletes the schema for given predicate from mutSchema.
@@ -237,9 +257,100 @@ func (s *state) SetType(typeName string, typ *pb.TypeUpdate) {
 	s.Lock()
 	defer s.Unlock()
 	s.types[typeName] = typ
+	if hasConstraints(typ) {
+		s.hasConstraints.Store(true)
+	}
+	s.setExpiringFields()
 	s.elog.Printf(logTypeUpdate(typ, typeName))
 }
 
+// setExpiringFields finds the predicates whose values expire with the nodes of the types. The
+// lock must be held.
+func (s *state) setExpiringFields() {
+	s.expiringFields = nil
+	for _, typ := range s.types {
+		if typ.TtlPredicate == "" {
+			continue
+		}
+		if s.expiringFields == nil {
+			s.expiringFields = make(map[string]struct{})
+		}
+		ns := x.ParseNamespace(typ.TypeName)
+		s.expiringFields[x.NamespaceAttr(ns, "dgraph.type")] = struct{}{}
+		for _, field := range typ.Fields {
+			s.expiringFields[field.Predicate] = struct{}{}
+		}
+		for _, tuple := range typ.Unique {
+			s.expiringFields[CompositeUniquePredicate(typ.TypeName, tuple)] = struct{}{}
+		}
+	}
+	if s.expiringFields != nil {
+		s.hasTTL.Store(true)
+	}
+}
+
+func hasConstraints(typ *pb.TypeUpdate) bool {
+	if typ.GetClosed() {
+		return true
+	}
+	for _, field := range typ.GetFields() {
+		if field.Constraint != nil {
+			return true
+		}
+	}
+	return len(typ.GetUnique()) > 0 || typ.GetTtlPredicate() != ""
+}
+
+// HasTypeConstraints returns whether any type in the schema may be closed, constrain the values
+// of its fields or expire its nodes.
+func (s *state) HasTypeConstraints() bool {
+	return s.hasConstraints.Load()
+}
+
+// ConstrainedType returns the type if it's closed, constrains the values of its fields, has
+// unique tuples of fields or expires its nodes.
+func (s *state) ConstrainedType(typeName string) (*pb.TypeUpdate, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	typ, ok := s.types[typeName]
+	if !ok || !hasConstraints(typ) {
+		return nil, false
+	}
+	return typ, true
+}
+
+// compositeUniquePrefix prefixes the predicates that index the @unique tuples of the types.
+const compositeUniquePrefix = "dgraph.unique."
+
+// CompositeUniquePredicate returns the predicate that indexes the combined values of a @unique
+// tuple of the type, like dgraph.unique.User.tenant.email for @unique(tenant, email) of User.
+func CompositeUniquePredicate(typeName string, tuple *pb.UniqueTuple) string {
+	ns, name := x.ParseNamespaceAttr(typeName)
+	parts := []string{compositeUniquePrefix + name}
+	for _, pred := range tuple.GetPredicates() {
+		parts = append(parts, x.ParseAttr(pred))
+	}
+	return x.NamespaceAttr(ns, strings.Join(parts, "."))
+}
+
+// IsCompositeUniquePredicate returns whether the predicate, given without its namespace, indexes
+// a @unique tuple of a type.
+func IsCompositeUniquePredicate(pred string) bool {
+	return strings.HasPrefix(pred, compositeUniquePrefix)
+}
+
+// CompositeUniqueSchema returns the schema of the predicate that indexes a @unique tuple. Its
+// upsert directive makes concurrent transactions that index the same values conflict at commit.
+func CompositeUniqueSchema(pred string) *pb.SchemaUpdate {
+	return &pb.SchemaUpdate{
+		Predicate: pred,
+		ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"hash"},
+		Upsert:    true,
+	}
+}
+
 // Get gets the schema for the given predicate.
 func (s *state) Get(ctx context.Context, pred string) (pb.SchemaUpdate, bool) {
 	isWrite, _ := ctx.Value(IsWrite).(bool)
@@ -355,13 +466,31 @@ func (s *state) Tokenizer(ctx context.Context, pred string) []tok.Tokenizer {
 	}
 	tokenizers := make([]tok.Tokenizer, 0, len(su.Tokenizer))
 	for _, it := range su.Tokenizer {
-		t, found := tok.GetTokenizer(it)
-		x.AssertTruef(found, "Invalid tokenizer %s", it)
+		t, err := tok.GetTokenizerWithAnalyzer(it, su.Analyzers[it])
+		x.AssertTruef(err == nil, "Invalid tokenizer %s: %v", it, err)
 		tokenizers = append(tokenizers, t)
 	}
 	return tokenizers
 }
 
+// Analyzer returns the declaration of the analyzer with the given name that an index of a
+// predicate of the namespace uses.
+func (s *state) Analyzer(ns uint64, name string) (*pb.Analyzer, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	for pred, su := range s.predicate {
+		if x.ParseNamespace(pred) != ns {
+			continue
+		}
+		for _, a := range su.Analyzers {
+			if a.Name == name {
+				return a, true
+			}
+		}
+	}
+	return nil, false
+}
+
 // FactoryCreateSpec(ctx, pred) returns the list of versioned
 // FactoryCreateSpec instances for given predicate.
 // The FactoryCreateSpec type defines the IndexFactory instance(s)
@@ -464,6 +593,45 @@ func (s *state) HasCount(ctx context.Context, pred string) bool {
 	return false
 }
 
+// facetsFor returns the facets declared for the given predicate. The caller must hold the lock.
+func (s *state) facetsFor(ctx context.Context, pred string) []*pb.FacetSchema {
+	isWrite, _ := ctx.Value(IsWrite).(bool)
+	if isWrite {
+		if schema, ok := s.mutSchema[pred]; ok {
+			return schema.Facets
+		}
+	}
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.Facets
+	}
+	return nil
+}
+
+// FacetSchema returns the schema of the given facet, if it is declared for the predicate.
+func (s *state) FacetSchema(ctx context.Context, pred, facet string) (*pb.FacetSchema, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	for _, f := range s.facetsFor(ctx, pred) {
+		if f.Name == facet {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// IndexedFacets returns the facets of the given predicate that are indexed.
+func (s *state) IndexedFacets(ctx context.Context, pred string) []*pb.FacetSchema {
+	s.RLock()
+	defer s.RUnlock()
+	var indexed []*pb.FacetSchema
+	for _, f := range s.facetsFor(ctx, pred) {
+		if f.Tokenizer != "" {
+			indexed = append(indexed, f)
+		}
+	}
+	return indexed
+}
+
 func (s *state) PredicatesToDelete(pred string) []string {
 	s.RLock()
 	defer s.RUnlock()
@@ -490,6 +658,60 @@ func (s *state) IsList(pred string) bool {
 	return false
 }
 
+// HasTTL returns whether any predicate in the schema may have a TTL, its own or the one of the
+// nodes of a type.
+func (s *state) HasTTL() bool {
+	return s.hasTTL.Load()
+}
+
+func hasTTL(schema *pb.SchemaUpdate) bool {
+	return schema.GetTtl() > 0 || schema.GetTtlFromValue()
+}
+
+// TTL returns the number of seconds after which the values of the predicate expire, and whether
+// its datetime values are their own expiry time instead.
+func (s *state) TTL(pred string) (int64, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.Ttl, schema.TtlFromValue
+	}
+	return 0, false
+}
+
+// MayExpire returns whether the postings of the predicate can expire.
+func (s *state) MayExpire(pred string) bool {
+	if !s.hasTTL.Load() {
+		return false
+	}
+	s.RLock()
+	defer s.RUnlock()
+	if _, ok := s.expiringFields[pred]; ok {
+		return true
+	}
+	if schema, ok := s.predicate[pred]; ok && hasTTL(schema) {
+		return true
+	}
+	schema, ok := s.mutSchema[pred]
+	return ok && hasTTL(schema)
+}
+
+// TTLPredicates returns the predicates whose values may expire.
+func (s *state) TTLPredicates() []string {
+	if !s.hasTTL.Load() {
+		return nil
+	}
+	s.RLock()
+	defer s.RUnlock()
+	var preds []string
+	for pred, schema := range s.predicate {
+		if _, ok := s.expiringFields[pred]; ok || hasTTL(schema) {
+			preds = append(preds, pred)
+		}
+	}
+	return preds
+}
+
 func (s *state) HasUpsert(pred string) bool {
 	s.RLock()
 	defer s.RUnlock()
@@ -694,6 +916,44 @@ func initialTypesInternal(namespace uint64, all bool) []*pb.TypeUpdate {
 			})
 	}
 
+	if namespace == x.RootNamespace && (all || x.Config.NamespaceQuotas) {
+		initialTypes = append(initialTypes,
+			&pb.TypeUpdate{
+				TypeName: "dgraph.quota",
+				Fields: []*pb.SchemaUpdate{
+					{
+						Predicate: "dgraph.quota.namespace",
+						ValueType: pb.Posting_INT,
+					},
+					{
+						Predicate: "dgraph.quota.limits",
+						ValueType: pb.Posting_STRING,
+					},
+				},
+			})
+	}
+
+	if all || x.WorkerConfig.StoredQueries {
+		initialTypes = append(initialTypes,
+			&pb.TypeUpdate{
+				TypeName: "dgraph.query",
+				Fields: []*pb.SchemaUpdate{
+					{
+						Predicate: "dgraph.query.name",
+						ValueType: pb.Posting_STRING,
+					},
+					{
+						Predicate: "dgraph.query.text",
+						ValueType: pb.Posting_STRING,
+					},
+					{
+						Predicate: "dgraph.query.groups",
+						ValueType: pb.Posting_STRING,
+					},
+				},
+			})
+	}
+
 	if all || x.WorkerConfig.AclEnabled {
 		// These type definitions are required for deleteUser and deleteGroup GraphQL API to work
 		// properly.
@@ -823,6 +1083,45 @@ func initialSchemaInternal(namespace uint64, all bool) []*pb.SchemaUpdate {
 		}...)
 	}
 
+	if namespace == x.RootNamespace && (all || x.Config.NamespaceQuotas) {
+		// propose the schema update for namespace quota predicates
+		initialSchema = append(initialSchema, []*pb.SchemaUpdate{
+			{
+				Predicate: "dgraph.quota.namespace",
+				ValueType: pb.Posting_INT,
+				Directive: pb.SchemaUpdate_INDEX,
+				Tokenizer: []string{"int"},
+				Upsert:    true,
+			},
+			{
+				Predicate: "dgraph.quota.limits",
+				ValueType: pb.Posting_STRING,
+			},
+		}...)
+	}
+
+	if all || x.WorkerConfig.StoredQueries {
+		// propose the schema update for stored query predicates
+		initialSchema = append(initialSchema, []*pb.SchemaUpdate{
+			{
+				Predicate: "dgraph.query.name",
+				ValueType: pb.Posting_STRING,
+				Directive: pb.SchemaUpdate_INDEX,
+				Tokenizer: []string{"exact"},
+				Upsert:    true,
+			},
+			{
+				Predicate: "dgraph.query.text",
+				ValueType: pb.Posting_STRING,
+			},
+			{
+				Predicate: "dgraph.query.groups",
+				ValueType: pb.Posting_STRING,
+				List:      true,
+			},
+		}...)
+	}
+
 	if all || x.WorkerConfig.AclEnabled {
 		// propose the schema update for acl predicates
 		initialSchema = append(initialSchema, []*pb.SchemaUpdate{
@@ -0,0 +1,75 @@
+//go:build wasip1
+
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package main is a custom tokenizer built as a WebAssembly module, which indexes the lowercase
+// words of a string. Build it with:
+//
+//	GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared -o words.wasm
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+	"unicode/utf8"
+	"unsafe"
+)
+
+func main() {}
+
+func Tokens(value string) ([]string, error) {
+	if !utf8.ValidString(value) {
+		return nil, errors.New("value isn't valid UTF-8")
+	}
+	return strings.Fields(strings.ToLower(value)), nil
+}
+
+// The buffers returned to the host, kept alive until the next call.
+var input, output []byte
+
+func pack(b []byte) uint64 {
+	if len(b) == 0 {
+		return 0
+	}
+	return uint64(uintptr(unsafe.Pointer(&b[0])))<<32 | uint64(len(b))
+}
+
+//go:wasmexport alloc
+func alloc(size uint32) uint32 {
+	input = make([]byte, size+1)
+	return uint32(uintptr(unsafe.Pointer(&input[0])))
+}
+
+//go:wasmexport name
+func name() uint64 {
+	output = []byte("words")
+	return pack(output)
+}
+
+//go:wasmexport type
+func typ() uint64 {
+	output = []byte("string")
+	return pack(output)
+}
+
+//go:wasmexport identifier
+func identifier() uint32 { return 0xfb }
+
+//go:wasmexport tokens
+func tokens(ptr, size uint32) uint64 {
+	toks, err := Tokens(string(input[:size]))
+	if err != nil {
+		output = append([]byte{1}, err.Error()...)
+		return pack(output)
+	}
+	output = []byte{0}
+	for _, tok := range toks {
+		output = binary.LittleEndian.AppendUint32(output, uint32(len(tok)))
+		output = append(output, tok...)
+	}
+	return pack(output)
+}
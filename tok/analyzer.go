@@ -0,0 +1,428 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tok
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/blevesearch/bleve/v2/analysis"
+	"github.com/blevesearch/bleve/v2/analysis/char/asciifolding"
+	"github.com/blevesearch/bleve/v2/analysis/char/html"
+	regexpfilter "github.com/blevesearch/bleve/v2/analysis/char/regexp"
+	"github.com/blevesearch/bleve/v2/analysis/token/length"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/token/stop"
+	"github.com/blevesearch/bleve/v2/analysis/token/unicodenorm"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/character"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/single"
+	unicodetok "github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	opts "github.com/dgraph-io/dgraph/v25/tok/options"
+)
+
+// analyzerStage is a kind of stage of an analyzer declared in the schema. The options of a stage
+// are parsed with its allowed options before it's built.
+type analyzerStage[T any] struct {
+	allowed opts.AllowedOptions
+	build   func(o opts.Options) (T, error)
+}
+
+// tokenFilter filters the tokens of a text in the given language. The language is the one of the
+// value being indexed or of the query function, for the filters that don't have their own.
+type tokenFilter func(lang string, input analysis.TokenStream) analysis.TokenStream
+
+var analyzerCharFilters = map[string]analyzerStage[analysis.CharFilter]{
+	"html": {
+		allowed: opts.NewAllowedOptions(),
+		build: func(o opts.Options) (analysis.CharFilter, error) {
+			return html.New(), nil
+		},
+	},
+	"asciifolding": {
+		allowed: opts.NewAllowedOptions(),
+		build: func(o opts.Options) (analysis.CharFilter, error) {
+			return asciifolding.New(), nil
+		},
+	},
+	"regexp": {
+		allowed: opts.NewAllowedOptions().AddStringOption("pattern").
+			AddStringOption("replacement"),
+		build: func(o opts.Options) (analysis.CharFilter, error) {
+			pattern, _, _ := opts.GetOpt(o, "pattern", "")
+			if pattern == "" {
+				return nil, errors.New("regexp requires a pattern")
+			}
+			r, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid regexp pattern")
+			}
+			replacement, _, _ := opts.GetOpt(o, "replacement", " ")
+			return regexpfilter.New(r, []byte(replacement)), nil
+		},
+	},
+}
+
+var analyzerTokenizers = map[string]analyzerStage[analysis.Tokenizer]{
+	"unicode": {
+		allowed: opts.NewAllowedOptions(),
+		build: func(o opts.Options) (analysis.Tokenizer, error) {
+			return unicodetok.NewUnicodeTokenizer(), nil
+		},
+	},
+	"whitespace": {
+		allowed: opts.NewAllowedOptions(),
+		build: func(o opts.Options) (analysis.Tokenizer, error) {
+			return character.NewCharacterTokenizer(func(r rune) bool {
+				return !unicode.IsSpace(r)
+			}), nil
+		},
+	},
+	"letter": {
+		allowed: opts.NewAllowedOptions(),
+		build: func(o opts.Options) (analysis.Tokenizer, error) {
+			return character.NewCharacterTokenizer(unicode.IsLetter), nil
+		},
+	},
+	// keyword keeps the whole text as a single token.
+	"keyword": {
+		allowed: opts.NewAllowedOptions(),
+		build: func(o opts.Options) (analysis.Tokenizer, error) {
+			return single.NewSingleTokenTokenizer(), nil
+		},
+	},
+}
+
+var analyzerFilters = map[string]analyzerStage[tokenFilter]{
+	"lowercase": {
+		allowed: opts.NewAllowedOptions(),
+		build: func(o opts.Options) (tokenFilter, error) {
+			return bleveFilter(lowercase.NewLowerCaseFilter()), nil
+		},
+	},
+	"asciifolding": {
+		allowed: opts.NewAllowedOptions(),
+		build: func(o opts.Options) (tokenFilter, error) {
+			folding := asciifolding.New()
+			return func(_ string, input analysis.TokenStream) analysis.TokenStream {
+				for _, token := range input {
+					token.Term = folding.Filter(token.Term)
+				}
+				return input
+			}, nil
+		},
+	},
+	"normalize": {
+		allowed: opts.NewAllowedOptions().AddStringOption("form"),
+		build: func(o opts.Options) (tokenFilter, error) {
+			form, _, _ := opts.GetOpt(o, "form", unicodenorm.NFKC)
+			filter, err := unicodenorm.NewUnicodeNormalizeFilter(strings.ToLower(form))
+			if err != nil {
+				return nil, err
+			}
+			return bleveFilter(filter), nil
+		},
+	},
+	"length": {
+		allowed: opts.NewAllowedOptions().AddIntOption("min").AddIntOption("max"),
+		build: func(o opts.Options) (tokenFilter, error) {
+			minLen, _, _ := opts.GetOpt(o, "min", 0)
+			maxLen, _, _ := opts.GetOpt(o, "max", 0)
+			return bleveFilter(length.NewLengthFilter(minLen, maxLen)), nil
+		},
+	},
+	// stop removes the given words, or the stop words of the language.
+	"stop": {
+		allowed: opts.NewAllowedOptions().AddStringOption("words").AddStringOption("lang"),
+		build: func(o opts.Options) (tokenFilter, error) {
+			words, _, _ := opts.GetOpt(o, "words", "")
+			lang, _, _ := opts.GetOpt(o, "lang", "")
+			switch {
+			case words != "" && lang != "":
+				return nil, errors.New("stop takes either words or lang")
+			case words != "":
+				stopWords := analysis.NewTokenMap()
+				for _, w := range splitWords(words, ",") {
+					stopWords.AddToken(w)
+				}
+				return bleveFilter(stop.NewStopTokensFilter(stopWords)), nil
+			default:
+				return func(valueLang string, input analysis.TokenStream) analysis.TokenStream {
+					return filterStopwords(filterLang(lang, valueLang), input)
+				}, nil
+			}
+		},
+	},
+	// synonyms replaces every word of a group of synonyms by the first word of the group, so
+	// that the synonyms share their index keys. The groups are separated by semicolons.
+	"synonyms": {
+		allowed: opts.NewAllowedOptions().AddStringOption("words"),
+		build: func(o opts.Options) (tokenFilter, error) {
+			words, _, _ := opts.GetOpt(o, "words", "")
+			synonyms := make(map[string][]byte)
+			for _, group := range splitWords(words, ";") {
+				group := splitWords(group, ",")
+				for _, w := range group[1:] {
+					synonyms[w] = []byte(group[0])
+				}
+			}
+			if len(synonyms) == 0 {
+				return nil, errors.New("synonyms requires groups of words")
+			}
+			return func(_ string, input analysis.TokenStream) analysis.TokenStream {
+				for _, token := range input {
+					if s, ok := synonyms[string(token.Term)]; ok {
+						token.Term = s
+					}
+				}
+				return input
+			}, nil
+		},
+	},
+	// stemmer stems the words with the stemmer of the language, or strips the longest of the
+	// given suffixes from the words that keep at least min_length characters.
+	"stemmer": {
+		allowed: opts.NewAllowedOptions().AddStringOption("lang").
+			AddStringOption("suffixes").AddIntOption("min_length"),
+		build: func(o opts.Options) (tokenFilter, error) {
+			lang, _, _ := opts.GetOpt(o, "lang", "")
+			suffixes, _, _ := opts.GetOpt(o, "suffixes", "")
+			minLen, _, _ := opts.GetOpt(o, "min_length", 3)
+			if suffixes == "" {
+				return func(valueLang string, input analysis.TokenStream) analysis.TokenStream {
+					return filterStemmers(filterLang(lang, valueLang), input)
+				}, nil
+			}
+			if lang != "" {
+				return nil, errors.New("stemmer takes either lang or suffixes")
+			}
+			list := splitWords(suffixes, ",")
+			return func(_ string, input analysis.TokenStream) analysis.TokenStream {
+				for _, token := range input {
+					token.Term = stripSuffix(token.Term, list, minLen)
+				}
+				return input
+			}, nil
+		},
+	},
+}
+
+// analyzer is a built analyzer declared in the schema.
+type analyzer struct {
+	charFilters []analysis.CharFilter
+	tokenizer   analysis.Tokenizer
+	filters     []tokenFilter
+}
+
+func (a *analyzer) analyze(text []byte, lang string) analysis.TokenStream {
+	for _, f := range a.charFilters {
+		text = f.Filter(text)
+	}
+	tokens := a.tokenizer.Tokenize(text)
+	for _, f := range a.filters {
+		tokens = f(lang, tokens)
+	}
+	return tokens
+}
+
+// analyzers caches the built analyzers by their marshalled declaration.
+var analyzers struct {
+	sync.RWMutex
+	m map[string]*analyzer
+}
+
+func init() {
+	analyzers.m = make(map[string]*analyzer)
+}
+
+func getAnalyzer(a *pb.Analyzer) (*analyzer, error) {
+	key, err := proto.MarshalOptions{Deterministic: true}.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	analyzers.RLock()
+	built, ok := analyzers.m[string(key)]
+	analyzers.RUnlock()
+	if ok {
+		return built, nil
+	}
+
+	built, err = buildAnalyzer(a)
+	if err != nil {
+		return nil, errors.Wrapf(err, "analyzer %s", a.GetName())
+	}
+	analyzers.Lock()
+	defer analyzers.Unlock()
+	analyzers.m[string(key)] = built
+	return built, nil
+}
+
+func buildAnalyzer(a *pb.Analyzer) (*analyzer, error) {
+	built := &analyzer{}
+	for _, stage := range a.GetCharFilters() {
+		f, err := buildStage(analyzerCharFilters, "char filter", stage)
+		if err != nil {
+			return nil, err
+		}
+		built.charFilters = append(built.charFilters, f)
+	}
+	if a.GetTokenizer() == nil {
+		built.tokenizer = unicodetok.NewUnicodeTokenizer()
+	} else {
+		t, err := buildStage(analyzerTokenizers, "tokenizer", a.GetTokenizer())
+		if err != nil {
+			return nil, err
+		}
+		built.tokenizer = t
+	}
+	for _, stage := range a.GetFilters() {
+		f, err := buildStage(analyzerFilters, "filter", stage)
+		if err != nil {
+			return nil, err
+		}
+		built.filters = append(built.filters, f)
+	}
+	return built, nil
+}
+
+func buildStage[T any](kinds map[string]analyzerStage[T], what string,
+	stage *pb.AnalyzerStage) (T, error) {
+	var zero T
+	kind, ok := kinds[stage.GetName()]
+	if !ok {
+		return zero, errors.Errorf("unknown %s %s", what, stage.GetName())
+	}
+	pairs := make([]opts.OptionValuePair, 0, len(stage.GetOptions()))
+	for _, o := range stage.GetOptions() {
+		pairs = append(pairs, opts.OptionValuePair{Option: o.Key, Value: o.Value})
+	}
+	o := opts.NewOptions()
+	if err := kind.allowed.PopulateOptions(pairs, o); err != nil {
+		return zero, errors.Wrapf(err, "%s %s", what, stage.GetName())
+	}
+	built, err := kind.build(o)
+	if err != nil {
+		return zero, errors.Wrapf(err, "%s %s", what, stage.GetName())
+	}
+	return built, nil
+}
+
+// ValidateAnalyzer returns an error if the analyzer can't be built, because of an unknown stage
+// or an invalid option.
+func ValidateAnalyzer(a *pb.Analyzer) error {
+	_, err := getAnalyzer(a)
+	return err
+}
+
+// TokenizerOptions returns the options that the tokenizer with the given name accepts in the
+// schema, like the analyzer of the fulltext and term tokenizers.
+func TokenizerOptions(name string) (opts.AllowedOptions, bool) {
+	switch name {
+	case FullTextTokenizer{}.Name(), TermTokenizer{}.Name():
+		return opts.NewAllowedOptions().AddStringOption("analyzer"), true
+	}
+	return nil, false
+}
+
+// GetTokenizerWithAnalyzer returns the tokenizer with the given name. The tokenizer analyzes the
+// text with the analyzer if one is given, only the fulltext and term tokenizers accept one.
+func GetTokenizerWithAnalyzer(name string, a *pb.Analyzer) (Tokenizer, error) {
+	t, ok := GetTokenizer(name)
+	if !ok {
+		return nil, errors.Errorf("Invalid tokenizer %s", name)
+	}
+	if a == nil {
+		return t, nil
+	}
+	if _, ok := TokenizerOptions(name); !ok {
+		return nil, errors.Errorf("Tokenizer %s doesn't accept an analyzer", name)
+	}
+	built, err := getAnalyzer(a)
+	if err != nil {
+		return nil, err
+	}
+	return analyzedTokenizer{Tokenizer: t, analyzer: built}, nil
+}
+
+// GetTokenizersWithAnalyzers returns the tokenizers with the given names, each analyzing the text
+// with its analyzer in specs, if it has one.
+func GetTokenizersWithAnalyzers(names []string, specs map[string]*pb.Analyzer) (
+	[]Tokenizer, error) {
+	var tokenizers []Tokenizer
+	for _, name := range names {
+		t, err := GetTokenizerWithAnalyzer(name, specs[name])
+		if err != nil {
+			return nil, err
+		}
+		tokenizers = append(tokenizers, t)
+	}
+	return tokenizers, nil
+}
+
+// HasAnalyzer returns true if the tokenizer analyzes the text with an analyzer declared in the
+// schema.
+func HasAnalyzer(t Tokenizer) bool {
+	_, ok := t.(analyzedTokenizer)
+	return ok
+}
+
+// analyzedTokenizer is a fulltext or term tokenizer that analyzes the text with an analyzer
+// declared in the schema, instead of the built-in analysis. Its tokens share the identifier of
+// the tokenizer, so changing the analyzer of an index requires rebuilding it.
+type analyzedTokenizer struct {
+	Tokenizer
+	analyzer *analyzer
+	lang     string
+}
+
+func (t analyzedTokenizer) Tokens(v interface{}) ([]string, error) {
+	str, ok := v.(string)
+	if !ok || str == "" {
+		return []string{}, nil
+	}
+	return uniqueTerms(t.analyzer.analyze([]byte(str), LangBase(t.lang))), nil
+}
+
+func bleveFilter(f analysis.TokenFilter) tokenFilter {
+	return func(_ string, input analysis.TokenStream) analysis.TokenStream {
+		return f.Filter(input)
+	}
+}
+
+// filterLang returns the language of a filter, which defaults to the language of the value.
+func filterLang(lang, valueLang string) string {
+	if lang != "" {
+		return LangBase(lang)
+	}
+	return valueLang
+}
+
+func splitWords(s, sep string) []string {
+	var words []string
+	for _, w := range strings.Split(s, sep) {
+		if w = strings.TrimSpace(w); w != "" {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+func stripSuffix(term []byte, suffixes []string, minLen int) []byte {
+	var longest string
+	for _, s := range suffixes {
+		if len(s) > len(longest) && strings.HasSuffix(string(term), s) &&
+			utf8.RuneCount(term[:len(term)-len(s)]) >= minLen {
+			longest = s
+		}
+	}
+	return term[:len(term)-len(longest)]
+}
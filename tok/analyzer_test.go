@@ -0,0 +1,141 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tok
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+)
+
+func stage(name string, options ...string) *pb.AnalyzerStage {
+	s := &pb.AnalyzerStage{Name: name}
+	for i := 0; i+1 < len(options); i += 2 {
+		s.Options = append(s.Options, &pb.OptionPair{Key: options[i], Value: options[i+1]})
+	}
+	return s
+}
+
+func TestAnalyzerTokens(t *testing.T) {
+	tests := []struct {
+		analyzer *pb.Analyzer
+		lang     string
+		text     string
+		tokens   []string
+	}{
+		{
+			analyzer: &pb.Analyzer{Name: "a", Filters: []*pb.AnalyzerStage{stage("lowercase")}},
+			text:     "Hello World",
+			tokens:   []string{"hello", "world"},
+		},
+		{
+			analyzer: &pb.Analyzer{
+				Name:        "a",
+				CharFilters: []*pb.AnalyzerStage{stage("html")},
+				Filters: []*pb.AnalyzerStage{stage("lowercase"), stage("asciifolding"),
+					stage("stop", "words", "the,a")},
+			},
+			text:   "<p>The Café</p> a la carte",
+			tokens: []string{"cafe", "la", "carte"},
+		},
+		{
+			analyzer: &pb.Analyzer{
+				Name:      "a",
+				Tokenizer: stage("whitespace"),
+				Filters:   []*pb.AnalyzerStage{stage("lowercase")},
+			},
+			text:   "wi-fi e-mail",
+			tokens: []string{"wi-fi", "e-mail"},
+		},
+		{
+			analyzer: &pb.Analyzer{Name: "a", Tokenizer: stage("keyword")},
+			text:     "New York",
+			tokens:   []string{"New York"},
+		},
+		{
+			analyzer: &pb.Analyzer{
+				Name: "a",
+				Filters: []*pb.AnalyzerStage{stage("lowercase"),
+					stage("synonyms", "words", "tv,television;laptop,notebook")},
+			},
+			text:   "Television and Notebook",
+			tokens: []string{"tv", "and", "laptop"},
+		},
+		{
+			analyzer: &pb.Analyzer{
+				Name: "a",
+				Filters: []*pb.AnalyzerStage{stage("lowercase"),
+					stage("stemmer", "suffixes", "ing,s", "min_length", "4")},
+			},
+			text:   "Sings running bus",
+			tokens: []string{"sing", "runn", "bus"},
+		},
+		{
+			analyzer: &pb.Analyzer{
+				Name:    "a",
+				Filters: []*pb.AnalyzerStage{stage("length", "min", "3")},
+			},
+			text:   "an ox ate hay",
+			tokens: []string{"ate", "hay"},
+		},
+		{
+			// The stop words and the stemmer default to the language of the value.
+			analyzer: &pb.Analyzer{
+				Name:    "a",
+				Filters: []*pb.AnalyzerStage{stage("lowercase"), stage("stop"), stage("stemmer")},
+			},
+			lang:   "de",
+			text:   "Die Katzen und die Hunde",
+			tokens: []string{"katz", "hund"},
+		},
+	}
+	for _, test := range tests {
+		tokenizer, err := GetTokenizerWithAnalyzer("term", test.analyzer)
+		require.NoError(t, err)
+		require.True(t, HasAnalyzer(tokenizer))
+		tokens, err := BuildTokens(test.text, GetTokenizerForLang(tokenizer, test.lang))
+		require.NoError(t, err)
+		want := make([]string, 0, len(test.tokens))
+		for _, token := range test.tokens {
+			want = append(want, encodeToken(token, IdentTerm))
+		}
+		require.ElementsMatch(t, want, tokens, test.text)
+	}
+}
+
+func TestAnalyzerErrors(t *testing.T) {
+	tests := map[string]*pb.Analyzer{
+		"unknown char filter strip": {CharFilters: []*pb.AnalyzerStage{stage("strip")}},
+		"unknown tokenizer ngram":   {Tokenizer: stage("ngram")},
+		"unknown filter porter":     {Filters: []*pb.AnalyzerStage{stage("porter")}},
+		"regexp requires a pattern": {CharFilters: []*pb.AnalyzerStage{stage("regexp")}},
+		"invalid regexp pattern": {
+			CharFilters: []*pb.AnalyzerStage{stage("regexp", "pattern", "(")}},
+		"stop takes either words or lang": {
+			Filters: []*pb.AnalyzerStage{stage("stop", "words", "a", "lang", "en")}},
+		"synonyms requires groups of words": {
+			Filters: []*pb.AnalyzerStage{stage("synonyms", "words", "tv")}},
+		"stemmer takes either lang or suffixes": {
+			Filters: []*pb.AnalyzerStage{stage("stemmer", "lang", "en", "suffixes", "s")}},
+		"filter stemmer: strconv.Atoi": {
+			Filters: []*pb.AnalyzerStage{stage("stemmer", "suffixes", "s", "min_length", "x")}},
+	}
+	for msg, a := range tests {
+		err := ValidateAnalyzer(a)
+		require.Error(t, err, msg)
+		require.Contains(t, err.Error(), msg)
+	}
+
+	_, err := GetTokenizerWithAnalyzer("exact", &pb.Analyzer{Name: "a"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "doesn't accept an analyzer")
+
+	tokenizer, err := GetTokenizerWithAnalyzer("fulltext", nil)
+	require.NoError(t, err)
+	require.False(t, HasAnalyzer(tokenizer))
+}
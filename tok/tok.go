@@ -50,6 +50,7 @@ const (
 	IdentBigFloat  = 0xD
 	IdentVFloat    = 0xE
 	IdentNGram     = 0xF
+	IdentFacet     = 0x10
 	IdentCustom    = 0x80
 	IdentDelimiter = 0x1f // ASCII 31 - Unit separator
 )
@@ -119,6 +120,25 @@ func BuildTokens(val interface{}, t Tokenizer) ([]string, error) {
 	return tokens, nil
 }
 
+// FacetTokenPrefix returns the prefix shared by all the index tokens of the given facet.
+func FacetTokenPrefix(facet string) string {
+	return string([]byte{IdentFacet}) + facet + string([]byte{IdentDelimiter})
+}
+
+// BuildFacetTokens tokenizes a facet value. The tokens are prefixed with the facet name so that
+// they can share the index of the predicate with the tokens of its values.
+func BuildFacetTokens(facet string, val interface{}, t Tokenizer) ([]string, error) {
+	tokens, err := BuildTokens(val, t)
+	if err != nil {
+		return nil, err
+	}
+	prefix := FacetTokenPrefix(facet)
+	for i := range tokens {
+		tokens[i] = prefix + tokens[i]
+	}
+	return tokens, nil
+}
+
 func BuildNGramQueryTokens(val interface{}, t NGramTokenizer) ([]string, error) {
 	tokens, err := t.QueryTokens(val)
 	if err != nil {
@@ -131,9 +151,16 @@ func BuildNGramQueryTokens(val interface{}, t NGramTokenizer) ([]string, error)
 	return tokens, nil
 }
 
-// LoadCustomTokenizer reads and loads a custom tokenizer from the given file.
+// LoadCustomTokenizer reads and loads a custom tokenizer from the given file, which is either a
+// WebAssembly module with the .wasm extension or else a Go plugin.
 func LoadCustomTokenizer(soFile string) {
 	glog.Infof("Loading custom tokenizer from %q", soFile)
+	if strings.HasSuffix(soFile, ".wasm") {
+		tokenizer, err := loadWasmTokenizer(soFile)
+		x.Checkf(err, "could not load custom tokenizer WASM module %q", soFile)
+		registerCustomTokenizer(tokenizer)
+		return
+	}
 	pl, err := plugin.Open(soFile)
 	x.Checkf(err, "could not open custom tokenizer plugin file")
 	symb, err := pl.Lookup("Tokenizer")
@@ -143,7 +170,10 @@ func LoadCustomTokenizer(soFile string) {
 	// telling the user what went wrong. Otherwise it's hard to capture this
 	// information to pass on to the user.
 	tokenizer := symb.(func() interface{})().(PluginTokenizer)
+	registerCustomTokenizer(tokenizer)
+}
 
+func registerCustomTokenizer(tokenizer PluginTokenizer) {
 	id := tokenizer.Identifier()
 	x.AssertTruef(id >= IdentCustom,
 		"custom tokenizer identifier byte must be >= 0x80, but was %#x", id)
@@ -663,7 +693,7 @@ func (t HashTokenizer) IsSortable() bool { return false }
 func (t HashTokenizer) IsLossy() bool { return false }
 
 // PluginTokenizer is implemented by external plugins loaded dynamically via
-// *.so files. It follows the implementation semantics of the Tokenizer
+// *.so files or WebAssembly modules. It follows the implementation semantics of the Tokenizer
 // interface.
 //
 // Think carefully before modifying this interface, as it would break users' plugins.
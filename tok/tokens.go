@@ -27,6 +27,10 @@ func GetTokenizerForLang(t Tokenizer, lang string) Tokenizer {
 		return FullTextTokenizer{lang: lang}
 	case TermTokenizer:
 		return TermTokenizer{lang: lang}
+	case analyzedTokenizer:
+		at := t.(analyzedTokenizer)
+		at.lang = lang
+		return at
 	case ExactTokenizer:
 		langTag, err := language.Parse(lang)
 		// We default to english if the language is not supported.
@@ -0,0 +1,294 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tok
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+
+	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// WasmDefaults are the default limits of the custom tokenizers loaded from WebAssembly modules.
+const WasmDefaults = `memory-mb=64; timeout=1s;`
+
+// WasmLimits bound the resources that a call of a WebAssembly tokenizer may use.
+type WasmLimits struct {
+	// MemoryMB is the maximum size of the linear memory of an instance of the module.
+	MemoryMB uint32
+	// Timeout is the maximum duration of a call or of the instantiation of the module, after
+	// which the instance is closed.
+	Timeout time.Duration
+}
+
+var wasmLimits = WasmLimits{MemoryMB: 64, Timeout: time.Second}
+
+// SetWasmLimits sets the limits of the WebAssembly tokenizers loaded after the call, from the
+// superflag given to the Alpha or to the bulk loader.
+func SetWasmLimits(flag string) {
+	sf := z.NewSuperFlag(flag).MergeAndCheckDefault(WasmDefaults)
+	wasmLimits = WasmLimits{
+		MemoryMB: sf.GetUint32("memory-mb"),
+		Timeout:  sf.GetDuration("timeout"),
+	}
+	x.AssertTruef(wasmLimits.MemoryMB > 0, "memory-mb of the WASM tokenizers must be positive")
+	x.AssertTruef(wasmLimits.Timeout > 0, "timeout of the WASM tokenizers must be positive")
+}
+
+// loadWasmTokenizer loads a custom tokenizer from a WebAssembly module. Unlike Go plugins, the
+// module doesn't need to be built with the toolchain and dependencies of Dgraph, and it runs
+// sandboxed in a pure Go runtime: it has no access to the file system or the network, its memory
+// is limited and its calls time out.
+//
+// The module implements the PluginTokenizer contract through these exports:
+//
+//	memory                     the linear memory of the module.
+//	alloc(size i32) i32        returns a buffer of size bytes, where the value is written.
+//	name() i64                 the name of the tokenizer.
+//	type() i64                 the type of the values that the tokenizer accepts.
+//	identifier() i32           the identifier of the tokenizer, at least 0x80.
+//	tokens(ptr i32, len i32) i64
+//	                           tokenizes the value written in the buffer returned by alloc.
+//
+// A result of type i64 is the pointer to a buffer in the upper 32 bits and its length in the
+// lower 32 bits. The buffer of name and type is the string. The buffer of tokens starts with a
+// status byte: 0 is followed by the tokens, each one prefixed by its length as a little-endian
+// uint32, and 1 is followed by an error message. The value given to tokens is encoded like Dgraph
+// stores values of the type, for example the bytes of a string or a little-endian int64.
+// The buffers must stay valid until the next call.
+//
+// A module targeting WASI is instantiated as a reactor: its _initialize function is called, if it
+// exports one, instead of _start.
+func loadWasmTokenizer(file string) (_ PluginTokenizer, err error) {
+	code, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	limits := wasmLimits
+	// A page of WebAssembly memory is 64 KiB.
+	config := wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(limits.MemoryMB * 16).
+		WithCloseOnContextDone(true)
+	r := wazero.NewRuntimeWithConfig(ctx, config)
+	defer func() {
+		if err != nil {
+			// The runtime holds the compiled code and the instances of the module.
+			_ = r.Close(ctx)
+		}
+	}()
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, r); err != nil {
+		return nil, errors.Wrapf(err, "while instantiating WASI")
+	}
+	compiled, err := r.CompileModule(ctx, code)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while compiling the WASM module")
+	}
+	if _, ok := compiled.ExportedMemories()["memory"]; !ok {
+		return nil, errors.Errorf("WASM module doesn't export its memory")
+	}
+	for _, name := range []string{"alloc", "name", "type", "identifier", "tokens"} {
+		if _, ok := compiled.ExportedFunctions()[name]; !ok {
+			return nil, errors.Errorf("WASM module doesn't export the function %q", name)
+		}
+	}
+
+	t := &wasmTokenizer{
+		runtime:  r,
+		compiled: compiled,
+		limits:   limits,
+		free:     make(chan *wasmInstance, runtime.GOMAXPROCS(0)),
+	}
+	inst, err := t.instance()
+	if err != nil {
+		return nil, err
+	}
+	defer t.release(inst)
+	if t.name, err = inst.callString("name"); err != nil {
+		return nil, err
+	}
+	if t.typ, err = inst.callString("type"); err != nil {
+		return nil, err
+	}
+	typ, ok := types.TypeForName(t.typ)
+	if !ok {
+		return nil, errors.Errorf("WASM tokenizer %s has an invalid type %q", t.name, t.typ)
+	}
+	t.tid = typ
+	res, err := inst.call("identifier")
+	if err != nil {
+		return nil, err
+	}
+	t.id = byte(res)
+	glog.Infof("Loaded WASM tokenizer %s of type %s with identifier %#x, memory limit %d MB "+
+		"and timeout %s", t.name, t.typ, t.id, limits.MemoryMB, limits.Timeout)
+	return t, nil
+}
+
+// wasmTokenizer is a PluginTokenizer implemented by a WebAssembly module. An instance of the
+// module runs one call at a time, so the tokenizer instantiates the module for the concurrent
+// calls, and keeps up to GOMAXPROCS idle instances for the next ones.
+type wasmTokenizer struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	limits   WasmLimits
+	free     chan *wasmInstance
+
+	name string
+	typ  string
+	tid  types.TypeID
+	id   byte
+}
+
+func (t *wasmTokenizer) Name() string     { return t.name }
+func (t *wasmTokenizer) Type() string     { return t.typ }
+func (t *wasmTokenizer) Identifier() byte { return t.id }
+
+func (t *wasmTokenizer) Tokens(v interface{}) ([]string, error) {
+	in := types.ValueForType(types.BinaryID)
+	if err := types.Marshal(types.Val{Tid: t.tid, Value: v}, &in); err != nil {
+		return nil, err
+	}
+	value := in.Value.([]byte)
+
+	inst, err := t.instance()
+	if err != nil {
+		return nil, err
+	}
+	out, err := inst.tokens(value)
+	var tokErr *wasmTokensError
+	if err != nil && !errors.As(err, &tokErr) {
+		// The call trapped or failed, so the instance may be closed or in an inconsistent state,
+		// and isn't reused.
+		_ = inst.module.Close(context.Background())
+		return nil, errors.Wrapf(err, "WASM tokenizer %s", t.name)
+	}
+	t.release(inst)
+	if err != nil {
+		return nil, errors.Wrapf(err, "WASM tokenizer %s", t.name)
+	}
+	return out, nil
+}
+
+func (t *wasmTokenizer) instance() (*wasmInstance, error) {
+	select {
+	case inst := <-t.free:
+		return inst, nil
+	default:
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), t.limits.Timeout)
+	defer cancel()
+	config := wazero.NewModuleConfig().WithName("").WithStartFunctions("_initialize")
+	module, err := t.runtime.InstantiateModule(ctx, t.compiled, config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while instantiating the WASM module")
+	}
+	return &wasmInstance{module: module, timeout: t.limits.Timeout}, nil
+}
+
+func (t *wasmTokenizer) release(inst *wasmInstance) {
+	select {
+	case t.free <- inst:
+	default:
+		_ = inst.module.Close(context.Background())
+	}
+}
+
+type wasmInstance struct {
+	module  api.Module
+	timeout time.Duration
+}
+
+// call calls the exported function, and closes the instance if the call times out.
+func (inst *wasmInstance) call(name string, params ...uint64) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), inst.timeout)
+	defer cancel()
+	res, err := inst.module.ExportedFunction(name).Call(ctx, params...)
+	if err != nil {
+		return 0, errors.Wrapf(err, "while calling %s", name)
+	}
+	if len(res) != 1 {
+		return 0, errors.Errorf("%s returned %d results instead of 1", name, len(res))
+	}
+	return res[0], nil
+}
+
+// read returns a copy of the buffer that the packed pointer and length refer to.
+func (inst *wasmInstance) read(name string, packed uint64) ([]byte, error) {
+	buf, ok := inst.module.Memory().Read(uint32(packed>>32), uint32(packed))
+	if !ok {
+		return nil, errors.Errorf("%s returned a buffer out of the memory of the module", name)
+	}
+	return append([]byte(nil), buf...), nil
+}
+
+func (inst *wasmInstance) callString(name string) (string, error) {
+	res, err := inst.call(name)
+	if err != nil {
+		return "", err
+	}
+	buf, err := inst.read(name, res)
+	return string(buf), err
+}
+
+// wasmTokensError is the error that the tokenizer returned for a value. The call succeeded, so
+// the instance can be reused.
+type wasmTokensError struct {
+	msg string
+}
+
+func (e *wasmTokensError) Error() string { return e.msg }
+
+// tokens returns the tokens of the value. The error is a *wasmTokensError if the tokenizer
+// returned it for the value, and wraps the trap or the host error if the call failed.
+func (inst *wasmInstance) tokens(value []byte) ([]string, error) {
+	ptr, err := inst.call("alloc", uint64(len(value)))
+	if err != nil {
+		return nil, err
+	}
+	if !inst.module.Memory().Write(uint32(ptr), value) {
+		return nil, errors.Errorf("alloc returned a buffer out of the memory of the module")
+	}
+	res, err := inst.call("tokens", ptr, uint64(len(value)))
+	if err != nil {
+		return nil, err
+	}
+	buf, err := inst.read("tokens", res)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) == 0 {
+		return nil, errors.Errorf("tokens returned an empty buffer")
+	}
+	if buf[0] != 0 {
+		return nil, &wasmTokensError{msg: string(buf[1:])}
+	}
+	var out []string
+	for buf = buf[1:]; len(buf) > 0; {
+		if len(buf) < 4 {
+			return nil, errors.Errorf("tokens returned a truncated token length")
+		}
+		n := binary.LittleEndian.Uint32(buf)
+		buf = buf[4:]
+		if uint32(len(buf)) < n {
+			return nil, errors.Errorf("tokens returned a truncated token")
+		}
+		out = append(out, string(buf[:n]))
+		buf = buf[n:]
+	}
+	return out, nil
+}
@@ -0,0 +1,88 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tok
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// buildWasmTokenizer builds the example WASM tokenizer of testutil/custom_plugins/words.
+func buildWasmTokenizer(t *testing.T) string {
+	out := filepath.Join(t.TempDir(), "words.wasm")
+	cmd := exec.Command("go", "build", "-buildmode=c-shared", "-o", out, ".")
+	cmd.Dir = "../testutil/custom_plugins/words"
+	cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm")
+	if b, err := cmd.CombinedOutput(); err != nil {
+		t.Skipf("could not build the WASM tokenizer: %v: %s", err, b)
+	}
+	return out
+}
+
+func TestWasmTokenizer(t *testing.T) {
+	file := buildWasmTokenizer(t)
+	defer SetWasmLimits(WasmDefaults)
+
+	SetWasmLimits(WasmDefaults)
+	tokenizer, err := loadWasmTokenizer(file)
+	require.NoError(t, err)
+	require.Equal(t, "words", tokenizer.Name())
+	require.Equal(t, "string", tokenizer.Type())
+	require.Equal(t, byte(0xfb), tokenizer.Identifier())
+
+	tokens, err := tokenizer.Tokens("Hello  WASM world")
+	require.NoError(t, err)
+	require.Equal(t, []string{"hello", "wasm", "world"}, tokens)
+
+	_, err = tokenizer.Tokens("\xff")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "value isn't valid UTF-8")
+	// The instance that returned the error is reused.
+	var tokErr *wasmTokensError
+	require.True(t, errors.As(err, &tokErr))
+	require.Len(t, tokenizer.(*wasmTokenizer).free, 1)
+
+	// The instances of the module are not shared by concurrent calls.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				tokens, err := tokenizer.Tokens("a b c")
+				require.NoError(t, err)
+				require.Equal(t, []string{"a", "b", "c"}, tokens)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The module doesn't fit in the memory limit.
+	SetWasmLimits("memory-mb=1")
+	_, err = loadWasmTokenizer(file)
+	require.Error(t, err)
+}
+
+func TestWasmTokenizerInvalidModule(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "invalid.wasm")
+	require.NoError(t, os.WriteFile(file, []byte("not a module"), 0600))
+	_, err := loadWasmTokenizer(file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "while compiling the WASM module")
+
+	// A valid module without the exports of a tokenizer.
+	empty := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	require.NoError(t, os.WriteFile(file, empty, 0600))
+	_, err = loadWasmTokenizer(file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "doesn't export its memory")
+}
@@ -7,8 +7,10 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/golang/geo/s1"
+	"github.com/pkg/errors"
 )
 
 // Helper functions for earth distances
@@ -29,6 +31,22 @@ func EarthAngle(dist float64) s1.Angle {
 	return s1.Angle(dist / EarthRadiusMeters)
 }
 
+// distanceUnits are the units of distance accepted by the geo functions, in meters.
+var distanceUnits = map[string]float64{
+	"m":  1,
+	"km": 1000,
+	"mi": 1609.344,
+	"ft": 0.3048,
+}
+
+// DistanceUnit returns the length in meters of a unit of distance: m, km, mi or ft.
+func DistanceUnit(unit string) (float64, error) {
+	if l, ok := distanceUnits[strings.ToLower(strings.TrimSpace(unit))]; ok {
+		return l, nil
+	}
+	return 0, errors.Errorf("Invalid unit of distance %q, expected one of m, km, mi or ft", unit)
+}
+
 // Area denotes an area on Earth
 type Area float64
 
@@ -155,6 +155,49 @@ func ToBinary(key string, value interface{}, sourceType api.Facet_ValType) (
 	return &api.Facet{Key: key, Value: targetVal.Value.([]byte), ValType: sourceType}, nil
 }
 
+// ConvertTo converts the facet to the given type, which is used to enforce the types of the facets
+// declared in the schema.
+func ConvertTo(f *api.Facet, typ types.TypeID) (*api.Facet, error) {
+	facetTid, err := TypeIDFor(f)
+	if err != nil {
+		return nil, err
+	}
+	if facetTid == typ {
+		return f, nil
+	}
+	var valType api.Facet_ValType
+	switch typ {
+	case types.IntID:
+		valType = api.Facet_INT
+	case types.FloatID:
+		valType = api.Facet_FLOAT
+	case types.BoolID:
+		valType = api.Facet_BOOL
+	case types.DateTimeID:
+		valType = api.Facet_DATETIME
+	case types.StringID:
+		valType = api.Facet_STRING
+	default:
+		return nil, errors.Errorf("Facets can't be of type %s", typ.Name())
+	}
+
+	dst, err := types.Convert(types.Val{Tid: facetTid, Value: f.Value}, typ)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting facet %s to %s", f.Key, typ.Name())
+	}
+	facet, err := ToBinary(f.Key, dst.Value, valType)
+	if err != nil {
+		return nil, err
+	}
+	if valType == api.Facet_STRING {
+		facet.Tokens, err = tok.GetTermTokens([]string{dst.Value.(string)})
+		if err == nil {
+			sort.Strings(facet.Tokens)
+		}
+	}
+	return facet, err
+}
+
 // TypeIDFor gives TypeID for facet.
 func TypeIDFor(f *api.Facet) (types.TypeID, error) {
 	switch f.ValType {
@@ -7,9 +7,11 @@ package types
 
 import (
 	"bytes"
+	"math"
 	"strconv"
 	"strings"
 
+	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
 	"github.com/pkg/errors"
 	geom "github.com/twpayne/go-geom"
@@ -31,19 +33,28 @@ const (
 	QueryTypeIntersects
 	// QueryTypeNear finds all points that are within the given distance from the given point.
 	QueryTypeNear
+	// QueryTypeDWithinLine finds all objects that are within the given distance from the given
+	// line.
+	QueryTypeDWithinLine
 )
 
 // GeoQueryData is pb.data used by the geo query filter to additionally filter the geometries.
 type GeoQueryData struct {
-	pt    *s2.Point  // If not nil, the input data was a point
-	loops []*s2.Loop // If not empty, the input data was a polygon/multipolygon or it was a near query.
-	qtype QueryType
+	pt    *s2.Point      // If not nil, the input data was a point
+	loops []*s2.Loop     // If not empty, the input data was a polygon/multipolygon or it was a near query.
+	lines []*s2.Polyline // If not empty, the input data was a linestring/multilinestring.
+	// maxDistance is the distance from the lines of a dwithin_line query.
+	maxDistance s1.Angle
+	// center is the point of a near query, the distances to the values that match are measured
+	// from it.
+	center *s2.Point
+	qtype  QueryType
 }
 
 // IsGeoFunc returns if a function is of geo type.
 func IsGeoFunc(str string) bool {
 	switch str {
-	case "near", "contains", "within", "intersects":
+	case "near", "contains", "within", "intersects", "knn_geo", "dwithin_line":
 		return true
 	}
 
@@ -57,22 +68,36 @@ func GetGeoTokens(srcFunc *pb.SrcFunction) ([]string, *GeoQueryData, error) {
 	funcName := strings.ToLower(srcFunc.Name)
 	switch funcName {
 	case "near":
-		if len(srcFunc.Args) != 2 {
-			return nil, nil, errors.Errorf("near function requires 2 arguments, but got %d",
+		// The distance is in meters, unless a unit is given as the third argument.
+		if len(srcFunc.Args) != 2 && len(srcFunc.Args) != 3 {
+			return nil, nil, errors.Errorf("near function requires 2 or 3 arguments, but got %d",
 				len(srcFunc.Args))
 		}
-		maxDist, err := strconv.ParseFloat(srcFunc.Args[1], 64)
+		maxDist, err := parseGeoDistance(srcFunc.Args[1:])
 		if err != nil {
-			return nil, nil, errors.Wrapf(err, "Error while converting distance to float")
-		}
-		if maxDist < 0 {
-			return nil, nil, errors.Errorf("Distance cannot be negative")
+			return nil, nil, err
 		}
 		g, err := convertToGeom(srcFunc.Args[0])
 		if err != nil {
 			return nil, nil, err
 		}
 		return queryTokensGeo(QueryTypeNear, g, maxDist)
+	case "dwithin_line":
+		// Like near, but the distance is measured from a line, e.g. a route, given as
+		// [[lng, lat], ...] or as GeoJSON.
+		if len(srcFunc.Args) != 2 && len(srcFunc.Args) != 3 {
+			return nil, nil, errors.Errorf("dwithin_line function requires 2 or 3 arguments, "+
+				"but got %d", len(srcFunc.Args))
+		}
+		maxDist, err := parseGeoDistance(srcFunc.Args[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		g, err := convertToLine(srcFunc.Args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		return queryTokensGeo(QueryTypeDWithinLine, g, maxDist)
 	case "within":
 		if len(srcFunc.Args) != 1 {
 			return nil, nil, errors.Errorf("within function requires 1 arguments, but got %d",
@@ -108,12 +133,111 @@ func GetGeoTokens(srcFunc *pb.SrcFunction) ([]string, *GeoQueryData, error) {
 	}
 }
 
+// parseGeoDistance parses the distance argument of a geo function, followed by its optional unit,
+// and returns it in meters.
+func parseGeoDistance(args []string) (float64, error) {
+	dist, err := strconv.ParseFloat(args[0], 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Error while converting distance to float")
+	}
+	if len(args) > 1 {
+		unit, err := DistanceUnit(args[1])
+		if err != nil {
+			return 0, err
+		}
+		dist *= unit
+	}
+	if dist < 0 {
+		return 0, errors.Errorf("Distance cannot be negative")
+	}
+	return dist, nil
+}
+
+// GeoNearTokens returns the tokens to look up in the geo index for the geometries that may lie
+// within maxDistance meters of the point.
+func GeoNearTokens(p *geom.Point, maxDistance float64) ([]string, error) {
+	toks, _, err := queryTokensGeo(QueryTypeNear, p, maxDistance)
+	return toks, err
+}
+
+// ParseGeoPoint parses a point given like in the arguments of the geo functions, either as
+// [longitude, latitude] or as GeoJSON.
+func ParseGeoPoint(str string) (*geom.Point, error) {
+	g, err := convertToGeom(str)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := g.(*geom.Point)
+	if !ok {
+		return nil, errors.Errorf("Expected a point, but got a geometry of type %T", g)
+	}
+	return p, nil
+}
+
+// GeoDistance returns the distance in meters from the point to the closest point of the
+// geometry, which is 0 if a polygon contains the point. Like in the geo filters, the holes of the
+// polygons are ignored.
+func GeoDistance(g geom.T, p *geom.Point) (float64, error) {
+	return geoDistance(g, pointFromPoint(p))
+}
+
+func geoDistance(g geom.T, pt s2.Point) (float64, error) {
+	switch v := g.(type) {
+	case *geom.Point:
+		return float64(EarthDistance(pt.Distance(pointFromPoint(v)))), nil
+	case *geom.Polygon:
+		return polygonDistance(v, pt)
+	case *geom.MultiPolygon:
+		dist := math.Inf(1)
+		for i := range v.NumPolygons() {
+			d, err := polygonDistance(v.Polygon(i), pt)
+			if err != nil {
+				return 0, err
+			}
+			dist = math.Min(dist, d)
+		}
+		return dist, nil
+	case *geom.LineString, *geom.MultiLineString:
+		lines, err := polylinesFromGeom(v)
+		if err != nil {
+			return 0, err
+		}
+		dist := s1.InfAngle()
+		for _, l := range lines {
+			dist = min(dist, distanceToPolyline(pt, l))
+		}
+		return float64(EarthDistance(dist)), nil
+	default:
+		return 0, errors.Errorf("Cannot compute the distance to a geometry of type %T", v)
+	}
+}
+
+func polygonDistance(p *geom.Polygon, pt s2.Point) (float64, error) {
+	l, err := loopFromPolygon(p)
+	if err != nil {
+		return 0, err
+	}
+	if l.ContainsPoint(pt) {
+		return 0, nil
+	}
+	dist := s1.InfAngle()
+	r := p.LinearRing(0)
+	for i := 0; i+1 < r.NumCoords(); i++ {
+		d := s2.DistanceFromSegment(pt, pointFromCoord(r.Coord(i)), pointFromCoord(r.Coord(i+1)))
+		if d < dist {
+			dist = d
+		}
+	}
+	return float64(EarthDistance(dist)), nil
+}
+
 // queryTokensGeo returns the tokens to be used to look up the geo index for a given filter.
 // qt is the type of Geo query - near/intersects/contains/within
 // g is the geom.T representation of the input. It could be a point/polygon/multipolygon.
 // maxDistance is distance in metres, only used for near query.
 func queryTokensGeo(qt QueryType, g geom.T, maxDistance float64) ([]string, *GeoQueryData, error) {
 	var loops []*s2.Loop
+	var lines []*s2.Polyline
 	var pt *s2.Point
 	var err error
 	switch v := g.(type) {
@@ -149,20 +273,35 @@ func queryTokensGeo(qt QueryType, g geom.T, maxDistance float64) ([]string, *Geo
 			loops = append(loops, l)
 		}
 
+	case *geom.LineString, *geom.MultiLineString:
+		if lines, err = polylinesFromGeom(v); err != nil {
+			return nil, nil, err
+		}
+
 	default:
 		return nil, nil, errors.Errorf("Cannot query using a geometry of type %T", v)
 	}
 
-	x.AssertTruef(len(loops) > 0 || pt != nil, "We should have a point or a loop.")
+	x.AssertTruef(len(loops) > 0 || len(lines) > 0 || pt != nil,
+		"We should have a point, a loop or a line.")
 
 	var cover, parents s2.CellUnion
-	if qt == QueryTypeNear {
+	switch qt {
+	case QueryTypeNear:
 		if len(loops) == 0 {
 			return nil, nil, errors.Errorf("Internal error while processing near query.")
 		}
 		cover = coverLoop(loops[0], MinCellLevel, MaxCellLevel, MaxCells)
 		parents = getParentCells(cover, MinCellLevel)
-	} else {
+	case QueryTypeDWithinLine:
+		// We cover the region within the distance of the lines, and then look for what it
+		// intersects with, like for a near query.
+		if len(lines) == 0 {
+			return nil, nil, errors.Errorf("Require a line for a dwithin_line query")
+		}
+		cover = coverPolylineBuffer(lines, EarthAngle(maxDistance))
+		parents = getParentCells(cover, MinCellLevel)
+	default:
 		parents, cover, err = indexCells(g)
 		if err != nil {
 			return nil, nil, err
@@ -182,7 +321,8 @@ func queryTokensGeo(qt QueryType, g geom.T, maxDistance float64) ([]string, *Geo
 	case QueryTypeContains:
 		// For a contains query, we only need to look at the objects whose cover matches our
 		// parents. So we take our parents and prefix with the coverPrefix to look in the index.
-		return createTokens(parents, coverPrefix), &GeoQueryData{pt: pt, loops: loops, qtype: qt}, nil
+		return createTokens(parents, coverPrefix),
+			&GeoQueryData{pt: pt, loops: loops, lines: lines, qtype: qt}, nil
 
 	case QueryTypeNear:
 		if pt == nil {
@@ -191,17 +331,22 @@ func queryTokensGeo(qt QueryType, g geom.T, maxDistance float64) ([]string, *Geo
 		// A near query is the same as the intersects query. We form a loop with the given point and
 		// the radius and then see what all does it intersect with.
 		toks := parentCoverTokens(parents, cover)
-		return toks, &GeoQueryData{loops: loops, qtype: QueryTypeIntersects}, nil
+		return toks, &GeoQueryData{center: pt, loops: loops, qtype: QueryTypeIntersects}, nil
 
 	case QueryTypeIntersects:
 		// An intersects query is as the name suggests all the entities which intersect with the
 		// given region. So we look at all the objects whose parents match our cover as well as
 		// all the objects whose cover matches our parents.
-		if len(loops) == 0 {
-			return nil, nil, errors.Errorf("Require a polygon for intersects query")
+		if len(loops) == 0 && len(lines) == 0 {
+			return nil, nil, errors.Errorf("Require a polygon or a line for intersects query")
 		}
 		toks := parentCoverTokens(parents, cover)
-		return toks, &GeoQueryData{loops: loops, qtype: qt}, nil
+		return toks, &GeoQueryData{loops: loops, lines: lines, qtype: qt}, nil
+
+	case QueryTypeDWithinLine:
+		toks := parentCoverTokens(parents, cover)
+		return toks, &GeoQueryData{lines: lines, maxDistance: EarthAngle(maxDistance), qtype: qt},
+			nil
 
 	default:
 		return nil, nil, errors.Errorf("Unknown query type")
@@ -219,6 +364,8 @@ func (q GeoQueryData) MatchesFilter(g geom.T) bool {
 		return q.intersects(g)
 	case QueryTypeNear:
 		return q.intersects(g)
+	case QueryTypeDWithinLine:
+		return q.withinDistance(g)
 	}
 	return false
 }
@@ -277,6 +424,27 @@ func (q GeoQueryData) isWithin(g geom.T) bool {
 			}
 			return true
 		}
+	case *geom.LineString, *geom.MultiLineString:
+		// We check each line should be within some loop of q.loops.
+		lines, err := polylinesFromGeom(geometry)
+		if err != nil || len(q.loops) == 0 {
+			return false
+		}
+		for _, line := range lines {
+			if !lineWithinMultiloops(line, q.loops) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func lineWithinMultiloops(line *s2.Polyline, loops []*s2.Loop) bool {
+	for _, l := range loops {
+		if loopContainsPolyline(l, line) {
+			return true
+		}
 	}
 	return false
 }
@@ -295,10 +463,24 @@ func multiPolygonContainsLoop(g *geom.MultiPolygon, l *s2.Loop) bool {
 	return false
 }
 
-// returns true if the geometry represented by g contains the given point/polygon.
+func multiPolygonContainsLine(g *geom.MultiPolygon, line *s2.Polyline) bool {
+	for i := range g.NumPolygons() {
+		s2loop, err := loopFromPolygon(g.Polygon(i))
+		if err != nil {
+			return false
+		}
+		if loopContainsPolyline(s2loop, line) {
+			return true
+		}
+	}
+	return false
+}
+
+// returns true if the geometry represented by g contains the given point/polygon/line.
 // g is the geom.T representation of the value which is the stored in the DB.
 func (q GeoQueryData) contains(g geom.T) bool {
-	x.AssertTruef(q.pt != nil || len(q.loops) > 0, "At least a point or loop should be defined.")
+	x.AssertTruef(q.pt != nil || len(q.loops) > 0 || len(q.lines) > 0,
+		"At least a point, loop or line should be defined.")
 	switch v := g.(type) {
 	case *geom.Polygon:
 		if q.pt != nil {
@@ -317,6 +499,11 @@ func (q GeoQueryData) contains(g geom.T) bool {
 				return false
 			}
 		}
+		for _, line := range q.lines {
+			if !loopContainsPolyline(s2loop, line) {
+				return false
+			}
+		}
 		return true
 	case *geom.MultiPolygon:
 		if q.pt != nil {
@@ -339,6 +526,16 @@ func (q GeoQueryData) contains(g geom.T) bool {
 			return true
 		}
 
+		if len(q.lines) > 0 {
+			// All the lines that are part of the query should be part of some loop of v.
+			for _, line := range q.lines {
+				if !multiPolygonContainsLine(v, line) {
+					return false
+				}
+			}
+			return true
+		}
+
 		return false
 	default:
 		// We will only consider polygons for contains queries.
@@ -359,18 +556,23 @@ func polygonContainsCoord(v *geom.Polygon, pt *s2.Point) bool {
 	return false
 }
 
-// returns true if the geometry represented by uid/attr intersects the given loop or point
+// returns true if the geometry represented by uid/attr intersects the given loop or line
 func (q GeoQueryData) intersects(g geom.T) bool {
-	x.AssertTruef(len(q.loops) > 0, "Loop should be defined for intersects.")
+	x.AssertTruef(len(q.loops) > 0 || len(q.lines) > 0,
+		"Loop or line should be defined for intersects.")
 	switch v := g.(type) {
 	case *geom.Point:
 		p := pointFromPoint(v)
-		// else loop is not nil
 		for _, l := range q.loops {
 			if l.ContainsPoint(p) {
 				return true
 			}
 		}
+		for _, line := range q.lines {
+			if distanceToPolyline(p, line) <= lineTolerance {
+				return true
+			}
+		}
 		return false
 
 	case *geom.Polygon:
@@ -378,12 +580,7 @@ func (q GeoQueryData) intersects(g geom.T) bool {
 		if err != nil {
 			return false
 		}
-		for _, loop := range q.loops {
-			if Intersects(l, loop) {
-				return true
-			}
-		}
-		return false
+		return q.intersectsLoop(l)
 	case *geom.MultiPolygon:
 		// We must compare all polygons in g with those in the query.
 		for i := range v.NumPolygons() {
@@ -391,8 +588,24 @@ func (q GeoQueryData) intersects(g geom.T) bool {
 			if err != nil {
 				return false
 			}
+			if q.intersectsLoop(l) {
+				return true
+			}
+		}
+		return false
+	case *geom.LineString, *geom.MultiLineString:
+		lines, err := polylinesFromGeom(v)
+		if err != nil {
+			return false
+		}
+		for _, line := range lines {
 			for _, loop := range q.loops {
-				if Intersects(l, loop) {
+				if loopIntersectsPolyline(loop, line) {
+					return true
+				}
+			}
+			for _, qline := range q.lines {
+				if line.Intersects(qline) {
 					return true
 				}
 			}
@@ -404,23 +617,64 @@ func (q GeoQueryData) intersects(g geom.T) bool {
 	}
 }
 
+func (q GeoQueryData) intersectsLoop(l *s2.Loop) bool {
+	for _, loop := range q.loops {
+		if Intersects(l, loop) {
+			return true
+		}
+	}
+	for _, line := range q.lines {
+		if loopIntersectsPolyline(l, line) {
+			return true
+		}
+	}
+	return false
+}
+
+// returns true if the geometry represented by g is within the distance of the given lines
+func (q GeoQueryData) withinDistance(g geom.T) bool {
+	d, err := distanceToPolylines(g, q.lines)
+	return err == nil && d <= q.maxDistance+lineTolerance
+}
+
 // MatchGeo matches values and GeoQueryData and ensures that the value actually
 // matches the query criteria.
 func MatchGeo(value *pb.TaskValue, q *GeoQueryData) bool {
+	g, ok := geoValue(value)
+	return ok && q.MatchesFilter(g)
+}
+
+// MatchGeoDistance is like MatchGeo for a near query, and also returns the distance in meters from
+// the point of the query to the value. The distance is +Inf if it can't be computed.
+func MatchGeoDistance(value *pb.TaskValue, q *GeoQueryData) (float64, bool) {
+	g, ok := geoValue(value)
+	if !ok || !q.MatchesFilter(g) {
+		return 0, false
+	}
+	if q.center == nil {
+		return math.Inf(1), true
+	}
+	dist, err := geoDistance(g, *q.center)
+	if err != nil {
+		return math.Inf(1), true
+	}
+	return dist, true
+}
+
+func geoValue(value *pb.TaskValue) (geom.T, bool) {
 	valBytes := value.Val
 	if bytes.Equal(valBytes, nil) {
-		return false
+		return nil, false
 	}
 	vType := value.ValType
 	if TypeID(vType) != GeoID {
-		return false
+		return nil, false
 	}
 	src := ValueForType(BinaryID)
 	src.Value = valBytes
 	gc, err := Convert(src, GeoID)
 	if err != nil {
-		return false
+		return nil, false
 	}
-	g := gc.Value.(geom.T)
-	return q.MatchesFilter(g)
+	return gc.Value.(geom.T), true
 }
@@ -15,6 +15,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/twpayne/go-geom"
 	"github.com/twpayne/go-geom/encoding/wkb"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
 )
 
 func queryTokens(qt QueryType, data string, maxDistance float64) ([]string, *GeoQueryData, error) {
@@ -436,3 +438,189 @@ func BenchmarkMatchesFilterContainsPoint(b *testing.B) {
 		qd.contains(us)
 	}
 }
+
+func TestGeoDistance(t *testing.T) {
+	p, err := ParseGeoPoint("[-122.082506, 37.4249518]")
+	require.NoError(t, err)
+
+	// Same point.
+	d, err := GeoDistance(p, p)
+	require.NoError(t, err)
+	require.Zero(t, d)
+
+	// Close point.
+	p2 := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.080668, 37.426753})
+	d, err = GeoDistance(p2, p)
+	require.NoError(t, err)
+	require.InDelta(t, 257.8, d, 0.1)
+
+	// One degree of longitude at the equator.
+	d, err = GeoDistance(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1, 0}),
+		geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 0}))
+	require.NoError(t, err)
+	require.InDelta(t, 111195, d, 1)
+
+	// The polygon contains the point.
+	poly := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-123, 37}, {-123, 38}, {-122, 38}, {-122, 37}},
+	})
+	d, err = GeoDistance(poly, p)
+	require.NoError(t, err)
+	require.Zero(t, d)
+
+	// The closest point of the polygon is on its edge along the meridian 0.
+	poly = geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{0, -1}, {1, -1}, {1, 1}, {0, 1}, {0, -1}},
+	})
+	d, err = GeoDistance(poly, geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-1, 0}))
+	require.NoError(t, err)
+	require.InDelta(t, 111195, d, 1)
+
+	_, err = ParseGeoPoint("[[[0, 0], [1, 0], [1, 1], [0, 0]]]")
+	require.Error(t, err)
+}
+
+func TestMatchGeoDistance(t *testing.T) {
+	p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.082506, 37.4249518})
+	_, qd, err := queryTokens(QueryTypeNear, formDataPoint(t, p), 1000.0)
+	require.NoError(t, err)
+
+	value := func(g geom.T) *pb.TaskValue {
+		b, err := wkb.Marshal(g, binary.LittleEndian)
+		require.NoError(t, err)
+		return &pb.TaskValue{Val: b, ValType: pb.Posting_ValType(GeoID)}
+	}
+
+	// Close point.
+	d, ok := MatchGeoDistance(value(geom.NewPoint(geom.XY).MustSetCoords(
+		geom.Coord{-122.080668, 37.426753})), qd)
+	require.True(t, ok)
+	require.InDelta(t, 257.8, d, 0.1)
+
+	// The polygon contains the point.
+	d, ok = MatchGeoDistance(value(geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-123, 37}, {-123, 38}, {-122, 38}, {-122, 37}},
+	})), qd)
+	require.True(t, ok)
+	require.Zero(t, d)
+
+	// Far point.
+	_, ok = MatchGeoDistance(value(geom.NewPoint(geom.XY).MustSetCoords(
+		geom.Coord{-123.082506, 37.4249518})), qd)
+	require.False(t, ok)
+}
+
+func TestGetGeoTokensNearUnit(t *testing.T) {
+	meters, _, err := GetGeoTokens(&pb.SrcFunction{Name: "near",
+		Args: []string{"[-122.082506, 37.4249518]", "1000"}})
+	require.NoError(t, err)
+	km, _, err := GetGeoTokens(&pb.SrcFunction{Name: "near",
+		Args: []string{"[-122.082506, 37.4249518]", "1", "km"}})
+	require.NoError(t, err)
+	require.ElementsMatch(t, meters, km)
+
+	_, _, err = GetGeoTokens(&pb.SrcFunction{Name: "near",
+		Args: []string{"[-122.082506, 37.4249518]", "1", "parsec"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid unit of distance")
+
+	l, err := DistanceUnit("MI")
+	require.NoError(t, err)
+	require.Equal(t, 1609.344, l)
+}
+
+func TestMatchesFilterLine(t *testing.T) {
+	// A route going through Mountain View.
+	route, err := convertToLine(`[[-122.09, 37.42], [-122.07, 37.43], [-122.05, 37.41]]`)
+	require.NoError(t, err)
+	_, qd, err := queryTokensGeo(QueryTypeIntersects, route, 0.0)
+	require.NoError(t, err)
+
+	// A polygon around a part of the route.
+	poly := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122.08, 37.4}, {-122.06, 37.4}, {-122.06, 37.44}, {-122.08, 37.44}, {-122.08, 37.4}},
+	})
+	require.True(t, qd.MatchesFilter(poly))
+	// A polygon away from the route.
+	poly = geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-121.9, 37}, {-121.9, 37.1}, {-122, 37.1}, {-122, 37}},
+	})
+	require.False(t, qd.MatchesFilter(poly))
+	// A road crossing the route.
+	road := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-122.07, 37.4}, {-122.07, 37.44}})
+	require.True(t, qd.MatchesFilter(road))
+	road = geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-122.2, 37.4}, {-122.2, 37.44}})
+	require.False(t, qd.MatchesFilter(road))
+	// A vertex of the route.
+	require.True(t, qd.MatchesFilter(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.07, 37.43})))
+
+	// A line within a polygon, and a polygon containing a line.
+	area, err := convertToGeom(
+		`[[[-122.1, 37.4], [-122.0, 37.4], [-122.0, 37.45], [-122.1, 37.45], [-122.1, 37.4]]]`)
+	require.NoError(t, err)
+	_, qd, err = queryTokensGeo(QueryTypeWithin, area, 0.0)
+	require.NoError(t, err)
+	line := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-122.09, 37.42}, {-122.07, 37.43}})
+	require.True(t, qd.MatchesFilter(line))
+	line = geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-122.09, 37.42}, {-122.2, 37.43}})
+	require.False(t, qd.MatchesFilter(line))
+
+	_, qd, err = queryTokensGeo(QueryTypeContains, route, 0.0)
+	require.NoError(t, err)
+	require.True(t, qd.MatchesFilter(geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122.1, 37.4}, {-122.0, 37.4}, {-122.0, 37.45}, {-122.1, 37.45}, {-122.1, 37.4}}})))
+	require.False(t, qd.MatchesFilter(poly))
+}
+
+func TestDWithinLine(t *testing.T) {
+	route := `[[-122.09, 37.42], [-122.07, 37.43], [-122.05, 37.41]]`
+	toks, qd, err := GetGeoTokens(&pb.SrcFunction{Name: "dwithin_line", Args: []string{route, "500"}})
+	require.NoError(t, err)
+	require.NotEmpty(t, toks)
+
+	// The tokens of a stop next to the route are looked up.
+	stop := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.082506, 37.4249518})
+	stopToks, err := IndexGeoTokens(stop)
+	require.NoError(t, err)
+	require.NotEmpty(t, intersect(toks, stopToks))
+	require.True(t, qd.MatchesFilter(stop))
+
+	// A stop 1.1 km away from the route.
+	far := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.07, 37.44})
+	require.False(t, qd.MatchesFilter(far))
+	_, qd, err = GetGeoTokens(&pb.SrcFunction{Name: "dwithin_line", Args: []string{route, "1.2", "km"}})
+	require.NoError(t, err)
+	require.True(t, qd.MatchesFilter(far))
+
+	_, _, err = GetGeoTokens(&pb.SrcFunction{Name: "dwithin_line",
+		Args: []string{"[-122.09, 37.42]", "500"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Require a line")
+}
+
+func TestGeoLength(t *testing.T) {
+	// One degree along the equator, then one degree along the meridian.
+	line := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0, 0}, {1, 0}, {1, 1}})
+	l, err := GeoLength(line)
+	require.NoError(t, err)
+	require.InDelta(t, 2*111195, l, 2)
+
+	d, err := GeoDistance(line, geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0.5, 0.5}))
+	require.NoError(t, err)
+	require.InDelta(t, 55597, d, 10)
+
+	_, err = GeoLength(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 0}))
+	require.Error(t, err)
+}
+
+func intersect(a, b []string) []string {
+	var out []string
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				out = append(out, x)
+			}
+		}
+	}
+	return out
+}
@@ -7,6 +7,7 @@ package types
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/golang/geo/s2"
 	"github.com/pkg/errors"
@@ -121,6 +122,9 @@ func Intersects(l1 *s2.Loop, l2 *s2.Loop) bool {
 	return intersects(l1, l2)
 }
 
+// convertToGeom parses a geometry argument of the geo functions, given as GeoJSON or as the
+// coordinates of a point, polygon or multipolygon. Coordinates like [[1, 2], [3, 4]] are rejected
+// as an invalid polygon, a line is given as GeoJSON, or to dwithin_line, see convertToLine.
 func convertToGeom(str string) (geom.T, error) {
 	// validate would ensure that we have a closed loop for all the polygons. We don't support open
 	// loop polygons.
@@ -150,6 +154,10 @@ func convertToGeom(str string) (geom.T, error) {
 			if err := closed(v); err != nil {
 				return nil, err
 			}
+		case *geom.LineString:
+			if v.NumCoords() < 2 {
+				return nil, errors.Errorf("A line requires at least 2 points")
+			}
 		}
 		return g, nil
 	}
@@ -209,3 +217,25 @@ func convertToGeom(str string) (geom.T, error) {
 	}
 	return nil, errors.Errorf("Invalid coordinates")
 }
+
+// convertToLine parses the line argument of dwithin_line. Unlike in the other geo functions, the
+// coordinates [[1, 2], [3, 4]] are those of a line. Other geometries are parsed by convertToGeom.
+func convertToLine(str string) (geom.T, error) {
+	s := x.WhiteSpace.Replace(str)
+	if !strings.HasPrefix(s, "[[") || strings.HasPrefix(s, "[[[") {
+		return convertToGeom(str)
+	}
+	var m json.RawMessage
+	if err := m.UnmarshalJSON([]byte(s)); err != nil {
+		return nil, errors.Wrapf(err, "Invalid coordinates")
+	}
+	g := geojson.Geometry{Type: "LineString", Coordinates: &m}
+	l, err := g.Decode()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid coordinates")
+	}
+	if l.(*geom.LineString).NumCoords() < 2 {
+		return nil, errors.Errorf("A line requires at least 2 points")
+	}
+	return l, nil
+}
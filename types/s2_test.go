@@ -37,6 +37,35 @@ func TestConvertToGeoJson_PolyError1(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestConvertToGeoJson_Line(t *testing.T) {
+	s := `[[1.76, -2.234], [3.543, 4.534], [4.54, 6.213]]`
+	b, err := convertToLine(s)
+	require.NoError(t, err)
+	require.Equal(t, []geom.Coord{{1.76, -2.234}, {3.543, 4.534}, {4.54, 6.213}},
+		b.(*geom.LineString).Coords())
+
+	// A line is given as GeoJSON to the other functions.
+	s = `{"type": "LineString", "coordinates": [[1.76, -2.234], [3.543, 4.534]]}`
+	b, err = convertToGeom(s)
+	require.NoError(t, err)
+	require.Equal(t, []geom.Coord{{1.76, -2.234}, {3.543, 4.534}}, b.(*geom.LineString).Coords())
+
+	// Other geometries are parsed like in the other functions.
+	b, err = convertToLine(`[1.0, 2.0]`)
+	require.NoError(t, err)
+	require.Equal(t, geom.Coord{1, 2}, b.(*geom.Point).Coords())
+}
+
+func TestConvertToGeoJson_LineError(t *testing.T) {
+	s := `[[1.76, -2.234]]`
+	_, err := convertToLine(s)
+	require.Error(t, err)
+
+	s = `{"type": "LineString", "coordinates": [[1.76, -2.234]]}`
+	_, err = convertToGeom(s)
+	require.Error(t, err)
+}
+
 func TestConvertToGeoJson_PolyError2(t *testing.T) {
 	s := `[[1.253, 2.2343], [-4.563, 6.1231], []]`
 	_, err := convertToGeom(s)
@@ -90,6 +90,17 @@ func indexCells(g geom.T) (parents, cover s2.CellUnion, err error) {
 		// Get parents for all cells in cover.
 		parents := getParentCells(cover, MinCellLevel)
 		return parents, cover, nil
+	case *geom.LineString, *geom.MultiLineString:
+		lines, err := polylinesFromGeom(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		var cover s2.CellUnion
+		for _, l := range lines {
+			cover = append(cover, coverPolyline(l, MinCellLevel, MaxCellLevel, MaxCells)...)
+		}
+		parents := getParentCells(cover, MinCellLevel)
+		return parents, cover, nil
 	default:
 		return nil, nil, errors.Errorf("Cannot index geometry of type %T", v)
 	}
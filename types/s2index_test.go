@@ -99,6 +99,29 @@ func TestIndexCellsPolygonError(t *testing.T) {
 	require.Contains(t, err.Error(), "Last coordinate not same as first")
 }
 
+func TestIndexCellsLine(t *testing.T) {
+	line := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-122.09, 37.42}, {-122.07, 37.43}, {-122.05, 37.41}})
+	parents, cover, err := indexCells(line)
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(cover), MaxCells)
+	for _, c := range cover {
+		if c.Level() > MaxCellLevel || c.Level() < MinCellLevel {
+			t.Errorf("Invalid cell level %d.", c.Level())
+		}
+		require.Contains(t, parents, c)
+	}
+
+	multi := geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122.09, 37.42}, {-122.07, 37.43}}, {{-74.0, 40.7}, {-73.9, 40.8}}})
+	_, cover, err = indexCells(multi)
+	require.NoError(t, err)
+	require.Greater(t, len(cover), 1)
+
+	_, _, err = indexCells(geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{1, 2}}))
+	require.Error(t, err)
+}
+
 func TestKeyGeneratorPoint(t *testing.T) {
 	p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.082506, 37.4249518})
 	data, err := wkb.Marshal(p, binary.LittleEndian)
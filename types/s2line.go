@@ -0,0 +1,215 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package types
+
+import (
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
+)
+
+// lineTolerance is the distance under which a point is considered to be on a line, as a point
+// given by its coordinates hardly ever lies exactly on a geodesic.
+var lineTolerance = EarthAngle(0.01)
+
+// polylineFromLineString converts a geom.LineString to a s2.Polyline.
+func polylineFromLineString(l *geom.LineString) (*s2.Polyline, error) {
+	n := l.NumCoords()
+	if n < 2 {
+		return nil, errors.Errorf("Can't convert line with less than 2 pts")
+	}
+	pts := make([]s2.Point, n)
+	for i := range n {
+		pts[i] = pointFromCoord(l.Coord(i))
+	}
+	p := s2.Polyline(pts)
+	return &p, nil
+}
+
+// polylinesFromGeom returns the polylines of a LineString or a MultiLineString, and nil for the
+// other geometries.
+func polylinesFromGeom(g geom.T) ([]*s2.Polyline, error) {
+	switch v := g.(type) {
+	case *geom.LineString:
+		p, err := polylineFromLineString(v)
+		if err != nil {
+			return nil, err
+		}
+		return []*s2.Polyline{p}, nil
+	case *geom.MultiLineString:
+		lines := make([]*s2.Polyline, 0, v.NumLineStrings())
+		for i := range v.NumLineStrings() {
+			p, err := polylineFromLineString(v.LineString(i))
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, p)
+		}
+		return lines, nil
+	}
+	return nil, nil
+}
+
+func coverPolyline(p *s2.Polyline, minLevel int, maxLevel int, maxCells int) s2.CellUnion {
+	rc := &s2.RegionCoverer{
+		MinLevel: minLevel,
+		MaxLevel: maxLevel,
+		LevelMod: 0,
+		MaxCells: maxCells,
+	}
+	return rc.Covering(p)
+}
+
+// coverPolylineBuffer returns a cover of the region within the given distance of the lines.
+func coverPolylineBuffer(lines []*s2.Polyline, maxDistance s1.Angle) s2.CellUnion {
+	var cu s2.CellUnion
+	for _, l := range lines {
+		cu = append(cu, coverPolyline(l, MinCellLevel, MaxCellLevel, MaxCells)...)
+	}
+	cu.Normalize()
+	cu.ExpandByRadius(maxDistance, MaxCellLevel-MinCellLevel)
+	// The expanded cells may be larger than the ones in the index, so we cover them again.
+	rc := &s2.RegionCoverer{
+		MinLevel: MinCellLevel,
+		MaxLevel: MaxCellLevel,
+		LevelMod: 0,
+		MaxCells: MaxCells,
+	}
+	return rc.Covering(&cu)
+}
+
+// loopEdges returns the edges of the loop as a closed polyline.
+func loopEdges(l *s2.Loop) *s2.Polyline {
+	p := s2.Polyline(append(append([]s2.Point{}, l.Vertices()...), l.Vertex(0)))
+	return &p
+}
+
+// loopIntersectsPolyline returns true if the line has a point inside the loop or crosses it.
+func loopIntersectsPolyline(l *s2.Loop, p *s2.Polyline) bool {
+	if !l.RectBound().Intersects(p.RectBound()) {
+		return false
+	}
+	if l.ContainsPoint((*p)[0]) {
+		return true
+	}
+	return loopEdges(l).Intersects(p)
+}
+
+// loopContainsPolyline returns true if all the points of the line are inside the loop.
+func loopContainsPolyline(l *s2.Loop, p *s2.Polyline) bool {
+	if !l.RectBound().Contains(p.RectBound()) {
+		return false
+	}
+	for _, pt := range *p {
+		if !l.ContainsPoint(pt) {
+			return false
+		}
+	}
+	// All the vertices are inside, the line is contained unless one of its edges crosses out of
+	// the loop and back.
+	edges := loopEdges(l)
+	for i := 1; i < len(*p); i++ {
+		crosser := s2.NewChainEdgeCrosser((*p)[i-1], (*p)[i], (*edges)[0])
+		for j := 1; j < len(*edges); j++ {
+			if crosser.ChainCrossingSign((*edges)[j]) == s2.Cross {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// distanceToPolyline returns the distance from the point to the closest point of the line.
+func distanceToPolyline(pt s2.Point, p *s2.Polyline) s1.Angle {
+	if len(*p) == 1 {
+		return pt.Distance((*p)[0])
+	}
+	dist := s1.InfAngle()
+	for i := 1; i < len(*p); i++ {
+		if d := s2.DistanceFromSegment(pt, (*p)[i-1], (*p)[i]); d < dist {
+			dist = d
+		}
+	}
+	return dist
+}
+
+// polylinesDistance returns the distance between two lines, which is 0 if they intersect.
+func polylinesDistance(a, b *s2.Polyline) s1.Angle {
+	if a.Intersects(b) {
+		return 0
+	}
+	// The lines don't cross, so the closest points include a vertex of one of them.
+	dist := s1.InfAngle()
+	for _, pt := range *a {
+		dist = min(dist, distanceToPolyline(pt, b))
+	}
+	for _, pt := range *b {
+		dist = min(dist, distanceToPolyline(pt, a))
+	}
+	return dist
+}
+
+// distanceToPolylines returns the distance from the geometry to the closest of the lines. Like in
+// the other geo functions, the holes of the polygons are ignored.
+func distanceToPolylines(g geom.T, lines []*s2.Polyline) (s1.Angle, error) {
+	dist := s1.InfAngle()
+	switch v := g.(type) {
+	case *geom.Point:
+		pt := pointFromPoint(v)
+		for _, l := range lines {
+			dist = min(dist, distanceToPolyline(pt, l))
+		}
+	case *geom.Polygon:
+		loop, err := loopFromPolygon(v)
+		if err != nil {
+			return 0, err
+		}
+		for _, l := range lines {
+			if loopIntersectsPolyline(loop, l) {
+				return 0, nil
+			}
+			dist = min(dist, polylinesDistance(loopEdges(loop), l))
+		}
+	case *geom.MultiPolygon:
+		for i := range v.NumPolygons() {
+			d, err := distanceToPolylines(v.Polygon(i), lines)
+			if err != nil {
+				return 0, err
+			}
+			dist = min(dist, d)
+		}
+	case *geom.LineString, *geom.MultiLineString:
+		vlines, err := polylinesFromGeom(v)
+		if err != nil {
+			return 0, err
+		}
+		for _, vl := range vlines {
+			for _, l := range lines {
+				dist = min(dist, polylinesDistance(vl, l))
+			}
+		}
+	default:
+		return 0, errors.Errorf("Cannot compute the distance to a geometry of type %T", v)
+	}
+	return dist, nil
+}
+
+// GeoLength returns the length in meters of a LineString or a MultiLineString.
+func GeoLength(g geom.T) (float64, error) {
+	lines, err := polylinesFromGeom(g)
+	if err != nil {
+		return 0, err
+	}
+	if lines == nil {
+		return 0, errors.Errorf("Cannot compute the length of a geometry of type %T", g)
+	}
+	var length s1.Angle
+	for _, l := range lines {
+		length += l.Length()
+	}
+	return float64(EarthDistance(length)), nil
+}
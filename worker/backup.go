@@ -7,6 +7,7 @@ package worker
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
@@ -51,6 +52,10 @@ type ManifestBase struct {
 	SinceTsDeprecated uint64 `json:"since"`
 	// ReadTs is the timestamp at which this backup was taken.
 	ReadTs uint64 `json:"read_ts"`
+	// SinceTs is the timestamp from which an incremental backup was taken, which is the read
+	// timestamp of the previous backup in the series. It's 0 for full backups and for the
+	// backups taken before it was recorded.
+	SinceTs uint64 `json:"since_ts,omitempty"`
 	// BackupId is a unique ID assigned to all backups in the same series
 	// (from the first full backup through the last incremental backup).
 	BackupId string `json:"backup_id"`
@@ -63,6 +68,11 @@ type ManifestBase struct {
 	Path string `json:"path"`
 	// Encrypted indicates whether this backup was encrypted.
 	Encrypted bool `json:"encrypted"`
+	// KeyVersion and KeyFingerprint identify the version of the encryption key of an encrypted
+	// backup, so that it can be decrypted after the key is rotated. They're empty for the
+	// backups taken before the keys had versions.
+	KeyVersion     uint64 `json:"key_version,omitempty"`
+	KeyFingerprint string `json:"key_fingerprint,omitempty"`
 	// Compression records the codec used to compress backup data files.
 	Compression string `json:"compression"`
 }
@@ -87,6 +97,9 @@ type Manifest struct {
 	// DropOperations records DROP operations that occurred since the previous backup.
 	// Required by restore but omitted from the summary manifest.
 	DropOperations []*pb.DropOperation `json:"drop_operations"`
+	// Checksums maps group IDs to the hex encoded SHA-256 of their backup file. It's used to
+	// verify a backup, and it's empty for the backups taken before it was recorded.
+	Checksums map[uint32]string `json:"checksums,omitempty"`
 }
 
 // checkBackupReadTsAdvanced returns an error if readTs does not advance the
@@ -231,6 +244,7 @@ var backupLock sync.Mutex
 // BackupRes is used to represent the response and error of the Backup gRPC call together to be
 // transported via a channel.
 type BackupRes struct {
+	gid uint32
 	res *pb.BackupResponse
 	err error
 }
@@ -255,6 +269,15 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 		}
 	}()
 
+	if err := ValidateRetention(req.Retention); err != nil {
+		return err
+	}
+	// The files of a backup are encrypted by the leaders of the groups, which must use the same
+	// version of the key.
+	if err := checkKeyVersions(ctx); err != nil {
+		return errors.Wrap(err, "while checking the encryption keys for the backup")
+	}
+
 	ts, err := Timestamps(ctx, &pb.Num{ReadOnly: true})
 	if err != nil {
 		glog.Errorf("Unable to retrieve readonly timestamp for backup: %s", err)
@@ -278,6 +301,13 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 			return errors.Wrap(err, "while creating backup directory")
 		}
 	}
+	// The lease keeps another Alpha or the prune command from rewriting the manifest until the
+	// backup is added to it. The backup stops if the lease is lost.
+	ctx, release, err := acquireBackupLease(ctx, handler)
+	if err != nil {
+		return err
+	}
+	defer release()
 	latestManifest, err := GetLatestManifest(handler, uri)
 	if err != nil {
 		return err
@@ -378,11 +408,14 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 		br.Predicates = predMap[gid]
 		go func(req *pb.BackupRequest) {
 			res, err := BackupGroup(ctx, req)
-			resCh <- BackupRes{res: res, err: err}
+			resCh <- BackupRes{gid: req.GroupId, res: res, err: err}
 		}(br)
 	}
 
 	var dropOperations []*pb.DropOperation
+	checksums := make(map[uint32]string)
+	fingerprints := make(map[string]struct{})
+	var keyFingerprint string
 	for range groups {
 		backupRes := <-resCh
 		if backupRes.err != nil {
@@ -390,18 +423,33 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 			return backupRes.err
 		}
 		dropOperations = append(dropOperations, backupRes.res.GetDropOperations()...)
+		if sum := backupRes.res.GetChecksum(); sum != "" {
+			checksums[backupRes.gid] = sum
+		}
+		if fp := backupRes.res.GetKeyFingerprint(); fp != "" {
+			fingerprints[fp] = struct{}{}
+			keyFingerprint = fp
+		}
+	}
+	// The files of a backup must be encrypted with the same key, which isn't the case if the key
+	// was rotated while the backup was taken.
+	if len(fingerprints) > 1 {
+		return errors.Errorf("the groups encrypted the backup with different encryption keys. " +
+			"Rotate the key on every Alpha before taking a backup")
 	}
 
 	dir := fmt.Sprintf(backupPathFmt, req.UnixTs)
 	m := Manifest{
 		ManifestBase: ManifestBase{
 			ReadTs:      req.ReadTs,
+			SinceTs:     req.SinceTs,
 			Version:     x.ManifestVersion,
 			Path:        dir,
 			Compression: "snappy",
 		},
 		Groups:         predMap,
 		DropOperations: dropOperations,
+		Checksums:      checksums,
 	}
 	if req.SinceTs == 0 {
 		m.Type = "full"
@@ -413,6 +461,12 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 		m.BackupNum = latestManifest.BackupNum + 1
 	}
 	m.Encrypted = x.WorkerConfig.EncryptionKey != nil
+	m.KeyFingerprint = keyFingerprint
+	if State.Keyring != nil && keyFingerprint != "" {
+		if _, v, err := State.Keyring.Key(keyFingerprint); err == nil {
+			m.KeyVersion = v.Version
+		}
+	}
 
 	bp := NewBackupProcessor(nil, req)
 	defer bp.Close()
@@ -423,6 +477,16 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 	}
 
 	backupSuccessful = true
+	// The backup is complete, so a failure to prune the older backups doesn't fail it.
+	if req.Retention != nil {
+		res, err := pruneBackups(ctx, handler, uri, req.Retention, time.Now(), false)
+		if err != nil {
+			glog.Errorf("Error while pruning the backups after the backup: %v", err)
+		} else {
+			glog.Infof("Pruned %d backups and %d WAL archive segments after the backup",
+				len(res.Deleted), len(res.DeletedWAL))
+		}
+	}
 	return nil
 }
 
@@ -530,7 +594,10 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.BackupResponse,
 	}
 	glog.V(3).Infof("Backup manifest version: %d", pr.Request.SinceTs)
 
-	eWriter, err := enc.GetWriter(x.WorkerConfig.EncryptionKey, w)
+	// The checksum of the file is recorded in the manifest to verify the backup later.
+	hash := sha256.New()
+	key := EncryptionKey()
+	eWriter, err := enc.GetWriter(key, io.MultiWriter(w, hash))
 	if err != nil {
 		return nil, err
 	}
@@ -701,14 +768,18 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.BackupResponse,
 		glog.Errorf("While closing handler: %v", err)
 		return &response, err
 	}
+	response.Checksum = hex.EncodeToString(hash.Sum(nil))
+	if key != nil {
+		response.KeyFingerprint = x.KeyFingerprint(key)
+	}
 	glog.Infof("Backup complete: group %d at %d", pr.Request.GroupId, pr.Request.ReadTs)
 	return &response, nil
 }
 
 // CompleteBackup will finalize a backup by writing the manifest at the backup destination.
 func (pr *BackupProcessor) CompleteBackup(ctx context.Context, m *Manifest) error {
-	if err := ctx.Err(); err != nil {
-		return err
+	if ctx.Err() != nil {
+		return context.Cause(ctx)
 	}
 	uri, err := url.Parse(pr.Request.Destination)
 	if err != nil {
@@ -88,8 +88,7 @@ func cleanRelPath(path string) string {
 }
 
 // UriHandler interface is implemented by URI scheme handlers.
-// When adding new scheme handles, for example 'azure://', an object will implement
-// this interface to supply Dgraph with a way to create or load backup files into DB.
+// When adding new scheme handles, an object will implement this interface to supply Dgraph with a way to create or load backup files into DB.
 // For all methods below, the URL object is parsed as described in `newHandler' and
 // the Processor object has the DB, estimated tablets size, and backup parameters.
 type UriHandler interface {
@@ -98,6 +97,9 @@ type UriHandler interface {
 	// CreateFile creates a file relative to the root path of the handler. It also makes the
 	// handler's descriptor to point to this file.
 	CreateFile(path string) (io.WriteCloser, error)
+	// DeletePath deletes the file or the directory, with all its content, at the path relative
+	// to the root path of the handler. It doesn't fail if the path doesn't exist.
+	DeletePath(path string) error
 	// DirExists returns true if the directory relative to the root path of the handler exists.
 	DirExists(path string) bool
 	// FileExists returns true if the file relative to the root path of the handler exists.
@@ -118,7 +120,7 @@ type UriHandler interface {
 
 // NewUriHandler parses the requested URI and finds the corresponding UriHandler.
 // If the passed credentials are not nil, they will be used to override the
-// default credentials (only for backups to minio or S3).
+// default credentials (only for backups to minio, S3, Azure or GCS).
 // Target URI formats:
 //
 //	[scheme]://[host]/[path]?[args]
@@ -127,8 +129,8 @@ type UriHandler interface {
 //
 // Target URI parts:
 //
-//	scheme - service handler, one of: "file", "s3", "minio"
-//	  host - remote address. ex: "dgraph.s3.amazonaws.com"
+//	scheme - service handler, one of: "file", "s3", "minio", "azure", "gs"
+//	  host - remote address or bucket. ex: "dgraph.s3.amazonaws.com"
 //	  path - directory, bucket or container at target. ex: "/dgraph/backups/"
 //	  args - specific arguments that are ok to appear in logs.
 //
@@ -143,6 +145,9 @@ type UriHandler interface {
 //
 //	s3://dgraph.s3.amazonaws.com/dgraph/backups?secure=true
 //	minio://localhost:9000/dgraph?secure=true
+//	azure://account.blob.core.windows.net/container/backups
+//	azure://localhost:10000/devstoreaccount1/container/backups?secure=false
+//	gs://bucket/backups
 //	file:///tmp/dgraph/backups
 //	/tmp/dgraph/backups?compress=gzip
 func NewUriHandler(uri *url.URL, creds *x.MinioCredentials) (UriHandler, error) {
@@ -151,6 +156,8 @@ func NewUriHandler(uri *url.URL, creds *x.MinioCredentials) (UriHandler, error)
 		return NewFileHandler(uri), nil
 	case "minio", "s3":
 		return NewS3Handler(uri, creds)
+	case "azure", "gs":
+		return NewBlobHandler(uri, creds)
 	}
 	return nil, errors.Errorf("Unable to handle url: %s", uri)
 }
@@ -210,6 +217,13 @@ func (h *fileHandler) CreateFile(path string) (io.WriteCloser, error) {
 	return &fileSyncer{fp}, errors.Wrapf(err, "File handler failed to create file %s", path)
 }
 
+func (h *fileHandler) DeletePath(path string) error {
+	if cleanRelPath(path) == "" {
+		return errors.Errorf("File handler can't delete its root path")
+	}
+	return os.RemoveAll(h.JoinPath(path))
+}
+
 func (h *fileHandler) Rename(src, dst string) error {
 	src = h.JoinPath(src)
 	dst = h.JoinPath(dst)
@@ -235,6 +249,11 @@ func FillRestoreCredentials(location string, req *pb.RestoreRequest) error {
 	if err != nil {
 		return err
 	}
+	if x.IsBlobScheme(uri.Scheme) {
+		// The Azure and GCS credentials of the environment, like managed identities, aren't
+		// static, so each group uses its own.
+		return nil
+	}
 
 	defaultCreds := credentials.Value{
 		AccessKeyID:     req.AccessKey,
@@ -420,6 +439,30 @@ func (h *s3Handler) Rename(srcPath, dstPath string) error {
 	return errors.Wrap(err, "Rename failed to remove temporary file")
 }
 
+func (h *s3Handler) DeletePath(path string) error {
+	if cleanRelPath(path) == "" {
+		return errors.Errorf("S3 handler can't delete its root path")
+	}
+	objectPath := h.getObjectPath(path)
+	// The path is either an object or a prefix of the objects in a directory.
+	objects := []string{objectPath}
+	for object := range h.mc.ListObjects(context.Background(), h.bucketName,
+		minio.ListObjectsOptions{Prefix: objectPath + "/", Recursive: true}) {
+		if object.Err != nil {
+			return errors.Wrapf(object.Err, "while listing the objects of %s", objectPath)
+		}
+		objects = append(objects, object.Key)
+	}
+	for _, object := range objects {
+		err := h.mc.RemoveObject(context.Background(), h.bucketName, object,
+			minio.RemoveObjectOptions{})
+		if err != nil && minio.ToErrorResponse(err).Code != "NoSuchKey" {
+			return errors.Wrapf(err, "while removing object %s", object)
+		}
+	}
+	return nil
+}
+
 func (h *s3Handler) getObjectPath(path string) string {
 	return filepath.Join(h.objectPrefix, cleanRelPath(path))
 }
@@ -0,0 +1,161 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// blobHandler is used for the 'azure:' and 'gs:' URI schemes, whose object stores are accessed
+// through an x.BlobClient. Like in the S3 handler, the directories are only prefixes of the
+// object names.
+type blobHandler struct {
+	bucket       string
+	objectPrefix string
+	uri          *url.URL
+	client       x.BlobClient
+}
+
+// NewBlobHandler returns a handler of the container or bucket of the URI, authenticated with the
+// given credentials, or else with the credentials of the environment.
+func NewBlobHandler(uri *url.URL, creds *x.MinioCredentials) (*blobHandler, error) {
+	client, err := x.NewBlobClient(uri, creds)
+	if err != nil {
+		return nil, err
+	}
+	h := &blobHandler{uri: uri, client: client}
+	h.bucket, h.objectPrefix = client.ParseLocation(uri)
+	if h.bucket == "" {
+		return nil, errors.Errorf("No bucket or container in %s location", uri.Scheme)
+	}
+	return h, nil
+}
+
+func (h *blobHandler) CreateDir(path string) error { return nil }
+func (h *blobHandler) DirExists(path string) bool  { return true }
+
+func (h *blobHandler) FileExists(path string) bool {
+	exists, err := h.client.Exists(context.Background(), h.bucket, h.getObjectPath(path))
+	if err != nil {
+		glog.Errorf("Failed to verify object existence: %v", err)
+	}
+	return exists
+}
+
+func (h *blobHandler) JoinPath(path string) string {
+	return h.client.URL(h.bucket, h.getObjectPath(path))
+}
+
+func (h *blobHandler) Read(path string) ([]byte, error) {
+	reader, err := h.Stream(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to read %s object", h.uri.Scheme)
+	}
+	defer reader.Close()
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(reader); err != nil {
+		return nil, errors.Wrapf(err, "Failed to read the %s object", h.uri.Scheme)
+	}
+	return buf.Bytes(), nil
+}
+
+func (h *blobHandler) Stream(path string) (io.ReadCloser, error) {
+	return h.client.Get(context.Background(), h.bucket, h.getObjectPath(path))
+}
+
+func (h *blobHandler) ListPaths(path string) []string {
+	paths, err := h.client.List(context.Background(), h.bucket, h.getObjectPath(path))
+	if err != nil {
+		glog.Errorf("Failed to list the %s objects: %v", h.uri.Scheme, err)
+	}
+	return paths
+}
+
+// blobWriter uploads the written data to an object as it's written.
+type blobWriter struct {
+	pwriter *io.PipeWriter
+	cerr    chan error
+}
+
+func (bw *blobWriter) Write(p []byte) (n int, err error) { return bw.pwriter.Write(p) }
+func (bw *blobWriter) Close() error {
+	if bw.pwriter == nil {
+		return nil
+	}
+	if err := bw.pwriter.Close(); err != nil {
+		glog.Errorf("Unexpected error when closing pipe: %v", err)
+	}
+	bw.pwriter = nil
+	glog.V(2).Infof("Backup waiting for upload to complete.")
+	return <-bw.cerr
+}
+
+func (h *blobHandler) CreateFile(path string) (io.WriteCloser, error) {
+	objectPath := h.getObjectPath(path)
+	glog.V(2).Infof("Sending data to %s blob %q ...", h.uri.Scheme, objectPath)
+
+	preader, pwriter := io.Pipe()
+	bw := &blobWriter{pwriter: pwriter, cerr: make(chan error, 1)}
+	go func() {
+		start := time.Now()
+		err := h.client.Put(context.Background(), h.bucket, objectPath, preader)
+		glog.V(2).Infof("Backup upload of %q done. Time elapsed: %s", objectPath,
+			time.Since(start).Round(time.Second))
+		// On error, this fails the writes still blocked in the pipe.
+		preader.CloseWithError(err)
+		bw.cerr <- err
+	}()
+	return bw, nil
+}
+
+// Rename copies the object before deleting it, as neither store can rename an object.
+func (h *blobHandler) Rename(srcPath, dstPath string) error {
+	src, err := h.Stream(srcPath)
+	if err != nil {
+		return errors.Wrap(err, "While renaming object, read failed")
+	}
+	defer src.Close()
+	dstPath = h.getObjectPath(dstPath)
+	if err := h.client.Put(context.Background(), h.bucket, dstPath, src); err != nil {
+		return errors.Wrap(err, "While renaming object, copy failed")
+	}
+	err = h.client.Delete(context.Background(), h.bucket, h.getObjectPath(srcPath))
+	return errors.Wrap(err, "Rename failed to remove temporary file")
+}
+
+func (h *blobHandler) DeletePath(path string) error {
+	if cleanRelPath(path) == "" {
+		return errors.Errorf("%s handler can't delete its root path", h.uri.Scheme)
+	}
+	objectPath := h.getObjectPath(path)
+	// The path is either an object or a prefix of the objects in a directory.
+	objects, err := h.client.List(context.Background(), h.bucket, objectPath+"/")
+	if err != nil {
+		return errors.Wrapf(err, "while listing the objects of %s", objectPath)
+	}
+	for _, object := range append([]string{objectPath}, objects...) {
+		err := h.client.Delete(context.Background(), h.bucket, object)
+		if err != nil && !errors.Is(err, x.ErrBlobNotFound) {
+			return errors.Wrapf(err, "while removing object %s", object)
+		}
+	}
+	return nil
+}
+
+func (h *blobHandler) getObjectPath(path string) string {
+	return filepath.ToSlash(filepath.Join(h.objectPrefix, cleanRelPath(path)))
+}
@@ -0,0 +1,121 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// memBlobClient is an in-memory x.BlobClient of the gs scheme.
+type memBlobClient struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (c *memBlobClient) ParseLocation(uri *url.URL) (string, string) {
+	return uri.Host, strings.Trim(uri.Path, "/")
+}
+
+func (c *memBlobClient) URL(bucket, name string) string { return "gs://" + bucket + "/" + name }
+
+func (c *memBlobClient) Get(_ context.Context, bucket, name string) (io.ReadCloser, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.objects[bucket+"/"+name]
+	if !ok {
+		return nil, x.ErrBlobNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (c *memBlobClient) Exists(_ context.Context, bucket, name string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.objects[bucket+"/"+name]
+	return ok, nil
+}
+
+func (c *memBlobClient) Put(_ context.Context, bucket, name string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.objects[bucket+"/"+name] = data
+	return nil
+}
+
+func (c *memBlobClient) Delete(_ context.Context, bucket, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.objects[bucket+"/"+name]; !ok {
+		return x.ErrBlobNotFound
+	}
+	delete(c.objects, bucket+"/"+name)
+	return nil
+}
+
+func (c *memBlobClient) List(_ context.Context, bucket, prefix string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var names []string
+	for key := range c.objects {
+		if name := strings.TrimPrefix(key, bucket+"/"); strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func TestBlobHandler(t *testing.T) {
+	client := &memBlobClient{objects: make(map[string][]byte)}
+	uri, err := url.Parse("gs://bucket/dgraph/backups")
+	require.NoError(t, err)
+	h := &blobHandler{uri: uri, client: client}
+	h.bucket, h.objectPrefix = client.ParseLocation(uri)
+
+	w, err := h.CreateFile("dgraph.1/" + tmpManifest)
+	require.NoError(t, err)
+	_, err = w.Write([]byte(`{"type": "full"}`))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, h.Rename("dgraph.1/"+tmpManifest, "dgraph.1/"+backupManifest))
+	require.False(t, h.FileExists("dgraph.1/"+tmpManifest))
+	require.True(t, h.FileExists("dgraph.1/"+backupManifest))
+	data, err := h.Read("dgraph.1/" + backupManifest)
+	require.NoError(t, err)
+	require.Equal(t, `{"type": "full"}`, string(data))
+	require.Equal(t, "gs://bucket/dgraph/backups/dgraph.1/manifest.json",
+		h.JoinPath("dgraph.1/"+backupManifest))
+
+	require.NoError(t, client.Put(context.Background(), "bucket",
+		"dgraph/backups/dgraph.1/r10-g1.backup", bytes.NewReader(nil)))
+	require.NoError(t, client.Put(context.Background(), "bucket",
+		"dgraph/backups/dgraph.10/r20-g1.backup", bytes.NewReader(nil)))
+	require.Len(t, h.ListPaths(""), 3)
+
+	// Deleting a directory deletes the objects under its prefix only.
+	require.NoError(t, h.DeletePath("dgraph.1"))
+	require.Equal(t, []string{"dgraph/backups/dgraph.10/r20-g1.backup"}, h.ListPaths(""))
+	require.NoError(t, h.DeletePath("dgraph.2"))
+	require.Error(t, h.DeletePath("/"))
+
+	// The object paths can't escape the prefix.
+	require.Equal(t, "dgraph/backups/etc/passwd", h.getObjectPath("../../etc/passwd"))
+}
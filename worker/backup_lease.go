@@ -0,0 +1,157 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	// backupLeaseFile is the lease held on a backup location by the backup or the pruning that
+	// reads and rewrites its master manifest.
+	backupLeaseFile = "backup.lease"
+	// backupLeaseDuration is how long a lease lasts unless its holder renews it, which is how
+	// long a location stays blocked after its holder died.
+	backupLeaseDuration = 5 * time.Minute
+)
+
+// backupLeaseSettle is how long a lease is left to settle before it's read back, so that a
+// concurrent writer of the lease is detected, and backupLeaseRenewal how often it's renewed.
+// They're variables to shorten them in the tests.
+var (
+	backupLeaseSettle  = 2 * time.Second
+	backupLeaseRenewal = backupLeaseDuration / 3
+)
+
+type backupLease struct {
+	Owner   string    `json:"owner"`
+	Expires time.Time `json:"expires"`
+}
+
+// readBackupLease returns the lease of the location, or nil if there is none.
+func readBackupLease(h UriHandler) (*backupLease, error) {
+	if !h.FileExists(backupLeaseFile) {
+		return nil, nil
+	}
+	b, err := h.Read(backupLeaseFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading the backup lease")
+	}
+	var lease backupLease
+	if err := json.Unmarshal(b, &lease); err != nil {
+		return nil, errors.Wrap(err, "while reading the backup lease")
+	}
+	return &lease, nil
+}
+
+func writeBackupLease(h UriHandler, lease *backupLease) error {
+	w, err := h.CreateFile(backupLeaseFile)
+	if err != nil {
+		return errors.Wrap(err, "while writing the backup lease")
+	}
+	if err := json.NewEncoder(w).Encode(lease); err != nil {
+		_ = w.Close()
+		return errors.Wrap(err, "while writing the backup lease")
+	}
+	return errors.Wrap(w.Close(), "while writing the backup lease")
+}
+
+// acquireBackupLease takes the lease of the backup location, which serializes the backups and
+// the prunings of the location across Alphas and the dgraph backup prune command. It fails if
+// another holder has a lease that hasn't expired. The lease is renewed until the returned
+// function releases it.
+//
+// The locations don't offer a conditional write, so the lease is read back after it settled: of
+// two processes writing it at the same time, only the last one to write it gets it. It's read
+// again before each renewal, and the returned context is cancelled if another holder took it
+// over or it expired without being renewed, for the backup or the pruning to stop before it
+// writes anything else.
+func acquireBackupLease(ctx context.Context, h UriHandler) (context.Context, func(), error) {
+	host, _ := os.Hostname()
+	owner := fmt.Sprintf("%s/%d/%s", host, os.Getpid(), uuid.NewString())
+
+	lease, err := readBackupLease(h)
+	if err != nil {
+		return nil, nil, err
+	}
+	if lease != nil && time.Now().Before(lease.Expires) {
+		return nil, nil, errors.Errorf("the backup location is leased by %s until %s, another "+
+			"backup or pruning is in progress", lease.Owner, lease.Expires.Format(time.RFC3339))
+	}
+	expires := time.Now().Add(backupLeaseDuration)
+	if err := writeBackupLease(h, &backupLease{Owner: owner, Expires: expires}); err != nil {
+		return nil, nil, err
+	}
+	time.Sleep(backupLeaseSettle)
+	if lease, err = readBackupLease(h); err != nil {
+		return nil, nil, err
+	}
+	if lease == nil || lease.Owner != owner {
+		return nil, nil, errors.Errorf("the backup location was leased at the same time by " +
+			"another backup or pruning")
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(backupLeaseRenewal)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			if time.Now().After(expires) {
+				cancel(errors.Errorf("the backup lease expired at %s without being renewed",
+					expires.Format(time.RFC3339)))
+				return
+			}
+			lease, err := readBackupLease(h)
+			if err != nil {
+				glog.Warningf("Failed to read the backup lease before renewing it: %v", err)
+				continue
+			}
+			if lease == nil || lease.Owner != owner {
+				cancel(errors.Errorf("the backup lease was taken over by another backup or pruning"))
+				return
+			}
+			renewed := time.Now().Add(backupLeaseDuration)
+			if err := writeBackupLease(h, &backupLease{Owner: owner, Expires: renewed}); err != nil {
+				glog.Warningf("Failed to renew the backup lease: %v", err)
+				continue
+			}
+			expires = renewed
+		}
+	}()
+
+	var once sync.Once
+	return ctx, func() {
+		once.Do(func() {
+			close(done)
+			wg.Wait()
+			defer cancel(context.Canceled)
+			if lease, err := readBackupLease(h); err != nil || lease == nil || lease.Owner != owner {
+				return
+			}
+			if err := h.DeletePath(backupLeaseFile); err != nil {
+				glog.Warningf("Failed to release the backup lease: %v", err)
+			}
+		})
+	}, nil
+}
@@ -0,0 +1,93 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+)
+
+func TestBackupLease(t *testing.T) {
+	defer func(settle time.Duration) { backupLeaseSettle = settle }(backupLeaseSettle)
+	backupLeaseSettle = 0
+
+	dir := t.TempDir()
+	h, _ := testFileHandlerForDir(t, dir)
+
+	_, release, err := acquireBackupLease(context.Background(), h)
+	require.NoError(t, err)
+	lease, err := readBackupLease(h)
+	require.NoError(t, err)
+	require.NotNil(t, lease)
+
+	// The location can't be leased or pruned until the lease is released.
+	_, _, err = acquireBackupLease(context.Background(), h)
+	require.ErrorContains(t, err, "the backup location is leased by "+lease.Owner)
+	_, err = ProcessPruneBackups(dir, nil, &pb.BackupRetention{KeepLast: 1}, false)
+	require.ErrorContains(t, err, "the backup location is leased by")
+	// A dry run doesn't take the lease.
+	_, err = ProcessPruneBackups(dir, nil, &pb.BackupRetention{KeepLast: 1}, true)
+	require.NoError(t, err)
+
+	release()
+	release()
+	require.False(t, h.FileExists(backupLeaseFile))
+	_, release, err = acquireBackupLease(context.Background(), h)
+	require.NoError(t, err)
+
+	// An expired lease is taken over, and its previous holder doesn't delete the new one.
+	require.NoError(t, writeBackupLease(h, &backupLease{
+		Owner:   "crashed",
+		Expires: time.Now().Add(-time.Second),
+	}))
+	_, release2, err := acquireBackupLease(context.Background(), h)
+	require.NoError(t, err)
+	release()
+	require.True(t, h.FileExists(backupLeaseFile))
+	release2()
+	require.False(t, h.FileExists(backupLeaseFile))
+}
+
+func TestBackupLeaseLost(t *testing.T) {
+	defer func(settle, renewal time.Duration) {
+		backupLeaseSettle, backupLeaseRenewal = settle, renewal
+	}(backupLeaseSettle, backupLeaseRenewal)
+	backupLeaseSettle, backupLeaseRenewal = 0, 10*time.Millisecond
+
+	dir := t.TempDir()
+	h, _ := testFileHandlerForDir(t, dir)
+	ctx, release, err := acquireBackupLease(context.Background(), h)
+	require.NoError(t, err)
+	defer release()
+
+	// The lease is renewed while it's held.
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, ctx.Err())
+
+	// Once another holder took it over, it isn't renewed and the holder is stopped.
+	require.NoError(t, writeBackupLease(h, &backupLease{
+		Owner:   "other",
+		Expires: time.Now().Add(time.Minute),
+	}))
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("the lease taken over wasn't detected")
+	}
+	require.ErrorContains(t, context.Cause(ctx), "taken over")
+	_, err = pruneBackups(ctx, h, nil, &pb.BackupRetention{KeepLast: 1}, time.Now(), false)
+	require.ErrorContains(t, err, "taken over")
+
+	release()
+	lease, err := readBackupLease(h)
+	require.NoError(t, err)
+	require.Equal(t, "other", lease.Owner)
+}
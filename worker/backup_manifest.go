@@ -85,6 +85,10 @@ func getManifestsToRestore(h UriHandler, uri *url.URL, req *pb.RestoreRequest) (
 	// validManifests are the ones for which the corresponding backup files exists.
 	var validManifests []*Manifest
 	for _, m := range manifests {
+		// A point-in-time restore starts from the backups taken before that point.
+		if req.RestoreToTs > 0 && m.ValidReadTs() > req.RestoreToTs {
+			continue
+		}
 		missingFiles := false
 		for g := range m.Groups {
 			path := filepath.Join(m.Path, backupName(m.ValidReadTs(), g))
@@ -0,0 +1,247 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// PruneResult lists the backups kept and deleted by a pruning, or the ones that would be deleted
+// in a dry run.
+type PruneResult struct {
+	Kept    []*Manifest
+	Deleted []*Manifest
+	// DeletedWAL are the segments of the WAL archive holding only commits older than the kept
+	// backups, which can't be used by a point-in-time restore anymore.
+	DeletedWAL []string
+}
+
+// ValidateRetention returns an error if the retention policy, when given, keeps no backup.
+func ValidateRetention(policy *pb.BackupRetention) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.KeepLast == 0 && policy.KeepDaily == 0 && policy.KeepMonthly == 0 {
+		return errors.Errorf("retention policy must set keep_last, keep_daily or keep_monthly")
+	}
+	return nil
+}
+
+// ProcessPruneBackups deletes the backups at the location that the retention policy doesn't
+// keep. Nothing is deleted in a dry run.
+func ProcessPruneBackups(location string, creds *x.MinioCredentials, policy *pb.BackupRetention,
+	dryRun bool) (*PruneResult, error) {
+
+	if policy == nil {
+		return nil, errors.Errorf("no retention policy given")
+	}
+	if err := ValidateRetention(policy); err != nil {
+		return nil, err
+	}
+	uri, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	h, err := NewUriHandler(uri, creds)
+	if err != nil {
+		return nil, errors.Wrap(err, "ProcessPruneBackups")
+	}
+	ctx := context.Background()
+	if !dryRun {
+		var release func()
+		ctx, release, err = acquireBackupLease(ctx, h)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+	return pruneBackups(ctx, h, uri, policy, time.Now(), dryRun)
+}
+
+// pruneBackups deletes the backups that the policy doesn't keep. The master manifest is written
+// without them before their files are deleted, so a failure may leave unreferenced files behind
+// but never a manifest referencing deleted files. Unless it's a dry run, the caller holds the
+// lease of the location, so that the manifest isn't rewritten by a backup in the meantime, and
+// the pruning stops once ctx is cancelled because the lease is lost.
+func pruneBackups(ctx context.Context, h UriHandler, uri *url.URL, policy *pb.BackupRetention,
+	now time.Time, dryRun bool) (*PruneResult, error) {
+
+	master, err := GetManifestNoUpgrade(h, uri)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading the manifests")
+	}
+	res := &PruneResult{}
+	keptPaths := make(map[string]struct{})
+	for i, keep := range backupsToKeep(master.Manifests, policy, now) {
+		m := master.Manifests[i]
+		if keep {
+			res.Kept = append(res.Kept, m)
+			keptPaths[m.Path] = struct{}{}
+		} else {
+			res.Deleted = append(res.Deleted, m)
+		}
+	}
+	res.DeletedWAL = expiredArchiveSegments(h, master.Manifests, res.Kept)
+	if dryRun {
+		return res, nil
+	}
+
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
+	}
+	if len(res.Deleted) > 0 {
+		if err := CreateManifest(h, uri, &MasterManifest{Manifests: res.Kept}); err != nil {
+			return nil, errors.Wrap(err, "while writing the pruned manifest")
+		}
+		// Best-effort like after a backup: the listing falls back to the full manifest.
+		if err := CreateManifestSummary(h, &MasterManifest{Manifests: res.Kept}); err != nil {
+			glog.Warningf("Failed to write backup summary manifest (non-fatal): %v", err)
+		}
+	}
+	for _, m := range res.Deleted {
+		if _, ok := keptPaths[m.Path]; ok {
+			continue
+		}
+		if ctx.Err() != nil {
+			return res, context.Cause(ctx)
+		}
+		if err := h.DeletePath(m.Path); err != nil {
+			return res, errors.Wrapf(err, "while deleting backup %s", m.Path)
+		}
+		glog.Infof("Deleted backup %s, number %d of series %s", m.Path, m.BackupNum, m.BackupId)
+	}
+	for _, path := range res.DeletedWAL {
+		if ctx.Err() != nil {
+			return res, context.Cause(ctx)
+		}
+		if err := h.DeletePath(path); err != nil {
+			return res, errors.Wrapf(err, "while deleting WAL archive segment %s", path)
+		}
+	}
+	return res, nil
+}
+
+// backupsToKeep returns whether the policy keeps each of the manifests, which are in the order
+// in which the backups were taken. The latest series is always kept whole, as the next
+// incremental backup continues it, and so are the backups whose time can't be read from their
+// path. An incremental backup is restored on top of the previous backups of its series, so they
+// are kept along with it.
+func backupsToKeep(manifests []*Manifest, policy *pb.BackupRetention, now time.Time) []bool {
+	keep := make([]bool, len(manifests))
+	if len(manifests) == 0 {
+		return keep
+	}
+
+	// The series in the order of their latest backup.
+	latest := make(map[string]int)
+	for i, m := range manifests {
+		latest[m.BackupId] = i
+	}
+	series := make([]string, 0, len(latest))
+	for id := range latest {
+		series = append(series, id)
+	}
+	sort.Slice(series, func(i, j int) bool { return latest[series[i]] < latest[series[j]] })
+	keepLast := int(policy.GetKeepLast())
+	if keepLast < 1 {
+		keepLast = 1
+	}
+	if keepLast > len(series) {
+		keepLast = len(series)
+	}
+	keptSeries := make(map[string]struct{})
+	for _, id := range series[len(series)-keepLast:] {
+		keptSeries[id] = struct{}{}
+	}
+
+	times := make([]time.Time, len(manifests))
+	for i, m := range manifests {
+		_, ok := keptSeries[m.BackupId]
+		t, err := parseBackupTime(m.Path)
+		keep[i] = ok || err != nil
+		times[i] = t
+	}
+
+	// keepLatestPer keeps the latest backup of each period since the given time.
+	keepLatestPer := func(period func(time.Time) time.Time, since time.Time) {
+		latest := make(map[time.Time]int)
+		for i, t := range times {
+			if t.IsZero() || t.Before(since) {
+				continue
+			}
+			p := period(t)
+			if j, ok := latest[p]; !ok || !t.Before(times[j]) {
+				latest[p] = i
+			}
+		}
+		for _, i := range latest {
+			keep[i] = true
+		}
+	}
+	now = now.UTC()
+	day := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	month := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	if n := int(policy.GetKeepDaily()); n > 0 {
+		keepLatestPer(day, day(now).AddDate(0, 0, 1-n))
+	}
+	if n := int(policy.GetKeepMonthly()); n > 0 {
+		keepLatestPer(month, month(now).AddDate(0, 1-n, 0))
+	}
+
+	maxNum := make(map[string]uint64)
+	for i, m := range manifests {
+		if keep[i] {
+			maxNum[m.BackupId] = x.Max(maxNum[m.BackupId], m.BackupNum)
+		}
+	}
+	for i, m := range manifests {
+		if num, ok := maxNum[m.BackupId]; ok && m.BackupNum <= num {
+			keep[i] = true
+		}
+	}
+	return keep
+}
+
+// expiredArchiveSegments returns the segments of the WAL archive whose commits are all before
+// the oldest kept backup.
+func expiredArchiveSegments(h UriHandler, manifests, kept []*Manifest) []string {
+	if len(kept) == 0 {
+		return nil
+	}
+	oldest := kept[0].ValidReadTs()
+	for _, m := range kept {
+		oldest = x.Min(oldest, m.ValidReadTs())
+	}
+	groups := make(map[uint32]struct{})
+	for _, m := range manifests {
+		for gid := range m.Groups {
+			groups[gid] = struct{}{}
+		}
+	}
+	var paths []string
+	for gid := range groups {
+		for _, s := range archiveSegments(h, gid) {
+			if s.lastTs <= oldest {
+				paths = append(paths, s.path)
+			}
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
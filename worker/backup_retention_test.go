@@ -0,0 +1,161 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+)
+
+// retentionManifest returns the manifest of a backup taken at the given time, whose read ts is
+// the unix time.
+func retentionManifest(id string, num uint64, at string) *Manifest {
+	t, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		panic(err)
+	}
+	typ := "incremental"
+	if num == 1 {
+		typ = "full"
+	}
+	return &Manifest{
+		ManifestBase: ManifestBase{
+			Type:      typ,
+			BackupId:  id,
+			BackupNum: num,
+			ReadTs:    uint64(t.Unix()),
+			Path:      "dgraph." + t.Format("20060102.150405.000"),
+		},
+		Groups: map[uint32][]string{1: {"0-name"}},
+	}
+}
+
+func TestBackupsToKeep(t *testing.T) {
+	manifests := []*Manifest{
+		retentionManifest("a", 1, "2026-01-10T00:00:00Z"),
+		retentionManifest("a", 2, "2026-01-20T00:00:00Z"),
+		retentionManifest("b", 1, "2026-02-10T00:00:00Z"),
+		retentionManifest("b", 2, "2026-03-05T00:00:00Z"),
+		retentionManifest("b", 3, "2026-03-05T12:00:00Z"),
+		retentionManifest("c", 1, "2026-03-09T00:00:00Z"),
+		retentionManifest("c", 2, "2026-03-10T00:00:00Z"),
+		retentionManifest("c", 3, "2026-03-10T06:00:00Z"),
+	}
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		policy *pb.BackupRetention
+		keep   []bool
+	}{
+		{
+			name:   "keep last series",
+			policy: &pb.BackupRetention{KeepLast: 2},
+			keep:   []bool{false, false, true, true, true, true, true, true},
+		},
+		{
+			name:   "latest series is always kept",
+			policy: &pb.BackupRetention{KeepDaily: 1},
+			keep:   []bool{false, false, false, false, false, true, true, true},
+		},
+		{
+			name: "daily backups keep their series",
+			// The 5th of March keeps the last backup of the day and the backups before it.
+			policy: &pb.BackupRetention{KeepDaily: 6},
+			keep:   []bool{false, false, true, true, true, true, true, true},
+		},
+		{
+			name:   "monthly backups",
+			policy: &pb.BackupRetention{KeepMonthly: 2},
+			keep:   []bool{false, false, true, false, false, true, true, true},
+		},
+		{
+			name:   "all months",
+			policy: &pb.BackupRetention{KeepMonthly: 3},
+			keep:   []bool{true, true, true, false, false, true, true, true},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.keep, backupsToKeep(manifests, tc.policy, now))
+		})
+	}
+
+	// The backups whose time can't be read are kept.
+	old := &Manifest{ManifestBase: ManifestBase{Type: "full", BackupId: "z", BackupNum: 1,
+		Path: "old-backup"}}
+	keep := backupsToKeep(append([]*Manifest{old}, manifests...),
+		&pb.BackupRetention{KeepLast: 1}, now)
+	require.True(t, keep[0])
+	require.False(t, keep[1])
+}
+
+func TestPruneBackups(t *testing.T) {
+	dir := t.TempDir()
+	manifests := []*Manifest{
+		retentionManifest("a", 1, "2026-01-10T00:00:00Z"),
+		retentionManifest("a", 2, "2026-01-20T00:00:00Z"),
+		retentionManifest("b", 1, "2026-03-09T00:00:00Z"),
+		retentionManifest("b", 2, "2026-03-10T00:00:00Z"),
+	}
+	for _, m := range manifests {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, m.Path), 0755))
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dir, m.Path, backupName(m.ReadTs, 1)), nil, 0600))
+	}
+	writeMasterManifestToDir(t, dir, manifests)
+	h, uri := testFileHandlerForDir(t, dir)
+	require.NoError(t, h.CreateDir(walArchiveDir))
+	oldSegment := walArchiveName(1, manifests[0].ReadTs, manifests[1].ReadTs)
+	newSegment := walArchiveName(1, manifests[2].ReadTs, manifests[3].ReadTs+1)
+	for _, p := range []string{oldSegment, newSegment} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, p), nil, 0600))
+	}
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	policy := &pb.BackupRetention{KeepLast: 1}
+
+	// A dry run doesn't delete anything.
+	res, err := pruneBackups(context.Background(), h, uri, policy, now, true)
+	require.NoError(t, err)
+	require.Len(t, res.Kept, 2)
+	require.Len(t, res.Deleted, 2)
+	require.Equal(t, []string{oldSegment}, res.DeletedWAL)
+	require.True(t, h.DirExists(manifests[0].Path))
+
+	res, err = pruneBackups(context.Background(), h, uri, policy, now, false)
+	require.NoError(t, err)
+	require.Len(t, res.Deleted, 2)
+	for i, m := range manifests {
+		require.Equal(t, i >= 2, h.DirExists(m.Path), m.Path)
+	}
+	require.False(t, h.FileExists(oldSegment))
+	require.True(t, h.FileExists(newSegment))
+
+	master, err := GetManifest(h, uri)
+	require.NoError(t, err)
+	require.Len(t, master.Manifests, 2)
+	require.Equal(t, "b", master.Manifests[0].BackupId)
+	summary, err := readMasterManifestSummary(h)
+	require.NoError(t, err)
+	require.Len(t, summary.Manifests, 2)
+
+	// Pruning again doesn't change anything.
+	res, err = pruneBackups(context.Background(), h, uri, policy, now, false)
+	require.NoError(t, err)
+	require.Empty(t, res.Deleted)
+	require.Empty(t, res.DeletedWAL)
+
+	require.Error(t, h.DeletePath(""))
+	require.Error(t, h.DeletePath("../.."))
+	require.Error(t, ValidateRetention(&pb.BackupRetention{}))
+	require.NoError(t, ValidateRetention(nil))
+}
@@ -0,0 +1,307 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// The status of a backup file in a BackupFileReport.
+const (
+	BackupFileOK        = "ok"
+	BackupFileMissing   = "missing"
+	BackupFileCorrupted = "corrupted"
+)
+
+// BackupFileReport is the result of the verification of the backup file of a group.
+type BackupFileReport struct {
+	Path      string `json:"path"`
+	BackupNum uint64 `json:"backup_num"`
+	GroupId   uint32 `json:"group_id"`
+	// Status is one of BackupFileOK, BackupFileMissing or BackupFileCorrupted.
+	Status string `json:"status"`
+	// Checksum is the SHA-256 of the file. ChecksumVerified is false when the manifest doesn't
+	// record the checksum of the file, which is the case of the backups taken by older versions.
+	Checksum         string `json:"checksum,omitempty"`
+	ChecksumVerified bool   `json:"checksum_verified"`
+	// NumKeys is the number of keys read from the file.
+	NumKeys uint64 `json:"num_keys"`
+	// Error describes the problem found in the file.
+	Error string `json:"error,omitempty"`
+}
+
+// BackupVerifyReport is the result of the verification of a backup series.
+type BackupVerifyReport struct {
+	BackupId   string `json:"backup_id"`
+	NumBackups int    `json:"num_backups"`
+	// Valid is true if neither the manifests nor the files of the series have a problem.
+	Valid bool `json:"valid"`
+	// Errors are the problems found in the chain of manifests of the series.
+	Errors []string            `json:"errors,omitempty"`
+	Files  []*BackupFileReport `json:"files"`
+}
+
+// ProcessVerifyBackup verifies the backup series with the given ID at the location, or the
+// latest series if the ID is empty, without restoring it. The key decrypts the encrypted backups.
+func ProcessVerifyBackup(location, backupId string, creds *x.MinioCredentials,
+	key x.Sensitive) (*BackupVerifyReport, error) {
+
+	uri, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	h, err := NewUriHandler(uri, creds)
+	if err != nil {
+		return nil, errors.Wrap(err, "ProcessVerifyBackup")
+	}
+	return verifyBackupSeries(h, uri, backupId, key)
+}
+
+// verifyBackupSeries checks that the manifests of the series form a chain from a full backup,
+// and reads every backup file of the series to check its checksum and its content. The
+// problems are returned in the report, and an error is returned only if the series can't be
+// verified at all.
+func verifyBackupSeries(h UriHandler, uri *url.URL, backupId string,
+	key x.Sensitive) (*BackupVerifyReport, error) {
+
+	master, err := GetManifest(h, uri)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading the manifests")
+	}
+	if backupId == "" {
+		if len(master.Manifests) == 0 {
+			return nil, errors.Errorf("No backups found at the location")
+		}
+		backupId = master.Manifests[len(master.Manifests)-1].BackupId
+	}
+	var series []*Manifest
+	for _, m := range master.Manifests {
+		if m.BackupId == backupId {
+			series = append(series, m)
+		}
+	}
+	if len(series) == 0 {
+		return nil, errors.Errorf("No backups with the specified backup ID %s", backupId)
+	}
+	keys := make([]x.Sensitive, len(series))
+	for i, m := range series {
+		if m.Encrypted && len(key) == 0 && m.KeyFingerprint == "" {
+			return nil, errors.Errorf("backup %s is encrypted but no encryption key was given",
+				m.Path)
+		}
+		if keys[i], err = manifestKey(key, m); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &BackupVerifyReport{
+		BackupId:   backupId,
+		NumBackups: len(series),
+		Errors:     verifyBackupChain(series),
+	}
+	report.Valid = len(report.Errors) == 0
+	for i, m := range series {
+		sinceTs := m.SinceTs
+		if sinceTs == 0 && i > 0 {
+			sinceTs = series[i-1].ValidReadTs()
+		}
+		gids := make([]uint32, 0, len(m.Groups))
+		for gid := range m.Groups {
+			gids = append(gids, gid)
+		}
+		sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+		for _, gid := range gids {
+			fr := verifyBackupFile(h, m, gid, sinceTs, keys[i])
+			report.Valid = report.Valid && fr.Status == BackupFileOK
+			report.Files = append(report.Files, fr)
+		}
+	}
+	return report, nil
+}
+
+// verifyBackupChain returns the problems found in the chain of manifests of a series, ordered
+// from the full backup to the latest incremental backup.
+func verifyBackupChain(series []*Manifest) []string {
+	var errs []string
+	for i, m := range series {
+		if i == 0 {
+			if m.Type != "full" || m.BackupNum != 1 {
+				errs = append(errs, errors.Errorf("backup %s of type %s and number %d isn't "+
+					"the full backup starting the series", m.Path, m.Type, m.BackupNum).Error())
+			}
+			continue
+		}
+		prev := series[i-1]
+		if m.BackupNum != prev.BackupNum+1 {
+			errs = append(errs, errors.Errorf("backup %s has number %d, expected %d",
+				m.Path, m.BackupNum, prev.BackupNum+1).Error())
+		}
+		if m.Type != "incremental" {
+			errs = append(errs, errors.Errorf("backup %s of type %s follows backup %s, "+
+				"expected an incremental backup", m.Path, m.Type, prev.Path).Error())
+		}
+		if m.ValidReadTs() <= prev.ValidReadTs() {
+			errs = append(errs, errors.Errorf("backup %s has read_ts %d, which isn't after "+
+				"the read_ts %d of backup %s", m.Path, m.ValidReadTs(), prev.ValidReadTs(),
+				prev.Path).Error())
+		}
+		if m.SinceTs != 0 && m.SinceTs != prev.ValidReadTs() {
+			errs = append(errs, errors.Errorf("backup %s starts at since_ts %d, but the "+
+				"previous backup %s was taken at read_ts %d", m.Path, m.SinceTs, prev.Path,
+				prev.ValidReadTs()).Error())
+		}
+	}
+	return errs
+}
+
+// verifyBackupFile reads the backup file of the group and reports whether it's missing or
+// corrupted.
+func verifyBackupFile(h UriHandler, m *Manifest, gid uint32, sinceTs uint64,
+	key x.Sensitive) *BackupFileReport {
+
+	path := filepath.Join(m.Path, backupName(m.ValidReadTs(), gid))
+	report := &BackupFileReport{
+		Path:      path,
+		BackupNum: m.BackupNum,
+		GroupId:   gid,
+		Status:    BackupFileOK,
+	}
+	if !h.FileExists(path) {
+		report.Status = BackupFileMissing
+		report.Error = "backup file not found"
+		return report
+	}
+
+	numKeys, sum, err := readBackupFile(h, path, m, sinceTs, key)
+	report.NumKeys = numKeys
+	report.Checksum = sum
+	if want, ok := m.Checksums[gid]; ok && err == nil {
+		report.ChecksumVerified = true
+		if sum != want {
+			err = errors.Errorf("checksum %s doesn't match the checksum %s in the manifest",
+				sum, want)
+		}
+	}
+	if err != nil {
+		report.Status = BackupFileCorrupted
+		report.Error = err.Error()
+	}
+	return report
+}
+
+// readBackupFile decrypts, decompresses and checks the content of a backup file, and returns
+// the number of keys read and the checksum of the file.
+func readBackupFile(h UriHandler, path string, m *Manifest, sinceTs uint64,
+	key x.Sensitive) (uint64, string, error) {
+
+	r, err := h.Stream(path)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "while opening the backup file")
+	}
+	hash := sha256.New()
+	raw := io.TeeReader(r, hash)
+	br := &backupReader{r: raw, toClose: []io.Closer{r}}
+	defer br.Close()
+	if m.Encrypted {
+		br = br.WithEncryption(key)
+	}
+	br = br.WithCompression(m.Compression)
+	if br.err != nil {
+		return 0, "", errors.Wrap(br.err, "while reading the backup file")
+	}
+
+	numKeys, err := checkBackupLists(br, m.ValidReadTs(), sinceTs)
+	if err != nil {
+		return numKeys, "", err
+	}
+	// The decompression may stop before the end of the file, so the rest is read for the checksum.
+	if _, err := io.Copy(io.Discard, raw); err != nil {
+		return numKeys, "", errors.Wrap(err, "while reading the backup file")
+	}
+	return numKeys, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// checkBackupLists reads the KV lists of a backup file and checks that their keys are valid and
+// that their versions are in the range of the backup. The posting lists are streamed by ranges
+// in parallel, so their keys aren't sorted, but they must come before the schema and the types,
+// which are written in order.
+func checkBackupLists(r io.Reader, readTs, sinceTs uint64) (uint64, error) {
+	br := bufio.NewReaderSize(r, 16<<10)
+	var numKeys uint64
+	var lastKey []byte
+	for {
+		var sz uint64
+		err := binary.Read(br, binary.LittleEndian, &sz)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return numKeys, errors.Wrap(err, "while reading the size of a list")
+		}
+		// The buffer grows with the data read, so a corrupted size doesn't allocate it at once.
+		buf, err := io.ReadAll(io.LimitReader(br, int64(sz)))
+		if err != nil {
+			return numKeys, errors.Wrap(err, "while reading a list")
+		}
+		if uint64(len(buf)) != sz {
+			return numKeys, errors.Errorf("list is truncated to %d bytes, expected %d",
+				len(buf), sz)
+		}
+		list := &bpb.KVList{}
+		if err := proto.Unmarshal(buf, list); err != nil {
+			return numKeys, errors.Wrap(err, "while unmarshalling a list")
+		}
+
+		for _, kv := range list.Kv {
+			if len(kv.GetUserMeta()) != 1 {
+				return numKeys, errors.Errorf("unexpected meta %v for key %x", kv.UserMeta, kv.Key)
+			}
+			key, _, err := fromBackupKey(kv.Key)
+			if err != nil {
+				return numKeys, err
+			}
+			pk, err := x.Parse(key)
+			if err != nil {
+				return numKeys, errors.Wrapf(err, "could not parse key %x", key)
+			}
+			numKeys++
+			if kv.Version > readTs {
+				return numKeys, errors.Errorf("key %x has version %d after the read_ts %d",
+					key, kv.Version, readTs)
+			}
+			if pk.IsSchema() || pk.IsType() {
+				if lastKey != nil && bytes.Compare(key, lastKey) <= 0 {
+					return numKeys, errors.Errorf("key %x of the schema or types isn't after %x",
+						key, lastKey)
+				}
+				lastKey = key
+				continue
+			}
+			if lastKey != nil {
+				return numKeys, errors.Errorf("key %x is after the schema and types", key)
+			}
+			if kv.Version < sinceTs {
+				return numKeys, errors.Errorf("key %x has version %d before the since_ts %d",
+					key, kv.Version, sinceTs)
+			}
+		}
+	}
+	return numKeys, nil
+}
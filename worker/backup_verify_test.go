@@ -0,0 +1,229 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgraph/v25/enc"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+func testBackupKV(t *testing.T, key []byte, version uint64) *bpb.KV {
+	pk, err := x.Parse(key)
+	require.NoError(t, err)
+	bk, err := proto.Marshal(pk.ToBackupKey())
+	require.NoError(t, err)
+	return &bpb.KV{Key: bk, Value: []byte("v"), UserMeta: []byte{0}, Version: version}
+}
+
+// writeTestBackupFile writes the lists to the backup file of the group like WriteBackup does, and
+// returns its checksum.
+func writeTestBackupFile(t *testing.T, dir string, m *Manifest, gid uint32, key x.Sensitive,
+	lists ...[]*bpb.KV) string {
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, m.Path), 0755))
+	f, err := os.Create(filepath.Join(dir, m.Path, backupName(m.ReadTs, gid)))
+	require.NoError(t, err)
+	hash := sha256.New()
+	ew, err := enc.GetWriter(key, io.MultiWriter(f, hash))
+	require.NoError(t, err)
+	cw := s2.NewWriter(ew)
+	for _, kvs := range lists {
+		require.NoError(t, writeKVList(&bpb.KVList{Kv: kvs}, cw))
+	}
+	require.NoError(t, cw.Close())
+	require.NoError(t, f.Close())
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func TestVerifyBackupSeries(t *testing.T) {
+	key := x.Sensitive("0123456789abcdef")
+	dir := t.TempDir()
+	full := &Manifest{
+		ManifestBase: ManifestBase{
+			Type: "full", BackupId: "id1", BackupNum: 1, Path: "dgraph.20260101.000000.000",
+			ReadTs: 100, Version: x.ManifestVersion, Encrypted: true, Compression: "snappy",
+		},
+		Groups: map[uint32][]string{1: {"0-name"}, 2: {"0-age"}},
+	}
+	incr := &Manifest{
+		ManifestBase: ManifestBase{
+			Type: "incremental", BackupId: "id1", BackupNum: 2, Path: "dgraph.20260102.000000.000",
+			ReadTs: 200, SinceTs: 100, Version: x.ManifestVersion, Encrypted: true,
+			Compression: "snappy",
+		},
+		Groups: map[uint32][]string{1: {"0-name"}, 2: {"0-age"}},
+	}
+	name, age := x.AttrInRootNamespace("name"), x.AttrInRootNamespace("age")
+	full.Checksums = map[uint32]string{
+		1: writeTestBackupFile(t, dir, full, 1, key,
+			[]*bpb.KV{testBackupKV(t, x.DataKey(name, 2), 90),
+				testBackupKV(t, x.DataKey(name, 1), 80)},
+			[]*bpb.KV{testBackupKV(t, x.SchemaKey(name), 1)},
+			[]*bpb.KV{testBackupKV(t, x.TypeKey(x.AttrInRootNamespace("Person")), 1)}),
+		2: writeTestBackupFile(t, dir, full, 2, key,
+			[]*bpb.KV{testBackupKV(t, x.DataKey(age, 1), 90)},
+			[]*bpb.KV{testBackupKV(t, x.SchemaKey(age), 1)}),
+	}
+	incr.Checksums = map[uint32]string{
+		1: writeTestBackupFile(t, dir, incr, 1, key,
+			[]*bpb.KV{testBackupKV(t, x.DataKey(name, 3), 150)}),
+		2: writeTestBackupFile(t, dir, incr, 2, key),
+	}
+	writeMasterManifestToDir(t, dir, []*Manifest{full, incr})
+	h, uri := testFileHandlerForDir(t, dir)
+
+	report, err := verifyBackupSeries(h, uri, "", key)
+	require.NoError(t, err)
+	require.True(t, report.Valid, "%+v", report)
+	require.Equal(t, "id1", report.BackupId)
+	require.Equal(t, 2, report.NumBackups)
+	require.Len(t, report.Files, 4)
+	require.Equal(t, uint64(4), report.Files[0].NumKeys)
+	for _, fr := range report.Files {
+		require.Equal(t, BackupFileOK, fr.Status)
+		require.True(t, fr.ChecksumVerified)
+	}
+
+	_, err = verifyBackupSeries(h, uri, "", nil)
+	require.Error(t, err)
+	_, err = verifyBackupSeries(h, uri, "id2", key)
+	require.Error(t, err)
+
+	// A changed byte is caught by the checksum or the decompression, and a missing file and a
+	// broken chain are reported.
+	path := filepath.Join(dir, full.Path, backupName(full.ReadTs, 1))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)/2] ^= 0xff
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	require.NoError(t, os.Remove(filepath.Join(dir, incr.Path, backupName(incr.ReadTs, 2))))
+	incr.SinceTs = 90
+	writeMasterManifestToDir(t, dir, []*Manifest{full, incr})
+
+	report, err = verifyBackupSeries(h, uri, "id1", key)
+	require.NoError(t, err)
+	require.False(t, report.Valid)
+	require.Len(t, report.Errors, 1)
+	require.Contains(t, report.Errors[0], "since_ts 90")
+	require.Equal(t, BackupFileCorrupted, report.Files[0].Status)
+	require.Equal(t, BackupFileOK, report.Files[1].Status)
+	require.Equal(t, BackupFileOK, report.Files[2].Status)
+	require.Equal(t, BackupFileMissing, report.Files[3].Status)
+}
+
+func TestVerifyBackupChain(t *testing.T) {
+	manifest := func(typ string, num, sinceTs, readTs uint64) *Manifest {
+		return &Manifest{ManifestBase: ManifestBase{
+			Type: typ, BackupNum: num, SinceTs: sinceTs, ReadTs: readTs}}
+	}
+	tests := []struct {
+		name   string
+		series []*Manifest
+		errs   int
+	}{
+		{
+			name:   "valid chain",
+			series: []*Manifest{manifest("full", 1, 0, 10), manifest("incremental", 2, 10, 20)},
+		},
+		{
+			name:   "since_ts not recorded",
+			series: []*Manifest{manifest("full", 1, 0, 10), manifest("incremental", 2, 0, 20)},
+		},
+		{
+			name:   "no full backup",
+			series: []*Manifest{manifest("incremental", 2, 10, 20)},
+			errs:   1,
+		},
+		{
+			name:   "missing backup",
+			series: []*Manifest{manifest("full", 1, 0, 10), manifest("incremental", 3, 20, 30)},
+			errs:   2,
+		},
+		{
+			name:   "read_ts going back",
+			series: []*Manifest{manifest("full", 1, 0, 10), manifest("incremental", 2, 10, 5)},
+			errs:   1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Len(t, verifyBackupChain(tc.series), tc.errs)
+		})
+	}
+}
+
+func TestCheckBackupLists(t *testing.T) {
+	name := x.AttrInRootNamespace("name")
+	write := func(lists ...[]*bpb.KV) *os.File {
+		f, err := os.CreateTemp(t.TempDir(), "lists")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = f.Close() })
+		for _, kvs := range lists {
+			require.NoError(t, writeKVList(&bpb.KVList{Kv: kvs}, f))
+		}
+		_, err = f.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		return f
+	}
+	tests := []struct {
+		name  string
+		lists [][]*bpb.KV
+		err   string
+	}{
+		{
+			name:  "version after read_ts",
+			lists: [][]*bpb.KV{{testBackupKV(t, x.DataKey(name, 1), 101)}},
+			err:   "after the read_ts",
+		},
+		{
+			name:  "version before since_ts",
+			lists: [][]*bpb.KV{{testBackupKV(t, x.DataKey(name, 1), 40)}},
+			err:   "before the since_ts",
+		},
+		{
+			name: "data after the schema",
+			lists: [][]*bpb.KV{{testBackupKV(t, x.SchemaKey(name), 1)},
+				{testBackupKV(t, x.DataKey(name, 1), 60)}},
+			err: "after the schema",
+		},
+		{
+			name: "unsorted schema",
+			lists: [][]*bpb.KV{{testBackupKV(t, x.SchemaKey(name), 1),
+				testBackupKV(t, x.SchemaKey(x.AttrInRootNamespace("age")), 1)}},
+			err: "isn't after",
+		},
+		{
+			name:  "invalid key",
+			lists: [][]*bpb.KV{{{Key: []byte("invalid"), UserMeta: []byte{0}}}},
+			err:   "backup key",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := checkBackupLists(write(tc.lists...), 100, 50)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	// A truncated list is reported instead of being allocated.
+	f := write([]*bpb.KV{testBackupKV(t, x.DataKey(name, 1), 60)})
+	info, err := f.Stat()
+	require.NoError(t, err)
+	_, err = checkBackupLists(io.LimitReader(f, info.Size()-1), 100, 50)
+	require.ErrorContains(t, err, "truncated")
+}
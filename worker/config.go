@@ -64,11 +64,21 @@ type Options struct {
 	// Define different ChangeDataCapture configurations
 	ChangeDataConf string
 
+	// WALArchiveConf is the superflag that configures the archiving of the commits to the backup
+	// location, used for point-in-time restores.
+	WALArchiveConf string
+
 	// TypeFilterUidLimit decides how many elements would be searched directly
 	// vs searched via type index. If the number of elements are too low, then querying the
 	// index might be slower. This would allow people to set their limit according to
 	// their use case.
 	TypeFilterUidLimit uint64
+
+	// HistoryRetention is the duration for which older versions of the data are kept around, so
+	// that they can be read via AS OF queries.
+	HistoryRetention time.Duration
+	// PredicateHistory overrides HistoryRetention for individual predicates.
+	PredicateHistory map[string]time.Duration
 }
 
 // Config holds an instance of the server options..
@@ -116,8 +126,10 @@ func (opt Options) String() string {
 	return fmt.Sprintf("{PostingDir:%s WALDir:%s MutationsMode:%d AuthToken:**** "+
 		"AclJwtAlg:%v AclSecretKey:**** AclSecretKeyBytes:**** AccessJwtTtl:%v "+
 		"RefreshJwtTtl:%v CachePercentage:%s CacheMb:%d RemoveOnUpdate:%v Audit:%v "+
-		"ChangeDataConf:%s TypeFilterUidLimit:%d}",
+		"ChangeDataConf:%s WALArchiveConf:%s TypeFilterUidLimit:%d HistoryRetention:%v "+
+		"PredicateHistory:%v}",
 		opt.PostingDir, opt.WALDir, opt.MutationsMode, opt.AclJwtAlg,
 		opt.AccessJwtTtl, opt.RefreshJwtTtl, opt.CachePercentage, opt.CacheMb,
-		opt.RemoveOnUpdate, opt.Audit, opt.ChangeDataConf, opt.TypeFilterUidLimit)
+		opt.RemoveOnUpdate, opt.Audit, opt.ChangeDataConf, opt.WALArchiveConf, opt.TypeFilterUidLimit,
+		opt.HistoryRetention, opt.PredicateHistory)
 }
@@ -0,0 +1,178 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgraph/v25/conn"
+	"github.com/dgraph-io/dgraph/v25/posting"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// CheckConsistencyOverNetwork checks the index, reverse and count keys of a predicate on the
+// leader of the group that serves it, and repairs them if asked to.
+func CheckConsistencyOverNetwork(ctx context.Context,
+	req *pb.ConsistencyCheckRequest) (*pb.ConsistencyCheckResponse, error) {
+	gid, err := groups().BelongsToReadOnly(req.Predicate, 0)
+	if err != nil {
+		return nil, err
+	} else if gid == 0 {
+		return nil, errors.Errorf("predicate %s isn't served by any group",
+			x.ParseAttr(req.Predicate))
+	}
+	if groups().ServesGroup(gid) {
+		return checkConsistency(ctx, req)
+	}
+
+	pl := groups().Leader(gid)
+	if pl == nil {
+		return nil, conn.ErrNoConnection
+	}
+	return pb.NewWorkerClient(pl.Get()).CheckConsistency(ctx, req)
+}
+
+// CheckConsistency checks the predicate of the request on this server.
+func (w *grpcWorker) CheckConsistency(ctx context.Context,
+	req *pb.ConsistencyCheckRequest) (*pb.ConsistencyCheckResponse, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if serves, err := groups().ServesTablet(req.Predicate); err != nil {
+		return nil, err
+	} else if !serves {
+		return nil, errors.Errorf("this server doesn't serve predicate %s",
+			x.ParseAttr(req.Predicate))
+	}
+	return checkConsistency(ctx, req)
+}
+
+func checkConsistency(ctx context.Context,
+	req *pb.ConsistencyCheckRequest) (*pb.ConsistencyCheckResponse, error) {
+	readTs := req.ReadTs
+	if req.Repair && readTs != 0 {
+		return nil, errors.Errorf("a repair checks the predicate at a new timestamp, it can't be " +
+			"given a read timestamp")
+	}
+	if readTs == 0 {
+		ts, err := Timestamps(ctx, &pb.Num{ReadOnly: true})
+		if err != nil {
+			return nil, err
+		}
+		readTs = ts.ReadOnly
+	}
+	if err := posting.Oracle().WaitForTs(ctx, readTs); err != nil {
+		return nil, err
+	}
+
+	glog.Infof("Checking the consistency of %s at %d", req.Predicate, readTs)
+	report, err := posting.CheckConsistency(ctx, pstore, req.Predicate, readTs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while checking %s", x.ParseAttr(req.Predicate))
+	}
+	glog.Infof("Checked %d data keys and %d derived keys of %s, found %d inconsistent keys",
+		report.DataKeys, report.Keys, req.Predicate, len(report.Inconsistencies))
+
+	resp := &pb.ConsistencyCheckResponse{
+		ReadTs:   readTs,
+		DataKeys: uint64(report.DataKeys),
+		Keys:     uint64(report.Keys),
+		Issues:   issuesToPb(report.Inconsistencies),
+	}
+	if !req.Repair || len(report.Inconsistencies) == 0 {
+		return resp, nil
+	}
+
+	unrepaired, err := repairConsistency(ctx, report)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while repairing %s", x.ParseAttr(req.Predicate))
+	}
+	resp.Unrepaired = issuesToPb(unrepaired)
+	resp.Repaired = len(unrepaired) == 0
+	return resp, nil
+}
+
+func issuesToPb(incs []*posting.Inconsistency) []*pb.ConsistencyIssue {
+	var issues []*pb.ConsistencyIssue
+	for _, inc := range incs {
+		issues = append(issues, &pb.ConsistencyIssue{
+			Key:     inc.Key,
+			Missing: inc.Missing,
+			Extra:   inc.Extra,
+		})
+	}
+	return issues
+}
+
+// repairConsistency proposes the deltas that fix the inconsistent keys of the report, at a commit
+// timestamp leased from Zero once the check is done. No rollup can have been written above it, so
+// the deltas aren't hidden. A transaction that commits between the check and the repair can
+// still touch the same keys, so the predicate is checked again once the deltas are applied, and
+// the repaired keys that are still inconsistent are returned.
+func repairConsistency(ctx context.Context,
+	report *posting.ConsistencyReport) ([]*posting.Inconsistency, error) {
+	ts, err := Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil {
+		return nil, err
+	}
+	commitTs := ts.StartId
+	kvs, err := report.RepairKVs(commitTs)
+	if err != nil {
+		return nil, err
+	}
+
+	n := groups().Node
+	var batch []*bpb.KV
+	size := 0
+	for _, kv := range kvs {
+		batch = append(batch, kv)
+		size += len(kv.Key) + len(kv.Value)
+		if size >= 32<<20 { // 32 MB
+			if err := n.proposeAndWait(ctx, &pb.Proposal{Kv: batch}); err != nil {
+				return nil, err
+			}
+			batch, size = nil, 0
+		}
+	}
+	if len(batch) > 0 {
+		if err := n.proposeAndWait(ctx, &pb.Proposal{Kv: batch}); err != nil {
+			return nil, err
+		}
+	}
+	glog.Infof("Repaired %d keys of %s at %d", len(kvs), report.Attr, commitTs)
+
+	ts, err = Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil {
+		return nil, err
+	}
+	if err := posting.Oracle().WaitForTs(ctx, ts.StartId); err != nil {
+		return nil, err
+	}
+	recheck, err := posting.CheckConsistency(ctx, pstore, report.Attr, ts.StartId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while checking the repair")
+	}
+	repaired := make(map[string]struct{}, len(report.Inconsistencies))
+	for _, inc := range report.Inconsistencies {
+		repaired[string(inc.Key)] = struct{}{}
+	}
+	var unrepaired []*posting.Inconsistency
+	for _, inc := range recheck.Inconsistencies {
+		if _, ok := repaired[string(inc.Key)]; ok {
+			unrepaired = append(unrepaired, inc)
+		}
+	}
+	if len(unrepaired) > 0 {
+		glog.Warningf("%d repaired keys of %s are still inconsistent at %d", len(unrepaired),
+			report.Attr, ts.StartId)
+	}
+	return unrepaired, nil
+}
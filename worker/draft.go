@@ -67,6 +67,7 @@ type node struct {
 	ops         map[op]operation
 	opsLock     sync.Mutex
 	cdcTracker  *CDC
+	walArchiver *walArchiver
 	canCampaign bool
 }
 
@@ -273,10 +274,11 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 		// We need a generous size for applyCh, because raft.Tick happens every
 		// 10ms. If we restrict the size here, then Raft goes into a loop trying
 		// to maintain quorum health.
-		applyCh:    make(chan []raftpb.Entry, 1000),
-		closer:     z.NewCloser(4), // Matches CLOSER:1
-		ops:        make(map[op]operation),
-		cdcTracker: newCDC(),
+		applyCh:     make(chan []raftpb.Entry, 1000),
+		closer:      z.NewCloser(4), // Matches CLOSER:1
+		ops:         make(map[op]operation),
+		cdcTracker:  newCDC(),
+		walArchiver: newWALArchiver(),
 	}
 	return n
 }
@@ -782,8 +784,12 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 			}
 			glog.Warningf("Error while calling CreateSnapshot: %v. Retrying...", err)
 		}
-		// We can now discard all invalid versions of keys below this ts.
-		pstore.SetDiscardTs(snap.ReadTs)
+		// We can now discard all invalid versions of keys below this ts, unless they still fall
+		// within the history retention window.
+		discardTs := HistoryDiscardTs(snap.ReadTs)
+		pstore.SetDiscardTs(discardTs)
+		posting.Oracle().PruneTimes(discardTs)
+		n.walArchiver.pruneCommitTimes(snap.ReadTs)
 		return nil
 	case proposal.Restore != nil:
 		// Enable draining mode for the duration of the restore processing.
@@ -823,6 +829,9 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 	case proposal.CdcState != nil:
 		n.cdcTracker.updateCDCState(proposal.CdcState)
 		return nil
+
+	case proposal.IndexBuildControl != nil:
+		return n.applyIndexBuildCancel(ctx, proposal.IndexBuildControl)
 	}
 	x.Fatalf("Unknown proposal: %+v", proposal)
 	return nil
@@ -904,6 +913,9 @@ func (n *node) processApplyCh() {
 				// if this applyCommitted fails, how do we ensure
 				start := time.Now()
 				perr = n.applyCommitted(&proposal, key)
+				// The time is recorded before the entry is marked as applied, which lets the WAL
+				// archive read it.
+				n.walArchiver.recordApplied(&proposal)
 				if key != 0 {
 					p := &P{err: perr, size: psz, seen: time.Now()}
 					previous[key] = p
@@ -1120,7 +1132,8 @@ func (n *node) proposeCDCState(ts uint64) error {
 }
 
 func (n *node) proposeSnapshot() error {
-	lastIdx := x.Min(n.Applied.DoneUntil(), n.cdcTracker.getSeenIndex())
+	lastIdx := x.Min(n.Applied.DoneUntil(),
+		x.Min(n.cdcTracker.getSeenIndex(), n.walArchiver.getSeenIndex()))
 	// We can't rely upon the Raft entries to determine the minPendingStart,
 	// because there are many cases during mutations where we don't commit or
 	// abort the transaction. This might happen due to an early error thrown.
@@ -1132,7 +1145,8 @@ func (n *node) proposeSnapshot() error {
 	// So, we iterate over logs. If we hit MinPendingStartTs, that generates our
 	// snapshotIdx. In any case, we continue picking up txn updates, to generate
 	// a maxCommitTs, which would become the readTs for the snapshot.
-	minPendingStart := x.Min(posting.Oracle().MinPendingStartTs(), n.cdcTracker.getTs())
+	minPendingStart := x.Min(posting.Oracle().MinPendingStartTs(),
+		x.Min(n.cdcTracker.getTs(), n.walArchiver.getTs()))
 	snap, err := n.calculateSnapshot(0, lastIdx, minPendingStart)
 	if err != nil {
 		return err
@@ -2017,6 +2031,7 @@ func (n *node) InitAndStartNode() {
 	go n.BatchAndSendMessages()
 	go n.monitorRaftMetrics()
 	go n.cdcTracker.processCDCEvents()
+	go n.walArchiver.run()
 	// Ignoring the error since InitAndStartNode does not return an error and using x.Check would
 	// not be the right thing to do.
 	_, _ = n.startTask(opRollup)
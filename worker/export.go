@@ -17,6 +17,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/dgraph-io/dgraph/v25/enc"
 	"github.com/dgraph-io/dgraph/v25/posting"
 	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
 	"github.com/dgraph-io/dgraph/v25/tok/hnsw"
 	"github.com/dgraph-io/dgraph/v25/types"
 	"github.com/dgraph-io/dgraph/v25/types/facets"
@@ -287,6 +289,12 @@ func toSchema(attr string, update *pb.SchemaUpdate) *bpb.KV {
 	// bytes.Buffer never returns error for any of the writes. So, we don't need to check them.
 	ns, attr := x.ParseNamespaceAttr(attr)
 	var buf bytes.Buffer
+	// The analyzers of the indexes are declared before the predicate that uses them.
+	for _, tokenizer := range update.GetTokenizer() {
+		if a, ok := update.GetAnalyzers()[tokenizer]; ok {
+			x.Check2(buf.WriteString(formatAnalyzer(ns, a)))
+		}
+	}
 	x.Check2(buf.WriteString(fmt.Sprintf("[%#x]", ns)))
 	x.Check2(buf.WriteRune(' '))
 	x.Check2(buf.WriteRune('<'))
@@ -305,7 +313,15 @@ func toSchema(attr string, update *pb.SchemaUpdate) *bpb.KV {
 		x.Check2(buf.WriteString(" @reverse"))
 	case update.GetDirective() == pb.SchemaUpdate_INDEX && len(update.GetTokenizer()) > 0:
 		x.Check2(buf.WriteString(" @index("))
-		x.Check2(buf.WriteString(strings.Join(update.GetTokenizer(), ",")))
+		for i, tokenizer := range update.GetTokenizer() {
+			if i > 0 {
+				x.Check2(buf.WriteRune(','))
+			}
+			x.Check2(buf.WriteString(tokenizer))
+			if a, ok := update.GetAnalyzers()[tokenizer]; ok {
+				x.Check2(buf.WriteString(`(analyzer:"` + a.GetName() + `")`))
+			}
+		}
 		x.Check2(buf.WriteRune(')'))
 
 	case len(update.GetIndexSpecs()) != 0:
@@ -325,6 +341,18 @@ func toSchema(attr string, update *pb.SchemaUpdate) *bpb.KV {
 	if update.GetUnique() {
 		x.Check2(buf.WriteString(" @unique"))
 	}
+	if len(update.GetFacets()) > 0 {
+		x.Check2(buf.WriteString(formatFacetsSchema(update.GetFacets())))
+	}
+	if enc := update.GetEncryption(); enc != nil {
+		x.Check2(buf.WriteString(formatEncryption(enc)))
+	}
+	switch {
+	case update.GetTtlFromValue():
+		x.Check2(buf.WriteString(" @ttl"))
+	case update.GetTtl() > 0:
+		x.Check2(buf.WriteString(" @ttl(" + formatTTL(update.Ttl) + ")"))
+	}
 	x.Check2(buf.WriteString(" . \n"))
 	//TODO(Naman): We don't need the version anymore.
 	return &bpb.KV{
@@ -333,6 +361,125 @@ func toSchema(attr string, update *pb.SchemaUpdate) *bpb.KV {
 	}
 }
 
+func formatFacetsSchema(facets []*pb.FacetSchema) string {
+	var buf bytes.Buffer
+	x.Check2(buf.WriteString(" @facets("))
+	for i, f := range facets {
+		if i > 0 {
+			x.Check2(buf.WriteString(", "))
+		}
+		x.Check2(buf.WriteString(f.Name))
+		x.Check2(buf.WriteString(": "))
+		x.Check2(buf.WriteString(types.TypeID(f.ValueType).Name()))
+		if f.Tokenizer != "" {
+			x.Check2(buf.WriteString(" @index("))
+			x.Check2(buf.WriteString(f.Tokenizer))
+			x.Check2(buf.WriteRune(')'))
+		}
+	}
+	x.Check2(buf.WriteRune(')'))
+	return buf.String()
+}
+
+// formatFieldConstraint formats the constraints of a field of a type as its directives.
+func formatFieldConstraint(c *pb.FieldConstraint) string {
+	var buf bytes.Buffer
+	formatNumber := func(f float64) string {
+		// Negative numbers are quoted, as the schema lexer doesn't read them.
+		s := strconv.FormatFloat(f, 'f', -1, 64)
+		if f < 0 {
+			return strconv.Quote(s)
+		}
+		return s
+	}
+	if c.Required {
+		x.Check2(buf.WriteString(" @required"))
+	}
+	if c.Min != nil {
+		x.Check2(buf.WriteString(" @min(" + formatNumber(c.GetMin()) + ")"))
+	}
+	if c.Max != nil {
+		x.Check2(buf.WriteString(" @max(" + formatNumber(c.GetMax()) + ")"))
+	}
+	if c.MinLength != nil {
+		x.Check2(buf.WriteString(" @length(" + strconv.FormatInt(c.GetMinLength(), 10)))
+		if c.MaxLength != nil {
+			x.Check2(buf.WriteString(", " + strconv.FormatInt(c.GetMaxLength(), 10)))
+		}
+		x.Check2(buf.WriteRune(')'))
+	}
+	if c.Regex != "" {
+		x.Check2(buf.WriteString(" @regex(" + strconv.Quote(c.Regex) + ")"))
+	}
+	if len(c.Enum) > 0 {
+		x.Check2(buf.WriteString(" @enum("))
+		for i, v := range c.Enum {
+			if i > 0 {
+				x.Check2(buf.WriteString(", "))
+			}
+			x.Check2(buf.WriteString(strconv.Quote(v)))
+		}
+		x.Check2(buf.WriteRune(')'))
+	}
+	if c.TargetType != "" {
+		x.Check2(buf.WriteString(" @target(" + c.TargetType + ")"))
+	}
+	return buf.String()
+}
+
+// formatEncryption formats the encryption of a predicate as its @encrypted directive.
+func formatEncryption(enc *pb.FieldEncryption) string {
+	mode := "random"
+	if enc.GetDeterministic() {
+		mode = "deterministic"
+	}
+	s := fmt.Sprintf(` @encrypted(key:%q, mode:%q`, enc.GetKey(), mode)
+	if len(enc.GetGroups()) > 0 {
+		s += fmt.Sprintf(`, groups:%q`, strings.Join(enc.GetGroups(), ","))
+	}
+	return s + ")"
+}
+
+// formatTTL formats a TTL in seconds the way it's written in the schema.
+func formatTTL(ttl int64) string {
+	const day = 24 * 60 * 60
+	if ttl%day == 0 {
+		return strconv.FormatInt(ttl/day, 10) + "d"
+	}
+	return (time.Duration(ttl) * time.Second).String()
+}
+
+func formatAnalyzer(ns uint64, a *pb.Analyzer) string {
+	var buf bytes.Buffer
+	x.Check2(buf.WriteString(fmt.Sprintf("[%#x] analyzer %s {\n", ns, a.GetName())))
+	writeStage := func(kind string, stage *pb.AnalyzerStage) {
+		x.Check2(buf.WriteString("\t" + kind + ": " + stage.GetName()))
+		if len(stage.GetOptions()) > 0 {
+			x.Check2(buf.WriteRune('('))
+			for i, o := range stage.GetOptions() {
+				if i > 0 {
+					x.Check2(buf.WriteRune(','))
+				}
+				// Option values are read without unescaping them, so they are written as is.
+				x.Check2(buf.WriteString(o.Key + `:"` + o.Value + `"`))
+			}
+			x.Check2(buf.WriteRune(')'))
+		}
+		x.Check2(buf.WriteRune('\n'))
+	}
+	for _, stage := range a.GetCharFilters() {
+		writeStage("char_filter", stage)
+	}
+	if a.GetTokenizer() != nil {
+		writeStage("tokenizer", a.GetTokenizer())
+	}
+	for _, stage := range a.GetFilters() {
+		writeStage("filter", stage)
+	}
+	x.Check2(buf.WriteString("}\n"))
+	return buf.String()
+}
+
 func formatVectorSchema(schema *pb.SchemaUpdate) string {
 	var buf bytes.Buffer
 	x.Check2(buf.WriteString(" @index("))
@@ -364,7 +511,21 @@ func formatVectorSchema(schema *pb.SchemaUpdate) string {
 func toType(attr string, update pb.TypeUpdate) *bpb.KV {
 	var buf bytes.Buffer
 	ns, attr := x.ParseNamespaceAttr(attr)
-	x.Check2(buf.WriteString(fmt.Sprintf("[%#x] type <%s> {\n", ns, attr)))
+	x.Check2(buf.WriteString(fmt.Sprintf("[%#x] type <%s> ", ns, attr)))
+	if update.Closed {
+		x.Check2(buf.WriteString("@closed "))
+	}
+	for _, tuple := range update.Unique {
+		preds := make([]string, 0, len(tuple.Predicates))
+		for _, pred := range tuple.Predicates {
+			preds = append(preds, x.ParseAttr(pred))
+		}
+		x.Check2(buf.WriteString(fmt.Sprintf("@unique(%s) ", strings.Join(preds, ", "))))
+	}
+	if update.TtlPredicate != "" {
+		x.Check2(buf.WriteString(fmt.Sprintf("@ttl(%s) ", x.ParseAttr(update.TtlPredicate))))
+	}
+	x.Check2(buf.WriteString("{\n"))
 	for _, field := range update.Fields {
 		x.Check2(buf.WriteString(fieldToString(field)))
 	}
@@ -391,6 +552,9 @@ func fieldToString(update *pb.SchemaUpdate) string {
 	} else {
 		x.Check2(builder.WriteString(predicate))
 	}
+	if update.Constraint != nil {
+		x.Check2(builder.WriteString(formatFieldConstraint(update.Constraint)))
+	}
 	x.Check2(builder.WriteString("\n"))
 	return builder.String()
 }
@@ -410,7 +574,7 @@ func (writer *ExportWriter) open(fpath string) error {
 		return err
 	}
 	writer.bw = bufio.NewWriterSize(writer.fd, 1e6)
-	w, err := enc.GetWriter(x.WorkerConfig.EncryptionKey, writer.bw)
+	w, err := enc.GetWriter(EncryptionKey(), writer.bw)
 	if err != nil {
 		return err
 	}
@@ -574,12 +738,94 @@ func (r *remoteExportStorage) FinishWriting(w *Writers) (ExportedFiles, error) {
 	return files, nil
 }
 
+// blobExportStorage uses localExportStorage to write files, then uploads to Azure Blob Storage or
+// Google Cloud Storage
+type blobExportStorage struct {
+	client x.BlobClient
+	bucket string
+	prefix string // stores the path within the bucket.
+	les    *localExportStorage
+}
+
+func newBlobExportStorage(in *pb.ExportRequest, backupName string) (*blobExportStorage, error) {
+	uri, err := url.Parse(in.Destination)
+	if err != nil {
+		return nil, err
+	}
+	client, err := x.NewBlobClient(uri, &x.MinioCredentials{
+		AccessKey:    in.AccessKey,
+		SecretKey:    in.SecretKey,
+		SessionToken: in.SessionToken,
+		Anonymous:    in.Anonymous,
+	})
+	if err != nil {
+		return nil, err
+	}
+	bucket, prefix := client.ParseLocation(uri)
+	if bucket == "" {
+		return nil, errors.Errorf("No bucket or container in export destination")
+	}
+
+	tmpDir, err := os.MkdirTemp(x.WorkerConfig.TmpDir, "export")
+	if err != nil {
+		return nil, err
+	}
+	localStorage, err := newLocalExportStorage(tmpDir, backupName)
+	if err != nil {
+		return nil, err
+	}
+	return &blobExportStorage{client, bucket, prefix, localStorage}, nil
+}
+
+func (b *blobExportStorage) OpenFile(fileName string) (*ExportWriter, error) {
+	return b.les.OpenFile(fileName)
+}
+
+func (b *blobExportStorage) FinishWriting(w *Writers) (ExportedFiles, error) {
+	defer func() {
+		glog.Infof("Deleting temporary export directory %s\n", b.les.destination)
+		if err := os.RemoveAll(b.les.destination); err != nil {
+			glog.Errorf("error deleting temporary export directory: %v", err)
+		}
+	}()
+
+	files, err := b.les.FinishWriting(w)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range files {
+		d := filepath.ToSlash(f)
+		if b.prefix != "" {
+			d = b.prefix + "/" + d
+		}
+		filePath := filepath.Join(b.les.destination, f)
+		glog.Infof("Uploading from %s to %s\n", filePath, b.client.URL(b.bucket, d))
+		if err := b.uploadFile(filePath, d); err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+func (b *blobExportStorage) uploadFile(filePath, name string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return b.client.Put(context.Background(), b.bucket, name, f)
+}
+
 func NewExportStorage(in *pb.ExportRequest, backupName string) (ExportStorage, error) {
 	switch {
 	case strings.HasPrefix(in.Destination, "/"):
 		return newLocalExportStorage(in.Destination, backupName)
 	case strings.HasPrefix(in.Destination, "minio://") || strings.HasPrefix(in.Destination, "s3://"):
 		return newRemoteExportStorage(in, backupName)
+	case strings.HasPrefix(in.Destination, "azure://") || strings.HasPrefix(in.Destination, "gs://"):
+		return newBlobExportStorage(in, backupName)
 	default:
 		return newLocalExportStorage(x.WorkerConfig.ExportPath, backupName)
 	}
@@ -618,6 +864,8 @@ func ToExportKvList(pk x.ParsedKey, pl *posting.List, in *pb.ExportRequest) (*bp
 	case e.attr == "dgraph.graphql.xid":
 	case e.attr == "dgraph.drop.op":
 	case e.attr == "dgraph.graphql.p_query":
+	// The indexes of the @unique tuples of the types are rebuilt when the types are imported.
+	case schema.IsCompositeUniquePredicate(e.attr):
 
 	case pk.IsData() && e.attr == "dgraph.graphql.schema":
 		// Export the graphql schema.
@@ -888,6 +1136,9 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 			var kv *bpb.KV
 			switch prefix {
 			case x.ByteSchema:
+				if schema.IsCompositeUniquePredicate(x.ParseAttr(pk.Attr)) {
+					continue
+				}
 				kv, err = SchemaExportKv(pk.Attr, val, skipZero)
 				if err != nil {
 					// Let's not propagate this error. We just log this and continue onwards.
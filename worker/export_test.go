@@ -547,6 +547,76 @@ func TestToSchema(t *testing.T) {
 			},
 			expected: "[0x0] <data.base>:string @lang . \n",
 		},
+		{
+			skv: &skv{
+				attr: x.AttrInRootNamespace("follows"),
+				schema: pb.SchemaUpdate{
+					Predicate: x.AttrInRootNamespace(""),
+					ValueType: pb.Posting_UID,
+					List:      true,
+					Facets: []*pb.FacetSchema{
+						{Name: "since", ValueType: pb.Posting_DATETIME, Tokenizer: "day"},
+						{Name: "weight", ValueType: pb.Posting_FLOAT},
+					},
+				},
+			},
+			expected: "[0x0] <follows>:[uid] @facets(since: datetime @index(day), weight: float) . \n",
+		},
+		{
+			skv: &skv{
+				attr: x.AttrInRootNamespace("session"),
+				schema: pb.SchemaUpdate{
+					Predicate: x.AttrInRootNamespace(""),
+					ValueType: pb.Posting_STRING,
+					Ttl:       30 * 24 * 3600,
+				},
+			},
+			expected: "[0x0] <session>:string @ttl(30d) . \n",
+		},
+		{
+			skv: &skv{
+				attr: x.AttrInRootNamespace("hit"),
+				schema: pb.SchemaUpdate{
+					Predicate: x.AttrInRootNamespace(""),
+					ValueType: pb.Posting_INT,
+					Ttl:       90 * 60,
+				},
+			},
+			expected: "[0x0] <hit>:int @ttl(1h30m0s) . \n",
+		},
+		{
+			skv: &skv{
+				attr: x.AttrInRootNamespace("expiresAt"),
+				schema: pb.SchemaUpdate{
+					Predicate:    x.AttrInRootNamespace(""),
+					ValueType:    pb.Posting_DATETIME,
+					TtlFromValue: true,
+				},
+			},
+			expected: "[0x0] <expiresAt>:datetime @ttl . \n",
+		},
+		{
+			skv: &skv{
+				attr: x.NamespaceAttr(0x2, "title"),
+				schema: pb.SchemaUpdate{
+					Predicate: x.NamespaceAttr(0x2, "title"),
+					ValueType: pb.Posting_STRING,
+					Directive: pb.SchemaUpdate_INDEX,
+					Tokenizer: []string{"fulltext", "exact"},
+					Analyzers: map[string]*pb.Analyzer{"fulltext": {
+						Name:      "product_en",
+						Tokenizer: &pb.AnalyzerStage{Name: "whitespace"},
+						Filters: []*pb.AnalyzerStage{
+							{Name: "lowercase"},
+							{Name: "stop", Options: []*pb.OptionPair{{Key: "lang", Value: "en"}}},
+						},
+					}},
+				},
+			},
+			expected: "[0x2] analyzer product_en {\n\ttokenizer: whitespace\n\tfilter: lowercase\n" +
+				"\tfilter: stop(lang:\"en\")\n}\n" +
+				"[0x2] <title>:string @index(fulltext(analyzer:\"product_en\"),exact) . \n",
+		},
 	}
 	for _, testCase := range testCases {
 		kv := toSchema(testCase.skv.attr, &testCase.skv.schema)
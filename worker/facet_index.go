@@ -0,0 +1,204 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgraph/v25/algo"
+	"github.com/dgraph-io/dgraph/v25/posting"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/tok"
+	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/types/facets"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// parseFacetFn parses the arguments of facet(predicate, facet, op, value[, value2]) and finds
+// the tokens of the facet index that may satisfy the comparison.
+func parseFacetFn(ctx context.Context, q *pb.Query, fc *functionContext) error {
+	args := q.SrcFunc.Args
+	if len(args) < 3 {
+		return errors.Errorf("Function 'facet' requires the facet, the comparison and a value, "+
+			"but got %v", args)
+	}
+	facet, ok := schema.State().FacetSchema(ctx, q.Attr, args[0])
+	if !ok || facet.Tokenizer == "" {
+		return errors.Errorf("Facet %s of predicate %s is not indexed", args[0],
+			x.ParseAttr(q.Attr))
+	}
+	tokenizer, ok := tok.GetTokenizer(facet.Tokenizer)
+	if !ok {
+		return errors.Errorf("Invalid tokenizer %s for facet %s", facet.Tokenizer, facet.Name)
+	}
+
+	op := args[1]
+	switch op {
+	case eq:
+	case between:
+		if len(args) != 4 {
+			return errors.Errorf("between expects exactly 2 values for facet %s", facet.Name)
+		}
+	case "le", "lt", "ge", "gt":
+		if len(args) != 3 {
+			return errors.Errorf("%s expects only 1 value for facet %s", op, facet.Name)
+		}
+	default:
+		return errors.Errorf("Invalid comparison %s for facet %s", op, facet.Name)
+	}
+
+	fc.facet = facet
+	fc.facetOp = op
+	fc.facetLossy = tokenizer.IsLossy()
+	for _, arg := range args[2:] {
+		src := types.Val{Tid: types.StringID, Value: []byte(arg)}
+		val, err := types.Convert(src, types.TypeID(facet.ValueType))
+		if err != nil {
+			return errors.Wrapf(err, "while converting %s for facet %s", arg, facet.Name)
+		}
+		toks, err := tok.BuildFacetTokens(facet.Name, val.Value, tokenizer)
+		if err != nil {
+			return err
+		}
+		if len(toks) != 1 {
+			return errors.Errorf("Facet %s does not have a valid tokenizer", facet.Name)
+		}
+		fc.eqTokens = append(fc.eqTokens, val)
+		fc.ineqValueToken = append(fc.ineqValueToken, toks[0])
+	}
+
+	if op == eq {
+		// Each of the values gets its own row, which is filtered separately if needed.
+		fc.tokens = fc.ineqValueToken
+	} else {
+		tokens, err := facetInequalityTokens(q.ReadTs, q.Attr, facet.Name, op,
+			fc.ineqValueToken, fc.facetLossy)
+		if err != nil {
+			return err
+		}
+		fc.tokens = tokens
+	}
+	fc.n = len(fc.tokens)
+	return nil
+}
+
+// facetInequalityTokens returns the tokens in the index of the facet that satisfy the
+// inequality. The bounds are included for lossy tokenizers, their rows are filtered later.
+func facetInequalityTokens(readTs uint64, attr, facet, op string, bounds []string,
+	lossy bool) ([]string, error) {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	isgeOrGt := op == "ge" || op == "gt" || op == between
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Reverse = !isgeOrGt
+	// All the tokens of a facet start with the identifier of its tokenizer.
+	itOpt.Prefix = x.IndexKey(attr, bounds[0][:len(tok.FacetTokenPrefix(facet))+1])
+	itr := txn.NewIterator(itOpt)
+	defer itr.Close()
+
+	first := []byte(bounds[0])
+	var out []string
+	for itr.Seek(x.IndexKey(attr, bounds[0])); itr.Valid(); itr.Next() {
+		k, err := x.Parse(itr.Item().Key())
+		if err != nil {
+			return nil, err
+		}
+		term := []byte(k.Term)
+		if op == between && bytes.Compare(term, []byte(bounds[1])) > 0 {
+			break
+		}
+		switch {
+		case lossy:
+		case op == "gt" && bytes.Equal(term, first):
+			continue
+		case op == "lt" && bytes.Equal(term, first):
+			continue
+		}
+		out = append(out, k.Term)
+	}
+	return out, nil
+}
+
+// handleFacetFunction filters the rows of facet() whose tokens don't imply that the comparison is
+// satisfied, by comparing the values of the facets on the postings of the subjects.
+func (qs *queryState) handleFacetFunction(ctx context.Context, arg funcArgs) error {
+	fc := arg.srcFn
+	if !fc.facetLossy {
+		return nil
+	}
+
+	compare := func(val types.Val, row int) bool {
+		switch fc.facetOp {
+		case eq:
+			return types.CompareVals(eq, val, fc.eqTokens[row])
+		case between:
+			return types.CompareBetween(val, fc.eqTokens[0], fc.eqTokens[1])
+		default:
+			return types.CompareVals(fc.facetOp, val, fc.eqTokens[0])
+		}
+	}
+	hasFacet := func(uid uint64, row int) (bool, error) {
+		pl, err := qs.cache.Get(x.DataKey(arg.q.Attr, uid))
+		if err != nil {
+			return false, err
+		}
+		var found bool
+		err = pl.Iterate(arg.q.ReadTs, 0, func(p *pb.Posting) error {
+			for _, f := range p.Facets {
+				if f.Key != fc.facet.Name {
+					continue
+				}
+				tid, err := facets.TypeIDFor(f)
+				if err != nil {
+					continue
+				}
+				val, err := types.Convert(types.Val{Tid: tid, Value: f.Value},
+					types.TypeID(fc.facet.ValueType))
+				if err == nil && compare(val, row) {
+					found = true
+					return posting.ErrStopIteration
+				}
+			}
+			return nil
+		})
+		if err == posting.ErrStopIteration {
+			err = nil
+		}
+		return found, err
+	}
+
+	for row, token := range fc.tokens {
+		// Only the rows of the tokens of the bounds may contain subjects that don't match.
+		if fc.facetOp != eq && !x.HasString(fc.ineqValueToken, token) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		var filterErr error
+		algo.ApplyFilter(arg.out.UidMatrix[row], func(uid uint64, i int) bool {
+			if filterErr != nil {
+				return false
+			}
+			found, err := hasFacet(uid, row)
+			filterErr = err
+			return found
+		})
+		if filterErr != nil {
+			return filterErr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,331 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// The values of the predicates declared with @encrypted are stored as strings made of this
+// prefix and the base64 encoded ciphertext, whose first byte is the mode of encryption.
+const encryptedValuePrefix = "$enc1$"
+
+const (
+	// In the deterministic mode, the IV of AES-CTR is the HMAC of the value, which is checked
+	// when the value is decrypted, like in AES-SIV.
+	fieldEncDeterministic byte = 1
+	// In the random mode, the value is encrypted with AES-GCM and a random nonce.
+	fieldEncRandom byte = 2
+	fieldEncIVSize      = 16
+)
+
+type decryptGroupsKey struct{}
+
+// WithDecryptGroups returns a context in which the values of the encrypted predicates are
+// decrypted if one of the ACL groups is allowed to read them. With ACL, the values are only
+// decrypted in such contexts.
+func WithDecryptGroups(ctx context.Context, groups []string) context.Context {
+	return context.WithValue(ctx, decryptGroupsKey{}, groups)
+}
+
+// canDecrypt returns whether the context is allowed to read the values of the predicate
+// decrypted. Without ACL, everyone is. With ACL, only the users of the groups of the predicate
+// are, so nobody is if the predicate has no groups.
+func canDecrypt(ctx context.Context, enc *pb.FieldEncryption) bool {
+	if !x.WorkerConfig.AclEnabled {
+		return true
+	}
+	groups, _ := ctx.Value(decryptGroupsKey{}).([]string)
+	for _, g := range groups {
+		for _, allowed := range enc.GetGroups() {
+			if g == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type fieldCipher struct {
+	block  cipher.Block
+	macKey []byte
+}
+
+// newFieldCipher derives the encryption and the MAC keys from the named key.
+func newFieldCipher(name string) (*fieldCipher, error) {
+	key, ok := x.WorkerConfig.FieldKeys[name]
+	if !ok {
+		return nil, errors.Errorf("no field encryption key named %q, set it with "+
+			"--encryption \"field-keys=<dir>;\"", name)
+	}
+	derive := func(label string) []byte {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte("dgraph field encryption " + label))
+		return mac.Sum(nil)
+	}
+	block, err := aes.NewCipher(derive("enc"))
+	if err != nil {
+		return nil, err
+	}
+	return &fieldCipher{block: block, macKey: derive("mac")}, nil
+}
+
+func (c *fieldCipher) siv(val []byte) []byte {
+	mac := hmac.New(sha256.New, c.macKey)
+	mac.Write(val)
+	return mac.Sum(nil)[:fieldEncIVSize]
+}
+
+func (c *fieldCipher) encrypt(val []byte, deterministic bool) ([]byte, error) {
+	var out []byte
+	if deterministic {
+		iv := c.siv(val)
+		out = make([]byte, 1+fieldEncIVSize+len(val))
+		out[0] = fieldEncDeterministic
+		copy(out[1:], iv)
+		cipher.NewCTR(c.block, iv).XORKeyStream(out[1+fieldEncIVSize:], val)
+	} else {
+		gcm, err := cipher.NewGCM(c.block)
+		if err != nil {
+			return nil, err
+		}
+		nonce := make([]byte, gcm.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, err
+		}
+		out = gcm.Seal(append([]byte{fieldEncRandom}, nonce...), nonce, val, nil)
+	}
+	return []byte(encryptedValuePrefix + base64.RawStdEncoding.EncodeToString(out)), nil
+}
+
+func (c *fieldCipher) decrypt(val []byte) ([]byte, error) {
+	if !bytes.HasPrefix(val, []byte(encryptedValuePrefix)) {
+		return nil, errors.New("value is not encrypted")
+	}
+	raw, err := base64.RawStdEncoding.DecodeString(string(val[len(encryptedValuePrefix):]))
+	if err != nil || len(raw) == 0 {
+		return nil, errors.New("invalid encrypted value")
+	}
+	switch raw[0] {
+	case fieldEncDeterministic:
+		if len(raw) < 1+fieldEncIVSize {
+			return nil, errors.New("invalid encrypted value")
+		}
+		iv := raw[1 : 1+fieldEncIVSize]
+		plain := make([]byte, len(raw)-1-fieldEncIVSize)
+		cipher.NewCTR(c.block, iv).XORKeyStream(plain, raw[1+fieldEncIVSize:])
+		if !hmac.Equal(iv, c.siv(plain)) {
+			return nil, errors.New("unable to decrypt the value with the field key")
+		}
+		return plain, nil
+	case fieldEncRandom:
+		gcm, err := cipher.NewGCM(c.block)
+		if err != nil {
+			return nil, err
+		}
+		if len(raw) < 1+gcm.NonceSize() {
+			return nil, errors.New("invalid encrypted value")
+		}
+		nonce := raw[1 : 1+gcm.NonceSize()]
+		plain, err := gcm.Open(nil, nonce, raw[1+gcm.NonceSize():], nil)
+		if err != nil {
+			return nil, errors.New("unable to decrypt the value with the field key")
+		}
+		return plain, nil
+	}
+	return nil, errors.Errorf("invalid encryption mode %d of the value", raw[0])
+}
+
+// EncryptEdge encrypts the value of the edge if its predicate is encrypted. It's done before the
+// edge is proposed, so that the replicas store the same ciphertext. A value that is already
+// encrypted with the key, like the values of an export, is kept as it is.
+func EncryptEdge(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
+	enc := su.GetEncryption()
+	if enc == nil || len(edge.Value) == 0 || isDeletePredicateEdge(edge) ||
+		(types.TypeID(edge.ValueType) == types.DefaultID && isStarAll(edge.Value)) {
+		return nil
+	}
+	if edge.Op == pb.DirectedEdge_DEL && !enc.GetDeterministic() {
+		return errors.Errorf("Values of predicate %s can't be deleted one by one, as they're "+
+			"encrypted in the random mode", x.ParseAttr(edge.Attr))
+	}
+	c, err := newFieldCipher(enc.GetKey())
+	if err != nil {
+		return err
+	}
+	if _, err := c.decrypt(edge.Value); err == nil {
+		return nil
+	}
+	if edge.Value, err = c.encrypt(edge.Value, enc.GetDeterministic()); err != nil {
+		return errors.Wrapf(err, "while encrypting value of predicate %s", x.ParseAttr(edge.Attr))
+	}
+	edge.ValueType = pb.Posting_STRING
+	return nil
+}
+
+// encryptFuncArgs encrypts the arguments of the function of the query, if its predicate is
+// encrypted. Only eq is supported, on the predicates encrypted in the deterministic mode, whose
+// equal values have equal ciphertexts. The users who can't read the values decrypted were
+// already refused by CheckFuncArgs, unless the arguments are encrypted.
+func encryptFuncArgs(ctx context.Context, q *pb.Query, fnType FuncType, fname string) error {
+	su, ok := schema.State().Get(ctx, q.Attr)
+	enc := su.GetEncryption()
+	if !ok || enc == nil {
+		return nil
+	}
+	switch fnType {
+	case notAFunction, hasFn, compareScalarFn, facetFn:
+		return nil
+	case compareAttrFn:
+		if fname == eq && enc.GetDeterministic() {
+			break
+		}
+		fallthrough
+	default:
+		return errors.Errorf("Function %s isn't supported on predicate %s, which is encrypted. "+
+			"Only eq is supported, in the deterministic mode", fname, x.ParseAttr(q.Attr))
+	}
+
+	c, err := newFieldCipher(enc.GetKey())
+	if err != nil {
+		return err
+	}
+	srcFunc := proto.Clone(q.SrcFunc).(*pb.SrcFunction)
+	for i, arg := range srcFunc.Args {
+		// The arguments taken from value variables may already be encrypted.
+		if _, err := c.decrypt([]byte(arg)); err == nil {
+			continue
+		}
+		val, err := c.encrypt([]byte(arg), true)
+		if err != nil {
+			return err
+		}
+		srcFunc.Args[i] = string(val)
+	}
+	q.SrcFunc = srcFunc
+	return nil
+}
+
+// CheckFuncArgs returns an error if the function of the query compares an encrypted predicate
+// with plain values while the context isn't allowed to read the predicate decrypted, as the
+// results would reveal its values. Only the arguments that are already encrypted, like those of
+// value variables, are allowed then. It's called by the Alpha that received the query, as the
+// ACL groups of the user aren't sent with the task.
+func CheckFuncArgs(ctx context.Context, q *pb.Query) error {
+	if q.SrcFunc == nil {
+		return nil
+	}
+	su, ok := schema.State().Get(ctx, q.Attr)
+	enc := su.GetEncryption()
+	if !ok || enc == nil || canDecrypt(ctx, enc) {
+		return nil
+	}
+	if fnType, _ := parseFuncType(q.SrcFunc); fnType != compareAttrFn {
+		return nil
+	}
+	for _, arg := range q.SrcFunc.Args {
+		if !strings.HasPrefix(arg, encryptedValuePrefix) {
+			return errors.Errorf("Function %s on predicate %s isn't allowed, as the user can't "+
+				"read its values decrypted", q.SrcFunc.Name, x.ParseAttr(q.Attr))
+		}
+	}
+	return nil
+}
+
+// checkEncryptedOrder returns an error if the values are ordered by an encrypted predicate, as
+// they would be sorted by their ciphertexts.
+func checkEncryptedOrder(ctx context.Context, order []*pb.Order) error {
+	for _, o := range order {
+		if su, ok := schema.State().Get(ctx, o.Attr); ok && su.GetEncryption() != nil {
+			return errors.Errorf("Sorting isn't supported on predicate %s, which is encrypted",
+				x.ParseAttr(o.Attr))
+		}
+	}
+	return nil
+}
+
+// DecryptValues decrypts the values of the predicate in place, if it's encrypted and the
+// context is allowed to read them decrypted.
+func DecryptValues(ctx context.Context, attr string, values []*pb.ValueList) error {
+	su, ok := schema.State().Get(ctx, attr)
+	enc := su.GetEncryption()
+	if !ok || enc == nil || !canDecrypt(ctx, enc) {
+		return nil
+	}
+	c, err := newFieldCipher(enc.GetKey())
+	if err != nil {
+		return err
+	}
+	for _, list := range values {
+		for _, v := range list.GetValues() {
+			if !strings.HasPrefix(string(v.Val), encryptedValuePrefix) {
+				continue
+			}
+			plain, err := c.decrypt(v.Val)
+			if err != nil {
+				return errors.Wrapf(err, "while decrypting value of predicate %s",
+					x.ParseAttr(attr))
+			}
+			v.Val = plain
+		}
+	}
+	return nil
+}
+
+// checkEncryptionKey returns an error if the key of an encrypted predicate isn't set on this
+// Alpha.
+func checkEncryptionKey(su *pb.SchemaUpdate) error {
+	if enc := su.GetEncryption(); enc != nil {
+		if _, err := newFieldCipher(enc.GetKey()); err != nil {
+			return errors.Wrapf(err, "predicate %s", x.ParseAttr(su.Predicate))
+		}
+	}
+	return nil
+}
+
+// checkEncryption returns an error if the encryption of the schema update can't be applied on
+// this Alpha, which serves its predicate: its key isn't set, or it changes the encryption of the
+// predicate that has data at readTs.
+func checkEncryption(ctx context.Context, su *pb.SchemaUpdate, readTs uint64) error {
+	if err := checkEncryptionKey(su); err != nil {
+		return err
+	}
+	prev, ok := schema.State().Get(ctx, su.Predicate)
+	if !ok {
+		return nil
+	}
+	return checkEncryptionChange(&prev, su, readTs)
+}
+
+// checkEncryptionChange returns an error if the encryption of a predicate with data is
+// changed, as its values would no longer be readable.
+func checkEncryptionChange(prev, next *pb.SchemaUpdate, readTs uint64) error {
+	p, n := prev.GetEncryption(), next.GetEncryption()
+	if p.GetKey() == n.GetKey() && p.GetDeterministic() == n.GetDeterministic() {
+		return nil
+	}
+	if hasEdges(next.Predicate, readTs) {
+		return errors.Errorf("Schema change not allowed for the encryption of predicate %s with "+
+			"data, drop the predicate first", x.ParseAttr(next.Predicate))
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgraph/v25/posting"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+func setFieldKeys(t *testing.T) {
+	keys := x.WorkerConfig.FieldKeys
+	t.Cleanup(func() { x.WorkerConfig.FieldKeys = keys })
+	x.WorkerConfig.FieldKeys = map[string]x.Sensitive{"pii": x.Sensitive("0123456789abcdef")}
+}
+
+func TestFieldCipher(t *testing.T) {
+	setFieldKeys(t)
+	c, err := newFieldCipher("pii")
+	require.NoError(t, err)
+	_, err = newFieldCipher("missing")
+	require.ErrorContains(t, err, `no field encryption key named "missing"`)
+
+	for _, deterministic := range []bool{true, false} {
+		e1, err := c.encrypt([]byte("123-45-6789"), deterministic)
+		require.NoError(t, err)
+		e2, err := c.encrypt([]byte("123-45-6789"), deterministic)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(e1), encryptedValuePrefix))
+		require.NotContains(t, string(e1), "123-45-6789")
+		// Only the deterministic mode gives equal ciphertexts for equal values.
+		require.Equal(t, deterministic, string(e1) == string(e2))
+
+		plain, err := c.decrypt(e1)
+		require.NoError(t, err)
+		require.Equal(t, "123-45-6789", string(plain))
+
+		// A tampered value isn't decrypted.
+		tampered := []byte(string(e1[:len(e1)-2]) + "AA")
+		_, err = c.decrypt(tampered)
+		require.Error(t, err)
+	}
+	_, err = c.decrypt([]byte("123-45-6789"))
+	require.ErrorContains(t, err, "value is not encrypted")
+}
+
+func TestEncryptEdge(t *testing.T) {
+	setFieldKeys(t)
+	su := &pb.SchemaUpdate{
+		Predicate:  x.AttrInRootNamespace("ssn"),
+		ValueType:  pb.Posting_STRING,
+		Encryption: &pb.FieldEncryption{Key: "pii", Deterministic: true},
+	}
+	edge := &pb.DirectedEdge{Attr: su.Predicate, Entity: 1, Value: []byte("123-45-6789"),
+		ValueType: pb.Posting_STRING, Op: pb.DirectedEdge_SET}
+	require.NoError(t, EncryptEdge(edge, su))
+	encrypted := edge.Value
+	require.True(t, strings.HasPrefix(string(encrypted), encryptedValuePrefix))
+
+	// The values that are already encrypted, like those of an export, are kept.
+	require.NoError(t, EncryptEdge(edge, su))
+	require.Equal(t, encrypted, edge.Value)
+
+	// Deleting all the values of the predicate doesn't need encryption.
+	star := &pb.DirectedEdge{Attr: su.Predicate, Entity: 1, Value: []byte(x.Star),
+		Op: pb.DirectedEdge_DEL}
+	require.NoError(t, EncryptEdge(star, su))
+	require.Equal(t, x.Star, string(star.Value))
+
+	su.Encryption.Deterministic = false
+	del := &pb.DirectedEdge{Attr: su.Predicate, Entity: 1, Value: []byte("123-45-6789"),
+		ValueType: pb.Posting_STRING, Op: pb.DirectedEdge_DEL}
+	require.ErrorContains(t, EncryptEdge(del, su), "can't be deleted one by one")
+
+	su.Encryption.Key = "missing"
+	edge.Value = []byte("123-45-6789")
+	require.Error(t, EncryptEdge(edge, su))
+}
+
+func TestCanDecrypt(t *testing.T) {
+	defer func(enabled bool) { x.WorkerConfig.AclEnabled = enabled }(x.WorkerConfig.AclEnabled)
+	enc := &pb.FieldEncryption{Key: "pii", Groups: []string{"hr"}}
+
+	x.WorkerConfig.AclEnabled = false
+	require.True(t, canDecrypt(context.Background(), enc))
+
+	x.WorkerConfig.AclEnabled = true
+	require.False(t, canDecrypt(context.Background(), enc))
+	require.False(t, canDecrypt(WithDecryptGroups(context.Background(), []string{"dev"}), enc))
+	require.True(t, canDecrypt(WithDecryptGroups(context.Background(), []string{"dev", "hr"}), enc))
+}
+
+func TestEncryptFuncArgs(t *testing.T) {
+	setFieldKeys(t)
+	ps, err := badger.OpenManaged(badger.DefaultOptions(t.TempDir()).WithLogger(nil))
+	require.NoError(t, err)
+	defer ps.Close()
+	schema.Init(ps)
+
+	ssn, notes := x.AttrInRootNamespace("ssn"), x.AttrInRootNamespace("notes")
+	schema.State().Set(ssn, &pb.SchemaUpdate{Predicate: ssn, ValueType: pb.Posting_STRING,
+		Encryption: &pb.FieldEncryption{Key: "pii", Deterministic: true, Groups: []string{"hr"}}})
+	schema.State().Set(notes, &pb.SchemaUpdate{Predicate: notes, ValueType: pb.Posting_STRING,
+		Encryption: &pb.FieldEncryption{Key: "pii"}})
+	ctx := context.Background()
+
+	q := &pb.Query{Attr: ssn, SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{"123-45-6789"}}}
+	srcFunc := q.SrcFunc
+	require.NoError(t, encryptFuncArgs(ctx, q, compareAttrFn, "eq"))
+	require.True(t, strings.HasPrefix(q.SrcFunc.Args[0], encryptedValuePrefix))
+	// The function of the request isn't changed.
+	require.Equal(t, "123-45-6789", srcFunc.Args[0])
+
+	// The encrypted arguments, like those of value variables, are kept.
+	arg := q.SrcFunc.Args[0]
+	require.NoError(t, encryptFuncArgs(ctx, q, compareAttrFn, "eq"))
+	require.Equal(t, arg, q.SrcFunc.Args[0])
+
+	values := []*pb.ValueList{{Values: []*pb.TaskValue{{Val: []byte(arg)}}}}
+	require.NoError(t, DecryptValues(ctx, ssn, values))
+	require.Equal(t, "123-45-6789", string(values[0].Values[0].Val))
+
+	q = &pb.Query{Attr: ssn, SrcFunc: &pb.SrcFunction{Name: "le", Args: []string{"1"}}}
+	require.ErrorContains(t, encryptFuncArgs(ctx, q, compareAttrFn, "le"), "Only eq is supported")
+	q = &pb.Query{Attr: ssn, SrcFunc: &pb.SrcFunction{Name: "regexp", Args: []string{"/1/"}}}
+	require.Error(t, encryptFuncArgs(ctx, q, regexFn, "regexp"))
+	q = &pb.Query{Attr: notes, SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{"x"}}}
+	require.Error(t, encryptFuncArgs(ctx, q, compareAttrFn, "eq"))
+	require.NoError(t, encryptFuncArgs(ctx, q, hasFn, "has"))
+
+	// With ACL, the users who can't read the values decrypted can only compare them with
+	// encrypted values.
+	defer func(enabled bool) { x.WorkerConfig.AclEnabled = enabled }(x.WorkerConfig.AclEnabled)
+	x.WorkerConfig.AclEnabled = true
+	q = &pb.Query{Attr: ssn, SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{"123-45-6789"}}}
+	require.ErrorContains(t, CheckFuncArgs(ctx, q), "the user can't read its values decrypted")
+	require.NoError(t, CheckFuncArgs(WithDecryptGroups(ctx, []string{"hr"}), q))
+	q.SrcFunc.Args = []string{arg}
+	require.NoError(t, CheckFuncArgs(ctx, q))
+	q = &pb.Query{Attr: ssn, SrcFunc: &pb.SrcFunction{Name: "has"}}
+	require.NoError(t, CheckFuncArgs(ctx, q))
+
+	require.ErrorContains(t, checkEncryptedOrder(ctx, []*pb.Order{{Attr: notes}}),
+		"Sorting isn't supported on predicate notes")
+	require.NoError(t, checkEncryptedOrder(ctx, []*pb.Order{{Attr: x.AttrInRootNamespace("name")}}))
+}
+
+func TestCheckEncryption(t *testing.T) {
+	setFieldKeys(t)
+	ps, err := badger.OpenManaged(badger.DefaultOptions(t.TempDir()).WithLogger(nil))
+	require.NoError(t, err)
+	defer ps.Close()
+	defer func(db *badger.DB) { pstore = db }(pstore)
+	pstore = ps
+	schema.Init(ps)
+
+	notes := x.AttrInRootNamespace("notes")
+	encrypted := &pb.SchemaUpdate{Predicate: notes, ValueType: pb.Posting_STRING,
+		Encryption: &pb.FieldEncryption{Key: "pii"}}
+	schema.State().Set(notes, encrypted)
+	txn := ps.NewTransactionAt(5, true)
+	require.NoError(t, txn.SetEntry(badger.NewEntry(x.DataKey(notes, 1), []byte("x")).
+		WithMeta(posting.BitCompletePosting)))
+	require.NoError(t, txn.CommitAt(5, nil))
+	ctx := context.Background()
+
+	// The key must be set on the Alpha.
+	missing := &pb.SchemaUpdate{Predicate: notes, ValueType: pb.Posting_STRING,
+		Encryption: &pb.FieldEncryption{Key: "missing"}}
+	require.ErrorContains(t, checkEncryption(ctx, missing, 4), `no field encryption key named`)
+
+	// The encryption can only change while the predicate has no data.
+	plain := &pb.SchemaUpdate{Predicate: notes, ValueType: pb.Posting_STRING}
+	require.NoError(t, checkEncryption(ctx, plain, 4))
+	require.ErrorContains(t, checkEncryption(ctx, plain, 5), "drop the predicate first")
+	require.NoError(t, checkEncryption(ctx, encrypted, 5))
+}
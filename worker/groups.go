@@ -655,6 +655,26 @@ func (g *groupi) KnownGroups() (gids []uint32) {
 	return
 }
 
+// NamespaceDiskUsage returns the on-disk size of the predicates of the namespace, as last reported
+// to Zero by the group leaders.
+func NamespaceDiskUsage(ns uint64) int64 {
+	g := groups()
+	g.RLock()
+	defer g.RUnlock()
+	if g.state == nil {
+		return 0
+	}
+	var size int64
+	for _, group := range g.state.Groups {
+		for pred, tablet := range group.GetTablets() {
+			if predNs, _ := x.ParseNamespaceAttr(pred); predNs == ns {
+				size += tablet.GetOnDiskBytes()
+			}
+		}
+	}
+	return size
+}
+
 // KnownGroups returns the known groups using the global groupi instance.
 func KnownGroups() []uint32 {
 	return groups().KnownGroups()
@@ -1027,6 +1047,12 @@ func (g *groupi) processOracleDeltaStream() {
 					for gid, checksum := range more.GroupChecksums {
 						delta.GroupChecksums[gid] = checksum
 					}
+					if len(more.Times) > 0 && delta.Times == nil {
+						delta.Times = make(map[uint64]int64)
+					}
+					for ts, t := range more.Times {
+						delta.Times[ts] = t
+					}
 				default:
 					break SLURP
 				}
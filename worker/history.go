@@ -0,0 +1,322 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v25/posting"
+	"github.com/dgraph-io/dgraph/v25/x"
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+const (
+	// historyFileName is the file inside the postings directory that stores the mapping from
+	// wall-clock time to timestamps, so that AS OF queries keep working across restarts.
+	historyFileName = "history_ts"
+	// historySampleInterval is the resolution of the wall-clock to timestamp mapping.
+	historySampleInterval = 10 * time.Second
+	// historyPersistInterval is how often the mapping is written back to disk.
+	historyPersistInterval = time.Minute
+)
+
+var (
+	// ErrAsOfOutsideRetention is returned when an AS OF query asks for a point in time that is
+	// older than the history retention window.
+	ErrAsOfOutsideRetention = errors.New("as_of is outside the history retention window")
+	// ErrAsOfInFuture is returned when an AS OF query asks for a timestamp that hasn't been
+	// assigned yet.
+	ErrAsOfInFuture = errors.New("as_of is in the future")
+	// ErrHistoryDisabled is returned when an AS OF query is received but no history retention
+	// window has been configured.
+	ErrHistoryDisabled = errors.New("as_of queries need a history retention window. " +
+		`Set it via --history "retention=<duration>;"`)
+)
+
+// tsSample records the max assigned timestamp seen by this Alpha at a given wall-clock time.
+type tsSample struct {
+	at time.Time
+	ts uint64
+}
+
+// tsHistory keeps track of the history retention window and the mapping from wall-clock time to
+// timestamps which is needed to serve AS OF queries.
+type tsHistory struct {
+	sync.RWMutex
+	samples []tsSample
+	dirty   bool
+	now     func() time.Time
+}
+
+var tsHist = &tsHistory{now: time.Now}
+
+// ParseHistoryPredicates parses a comma separated list of <predicate>:<duration> pairs, as
+// provided to the predicates option of the --history flag. Durations also accept a "d" suffix
+// for days.
+func ParseHistoryPredicates(str string) (map[string]time.Duration, error) {
+	res := make(map[string]time.Duration)
+	for _, pair := range strings.Split(str, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		idx := strings.LastIndex(pair, ":")
+		if idx <= 0 || idx == len(pair)-1 {
+			return nil, errors.Errorf("invalid history retention %q, expected <predicate>:<duration>",
+				pair)
+		}
+		dur, err := parseRetention(pair[idx+1:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing history retention for %q", pair[:idx])
+		}
+		res[strings.TrimSpace(pair[:idx])] = dur
+	}
+	return res, nil
+}
+
+func parseRetention(val string) (time.Duration, error) {
+	if strings.HasSuffix(val, "d") {
+		days, err := strconv.ParseUint(strings.TrimSuffix(val, "d"), 10, 32)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	dur, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, err
+	}
+	if dur < 0 {
+		return 0, errors.Errorf("retention can't be negative: %s", val)
+	}
+	return dur, nil
+}
+
+// HistoryRetention returns the history retention window for the given predicate. Predicate
+// specific windows take precedence over the global one.
+func HistoryRetention(attr string) time.Duration {
+	if dur, ok := Config.PredicateHistory[attr]; ok {
+		return dur
+	}
+	return Config.HistoryRetention
+}
+
+// maxHistoryRetention returns the longest retention window configured on this Alpha. Badger
+// discards versions for all keys at once, so this is the window that decides what gets kept.
+func maxHistoryRetention() time.Duration {
+	max := Config.HistoryRetention
+	for _, dur := range Config.PredicateHistory {
+		if dur > max {
+			max = dur
+		}
+	}
+	return max
+}
+
+func historyEnabled() bool {
+	return maxHistoryRetention() > 0
+}
+
+// record adds a new sample if the timestamp moved since the last one.
+func (h *tsHistory) record(ts uint64) {
+	h.Lock()
+	defer h.Unlock()
+	if n := len(h.samples); n > 0 && h.samples[n-1].ts >= ts {
+		return
+	}
+	h.samples = append(h.samples, tsSample{at: h.now(), ts: ts})
+	h.dirty = true
+}
+
+// prune drops the samples which are no longer needed to serve the retention window. The newest
+// sample before the cutoff is retained, it marks the oldest timestamp we can still read at.
+func (h *tsHistory) prune(window time.Duration) {
+	h.Lock()
+	defer h.Unlock()
+	cutoff := h.now().Add(-window)
+	idx := sort.Search(len(h.samples), func(i int) bool {
+		return h.samples[i].at.After(cutoff)
+	})
+	if idx <= 1 {
+		return
+	}
+	h.samples = append(h.samples[:0], h.samples[idx-1:]...)
+	h.dirty = true
+}
+
+// tsAt returns the max assigned timestamp as of the given wall-clock time.
+func (h *tsHistory) tsAt(t time.Time) (uint64, bool) {
+	h.RLock()
+	defer h.RUnlock()
+	idx := sort.Search(len(h.samples), func(i int) bool {
+		return h.samples[i].at.After(t)
+	})
+	if idx == 0 {
+		return 0, false
+	}
+	return h.samples[idx-1].ts, true
+}
+
+// oldestTs returns the oldest timestamp whose history is still guaranteed to be kept around for
+// the given retention window. If we haven't been tracking history for long enough, this is the
+// first timestamp we have seen.
+func (h *tsHistory) oldestTs(window time.Duration) uint64 {
+	if ts, ok := h.tsAt(h.now().Add(-window)); ok {
+		return ts
+	}
+	h.RLock()
+	defer h.RUnlock()
+	if len(h.samples) == 0 {
+		return posting.Oracle().MaxAssigned()
+	}
+	return h.samples[0].ts
+}
+
+// HistoryDiscardTs returns the timestamp below which Badger is allowed to discard older versions
+// of keys. Without a retention window, this is just the snapshot's read timestamp.
+func HistoryDiscardTs(readTs uint64) uint64 {
+	if !historyEnabled() {
+		return readTs
+	}
+	return x.Min(readTs, tsHist.oldestTs(maxHistoryRetention()))
+}
+
+// HistoryTsAt converts the given wall-clock time into a timestamp which can be used to read the
+// state of the data as of that time.
+func HistoryTsAt(t time.Time) (uint64, error) {
+	if !historyEnabled() {
+		return 0, ErrHistoryDisabled
+	}
+	if t.After(tsHist.now()) {
+		return 0, ErrAsOfInFuture
+	}
+	ts, ok := tsHist.tsAt(t)
+	if !ok {
+		return 0, errors.Wrapf(ErrAsOfOutsideRetention, "no history recorded at %s",
+			t.Format(time.RFC3339))
+	}
+	return ts, nil
+}
+
+// ValidateAsOf checks that the data for all the given predicates can be read at asOfTs, i.e.
+// that asOfTs lies within the history retention window of each of them.
+func ValidateAsOf(asOfTs uint64, preds []string) error {
+	if !historyEnabled() {
+		return ErrHistoryDisabled
+	}
+	if asOfTs > posting.Oracle().MaxAssigned() {
+		return ErrAsOfInFuture
+	}
+	if len(preds) == 0 && asOfTs < tsHist.oldestTs(maxHistoryRetention()) {
+		return ErrAsOfOutsideRetention
+	}
+	for _, pred := range preds {
+		window := HistoryRetention(pred)
+		if window == 0 {
+			return errors.Wrapf(ErrAsOfOutsideRetention, "predicate %q keeps no history", pred)
+		}
+		if asOfTs < tsHist.oldestTs(window) {
+			return errors.Wrapf(ErrAsOfOutsideRetention, "predicate %q keeps history for %s",
+				pred, window)
+		}
+	}
+	return nil
+}
+
+func (h *tsHistory) load(path string) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h.Lock()
+	defer h.Unlock()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var at int64
+		var ts uint64
+		if _, err := fmt.Sscanf(scanner.Text(), "%d %d", &at, &ts); err != nil {
+			return errors.Wrapf(err, "while parsing %s", path)
+		}
+		h.samples = append(h.samples, tsSample{at: time.Unix(0, at), ts: ts})
+	}
+	return scanner.Err()
+}
+
+func (h *tsHistory) persist(path string) error {
+	h.Lock()
+	defer h.Unlock()
+	if !h.dirty {
+		return nil
+	}
+	var sb strings.Builder
+	for _, s := range h.samples {
+		fmt.Fprintf(&sb, "%d %d\n", s.at.UnixNano(), s.ts)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(sb.String()), 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	h.dirty = false
+	return nil
+}
+
+// run periodically samples the max assigned timestamp, so that wall-clock times can be mapped
+// back to timestamps, and persists the samples in the postings directory.
+func (h *tsHistory) run(closer *z.Closer) {
+	defer closer.Done()
+	if !historyEnabled() {
+		return
+	}
+
+	path := filepath.Join(Config.PostingDir, historyFileName)
+	if err := h.load(path); err != nil {
+		glog.Errorf("Unable to load history timestamps from %s: %v", path, err)
+	}
+	glog.Infof("Keeping history for %s (predicates: %v)", Config.HistoryRetention,
+		Config.PredicateHistory)
+
+	sample := time.NewTicker(historySampleInterval)
+	defer sample.Stop()
+	save := time.NewTicker(historyPersistInterval)
+	defer save.Stop()
+	for {
+		select {
+		case <-closer.HasBeenClosed():
+			if err := h.persist(path); err != nil {
+				glog.Errorf("Unable to persist history timestamps to %s: %v", path, err)
+			}
+			return
+		case <-sample.C:
+			if ts := posting.Oracle().MaxAssigned(); ts > 0 {
+				h.record(ts)
+			}
+		case <-save.C:
+			h.prune(maxHistoryRetention())
+			if err := h.persist(path); err != nil {
+				glog.Errorf("Unable to persist history timestamps to %s: %v", path, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHistoryPredicates(t *testing.T) {
+	preds, err := ParseHistoryPredicates("name:30d, email:12h,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]time.Duration{
+		"name":  30 * 24 * time.Hour,
+		"email": 12 * time.Hour,
+	}, preds)
+
+	preds, err = ParseHistoryPredicates("")
+	require.NoError(t, err)
+	require.Empty(t, preds)
+
+	_, err = ParseHistoryPredicates("name")
+	require.Error(t, err)
+	_, err = ParseHistoryPredicates("name:forever")
+	require.Error(t, err)
+	_, err = ParseHistoryPredicates("name:-1h")
+	require.Error(t, err)
+}
+
+func TestHistoryRetention(t *testing.T) {
+	defer func(c Options) { Config = c }(Config)
+	Config.HistoryRetention = time.Hour
+	Config.PredicateHistory = map[string]time.Duration{"name": 24 * time.Hour, "age": 0}
+
+	require.Equal(t, 24*time.Hour, HistoryRetention("name"))
+	require.Equal(t, time.Duration(0), HistoryRetention("age"))
+	require.Equal(t, time.Hour, HistoryRetention("email"))
+	require.Equal(t, 24*time.Hour, maxHistoryRetention())
+}
+
+func TestTsHistory(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	h := &tsHistory{now: func() time.Time { return now }}
+
+	for i := 1; i <= 10; i++ {
+		h.record(uint64(i * 10))
+		// Timestamps which didn't move are not recorded.
+		h.record(uint64(i * 10))
+		now = now.Add(time.Minute)
+	}
+	require.Len(t, h.samples, 10)
+
+	_, ok := h.tsAt(start.Add(-time.Second))
+	require.False(t, ok)
+	ts, ok := h.tsAt(start.Add(90 * time.Second))
+	require.True(t, ok)
+	require.Equal(t, uint64(20), ts)
+
+	// now is start + 10m, so a 5m window starts at the sample recorded at start + 5m.
+	require.Equal(t, uint64(60), h.oldestTs(5*time.Minute))
+	// A window longer than the recorded history can only go back to the first sample.
+	require.Equal(t, uint64(10), h.oldestTs(time.Hour))
+
+	h.prune(5 * time.Minute)
+	require.Len(t, h.samples, 5)
+	require.Equal(t, uint64(60), h.oldestTs(5*time.Minute))
+
+	path := filepath.Join(t.TempDir(), historyFileName)
+	require.NoError(t, h.persist(path))
+	loaded := &tsHistory{now: h.now}
+	require.NoError(t, loaded.load(path))
+	require.Len(t, loaded.samples, len(h.samples))
+	for i := range h.samples {
+		require.True(t, h.samples[i].at.Equal(loaded.samples[i].at))
+		require.Equal(t, h.samples[i].ts, loaded.samples[i].ts)
+	}
+}
+
+func TestHistoryDiscardTs(t *testing.T) {
+	defer func(c Options) { Config = c }(Config)
+	defer func(h *tsHistory) { tsHist = h }(tsHist)
+
+	Config.HistoryRetention = 0
+	Config.PredicateHistory = nil
+	require.Equal(t, uint64(100), HistoryDiscardTs(100))
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tsHist = &tsHistory{now: func() time.Time { return now }}
+	tsHist.record(10)
+	now = now.Add(time.Hour)
+	tsHist.record(50)
+	now = now.Add(time.Hour)
+
+	Config.HistoryRetention = 90 * time.Minute
+	require.Equal(t, uint64(10), HistoryDiscardTs(100))
+	require.Equal(t, uint64(5), HistoryDiscardTs(5))
+
+	_, err := HistoryTsAt(now.Add(time.Hour))
+	require.ErrorIs(t, err, ErrAsOfInFuture)
+	ts, err := HistoryTsAt(now.Add(-30 * time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), ts)
+}
@@ -0,0 +1,222 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/dgraph/v25/conn"
+	"github.com/dgraph-io/dgraph/v25/posting"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// IndexBuilds returns the index builds running on this Alpha.
+func (w *grpcWorker) IndexBuilds(ctx context.Context, req *pb.IndexBuildsRequest) (
+	*pb.IndexBuildsResponse, error) {
+	return &pb.IndexBuildsResponse{
+		Builds: posting.IndexBuilds.List(req.GetNamespace(), req.GetAllNamespaces()),
+	}, nil
+}
+
+// ControlIndexBuild pauses, resumes or rate limits an index build running on this Alpha. A
+// cancel is proposed to the group of this Alpha instead, to revert the build on every replica.
+func (w *grpcWorker) ControlIndexBuild(ctx context.Context, req *pb.IndexBuildControl) (
+	*pb.Status, error) {
+	if req.GetAction() == pb.IndexBuildControl_CANCEL {
+		if serves, err := groups().ServesTablet(req.GetPredicate()); err != nil {
+			return nil, err
+		} else if !serves {
+			return nil, errors.Errorf("this server doesn't serve predicate %s",
+				x.ParseAttr(req.GetPredicate()))
+		}
+		if err := proposeIndexBuildCancel(ctx, req); err != nil {
+			return nil, err
+		}
+		return &pb.Status{}, nil
+	}
+	if err := posting.IndexBuilds.Control(req); err != nil {
+		return nil, err
+	}
+	return &pb.Status{}, nil
+}
+
+// IndexBuildsOverNetwork collects the index builds from all the Alphas in the cluster. Every
+// replica of a group builds the indexes of its predicates, so a predicate is reported once per
+// replica. Alphas that can't be reached are skipped.
+func IndexBuildsOverNetwork(ctx context.Context, req *pb.IndexBuildsRequest) (
+	[]*pb.IndexBuild, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	myId := myRaftId()
+	res := posting.IndexBuilds.List(req.GetNamespace(), req.GetAllNamespaces())
+
+	type result struct {
+		builds []*pb.IndexBuild
+		err    error
+		addr   string
+	}
+	var addrs []string
+	for _, group := range groups().state.GetGroups() {
+		for _, member := range group.GetMembers() {
+			if member.GetId() != myId {
+				addrs = append(addrs, member.GetAddr())
+			}
+		}
+	}
+	ch := make(chan result, len(addrs))
+	for _, addr := range addrs {
+		go func(addr string) {
+			pool, err := conn.GetPools().Get(addr)
+			if err != nil {
+				ch <- result{err: err, addr: addr}
+				return
+			}
+			resp, err := pb.NewWorkerClient(pool.Get()).IndexBuilds(ctx, req)
+			ch <- result{builds: resp.GetBuilds(), err: err, addr: addr}
+		}(addr)
+	}
+	for range addrs {
+		r := <-ch
+		if r.err != nil {
+			glog.Warningf("Unable to get index builds from Alpha %s: %v", r.addr, r.err)
+			continue
+		}
+		res = append(res, r.builds...)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Predicate != res[j].Predicate {
+			return res[i].Predicate < res[j].Predicate
+		}
+		return res[i].Alpha < res[j].Alpha
+	})
+	return res, nil
+}
+
+// ControlIndexBuildOverNetwork applies the control to the index build of the predicate on all
+// the replicas of the group that serves it. It fails if none of them is building its indexes.
+// A cancel reverts the schema update, so it's proposed to the group by its leader, for every
+// replica to apply it in the same order as the other proposals. The other controls are sent to
+// each replica.
+func ControlIndexBuildOverNetwork(ctx context.Context, req *pb.IndexBuildControl) error {
+	gid, err := groups().BelongsToReadOnly(req.GetPredicate(), 0)
+	if err != nil {
+		return err
+	} else if gid == 0 {
+		return errors.Errorf("predicate %s isn't served by any group",
+			x.ParseAttr(req.GetPredicate()))
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	if req.GetAction() == pb.IndexBuildControl_CANCEL {
+		if groups().ServesGroup(gid) {
+			return proposeIndexBuildCancel(ctx, req)
+		}
+		pl := groups().Leader(gid)
+		if pl == nil {
+			return conn.ErrNoConnection
+		}
+		_, err := pb.NewWorkerClient(pl.Get()).ControlIndexBuild(ctx, req)
+		return err
+	}
+
+	myId := myRaftId()
+	members := groups().state.GetGroups()[gid].GetMembers()
+	ch := make(chan error, len(members))
+	for _, member := range members {
+		go func(member *pb.Member) {
+			if member.GetId() == myId {
+				ch <- posting.IndexBuilds.Control(req)
+				return
+			}
+			pool, err := conn.GetPools().Get(member.GetAddr())
+			if err != nil {
+				ch <- errors.Wrapf(err, "unable to reach Alpha %s", member.GetAddr())
+				return
+			}
+			_, err = pb.NewWorkerClient(pool.Get()).ControlIndexBuild(ctx, req)
+			ch <- errors.Wrapf(err, "on Alpha %s", member.GetAddr())
+		}(member)
+	}
+
+	var found bool
+	var firstErr error
+	for range members {
+		err := <-ch
+		switch {
+		case err == nil:
+			found = true
+		// Errors returned by other Alphas lose their identity over gRPC.
+		case strings.Contains(err.Error(), posting.ErrIndexBuildNotFound.Error()):
+		case firstErr == nil:
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	if !found {
+		return posting.ErrIndexBuildNotFound
+	}
+	return nil
+}
+
+// proposeIndexBuildCancel proposes the cancel of the index build of a predicate to the group of
+// this Alpha, with the schema of the build and the one it started from. It fails if the build is
+// done on this Alpha, the schema can be altered back then.
+func proposeIndexBuildCancel(ctx context.Context, req *pb.IndexBuildControl) error {
+	update, old, err := posting.IndexBuilds.Schemas(req.GetPredicate())
+	if err != nil {
+		return errors.Wrapf(err, "while cancelling the index build of %s",
+			x.ParseAttr(req.GetPredicate()))
+	}
+	req = proto.Clone(req).(*pb.IndexBuildControl)
+	req.Schema, req.Revert = update, old
+	return groups().Node.proposeAndWait(ctx, &pb.Proposal{IndexBuildControl: req})
+}
+
+// applyIndexBuildCancel cancels the index build of a predicate on this replica and reverts its
+// schema update. The revert is a schema update from the schema of the build to the one it
+// started from, whether the build is done on this replica or not. So every replica drops the
+// indexes that the build added and rebuilds the ones it dropped, and ends up in the same state.
+func (n *node) applyIndexBuildCancel(ctx context.Context, req *pb.IndexBuildControl) error {
+	attr := req.GetPredicate()
+	if req.GetSchema() == nil || req.GetRevert() == nil {
+		return errors.Errorf("cancel of the index build of %s has no schema to revert",
+			x.ParseAttr(attr))
+	}
+	switch err := posting.IndexBuilds.Control(req); {
+	case err == posting.ErrIndexBuildNotFound:
+		glog.Infof("Index build of %s is done on this Alpha, reverting it", attr)
+	case err != nil:
+		return err
+	}
+	if err := detectPendingTxns(attr); err != nil {
+		return err
+	}
+
+	// A cancelled build reloads the schema it started from once it stops. Wait for it before
+	// setting the schema of the build, which the revert is computed from.
+	n.waitForTask(opIndexing)
+	schema.State().Set(attr, req.GetSchema())
+	if err := runSchemaMutation(ctx, []*pb.SchemaUpdate{req.GetRevert()},
+		posting.Oracle().MaxAssigned()); err != nil {
+		return err
+	}
+	posting.ResetCache()
+	return nil
+}
@@ -0,0 +1,265 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/v25/conn"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// EncryptionKeySource reads the encryption key again from the key file or Vault the Alpha was
+// started with. It's used to rotate the key when no key file is given.
+var EncryptionKeySource func() (x.Sensitive, error)
+
+var keyRotationHooks struct {
+	sync.Mutex
+	fns []func(old, new x.Sensitive)
+}
+
+// OnKeyRotation registers a function to call after the encryption key is rotated.
+func OnKeyRotation(fn func(old, new x.Sensitive)) {
+	keyRotationHooks.Lock()
+	defer keyRotationHooks.Unlock()
+	keyRotationHooks.fns = append(keyRotationHooks.fns, fn)
+}
+
+// EncryptionKey returns the current version of the encryption key, which the backups, exports,
+// WAL archives and audit logs are encrypted with. It's the key the Alpha was started with until
+// the key is rotated, and nil if encryption at rest is not enabled.
+func EncryptionKey() x.Sensitive {
+	if State.Keyring == nil {
+		return x.WorkerConfig.EncryptionKey
+	}
+	return State.Keyring.CurrentKey()
+}
+
+// RotateEncryptionKey rotates the encryption key of this Alpha.
+func (w *grpcWorker) RotateEncryptionKey(ctx context.Context, req *pb.RotateKeyRequest) (
+	*pb.RotateKeyResponse, error) {
+	return rotateEncryptionKey(req)
+}
+
+// rotateEncryptionKey makes the new key the current version of the keyring. From then on,
+// backups, exports, WAL archives and audit logs are encrypted with it, and so is the storage key
+// of the posting store and the Raft WAL, while they stay open.
+func rotateEncryptionKey(req *pb.RotateKeyRequest) (*pb.RotateKeyResponse, error) {
+	if State.Keyring == nil {
+		return nil, errors.New("encryption at rest is not enabled on this Alpha")
+	}
+	if req.GetVersionsOnly() {
+		return keyRotationResponse(State.Keyring.Current()), nil
+	}
+
+	var key x.Sensitive
+	var err error
+	switch {
+	case req.GetKeyFile() != "":
+		if key, err = os.ReadFile(req.GetKeyFile()); err != nil {
+			return nil, errors.Wrapf(err, "while reading the encryption key from %s",
+				req.GetKeyFile())
+		}
+	case EncryptionKeySource != nil:
+		if key, err = EncryptionKeySource(); err != nil {
+			return nil, errors.Wrap(err, "while reading the encryption key")
+		}
+	default:
+		return nil, errors.New("no key file given to rotate the encryption key to")
+	}
+	if req.GetDryRun() {
+		if err := State.Keyring.CheckRotate(key); err != nil {
+			return nil, err
+		}
+		return keyRotationResponse(State.Keyring.Current()), nil
+	}
+
+	keyRotationHooks.Lock()
+	defer keyRotationHooks.Unlock()
+	old := State.Keyring.CurrentKey()
+	prev := State.Keyring.Current()
+	cur, err := State.Keyring.Rotate(key)
+	if err != nil {
+		return nil, err
+	}
+	if cur.Version != prev.Version {
+		for _, fn := range keyRotationHooks.fns {
+			fn(old, key)
+		}
+		glog.Infof("Rotated the encryption key from version %d to version %d (fingerprint %s).",
+			prev.Version, cur.Version, cur.Fingerprint)
+	}
+	return keyRotationResponse(cur), nil
+}
+
+func keyRotationResponse(cur x.KeyVersion) *pb.RotateKeyResponse {
+	resp := &pb.RotateKeyResponse{
+		Alpha:   x.WorkerConfig.MyAddr,
+		Current: keyVersionProto(cur),
+	}
+	for _, v := range State.Keyring.Versions() {
+		resp.Versions = append(resp.Versions, keyVersionProto(v))
+	}
+	return resp
+}
+
+func keyVersionProto(v x.KeyVersion) *pb.KeyVersion {
+	return &pb.KeyVersion{
+		Version:     v.Version,
+		Fingerprint: v.Fingerprint,
+		CreatedAt:   v.CreatedAt,
+		RetiredAt:   v.RetiredAt,
+	}
+}
+
+// RotateEncryptionKeyOverNetwork rotates the encryption key of all the Alphas in the cluster.
+// Each Alpha reads the key itself, so the key never goes over the network. The key is only
+// rotated once every Alpha checked that it can read the new key and rotate to it, so that an
+// Alpha that is down or misconfigured doesn't leave the cluster with different keys. As rotating
+// to the current key changes nothing, the operation can be retried if some Alphas still failed.
+func RotateEncryptionKeyOverNetwork(ctx context.Context, req *pb.RotateKeyRequest) (
+	[]*pb.RotateKeyResponse, error) {
+
+	check := &pb.RotateKeyRequest{KeyFile: req.GetKeyFile(), DryRun: true}
+	if _, failed := rotateKeyOverNetwork(ctx, check); len(failed) > 0 {
+		return nil, errors.Errorf("the encryption key can't be rotated on every Alpha, it "+
+			"wasn't rotated: %s", strings.Join(failed, "; "))
+	}
+	res, failed := rotateKeyOverNetwork(ctx, req)
+	if len(failed) > 0 {
+		return res, errors.Errorf("the encryption key wasn't rotated on every Alpha, "+
+			"retry once they're available: %s", strings.Join(failed, "; "))
+	}
+	return res, nil
+}
+
+// checkKeyVersions returns an error if the Alphas don't encrypt with the same version of the
+// encryption key, which happens while the key is rotated or if its rotation failed on some
+// Alphas. The Alphas that can't be reached are skipped.
+func checkKeyVersions(ctx context.Context) error {
+	if State.Keyring == nil {
+		return nil
+	}
+	res, failed := rotateKeyOverNetwork(ctx, &pb.RotateKeyRequest{VersionsOnly: true})
+	if len(failed) > 0 {
+		glog.Warningf("Unable to check the encryption key version of some Alphas: %s",
+			strings.Join(failed, "; "))
+	}
+	for _, r := range res {
+		if r.GetCurrent().GetFingerprint() != res[0].GetCurrent().GetFingerprint() {
+			return errors.Errorf("Alpha %s uses version %d of the encryption key, but Alpha %s "+
+				"uses version %d. Rotate the key on every Alpha first",
+				res[0].Alpha, res[0].GetCurrent().GetVersion(), r.Alpha,
+				r.GetCurrent().GetVersion())
+		}
+	}
+	return nil
+}
+
+// rotateKeyOverNetwork sends the request to this Alpha and to the other Alphas of the cluster,
+// and returns the responses sorted by Alpha, and the errors of the Alphas that failed.
+func rotateKeyOverNetwork(ctx context.Context, req *pb.RotateKeyRequest) (
+	[]*pb.RotateKeyResponse, []string) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	type result struct {
+		resp *pb.RotateKeyResponse
+		err  error
+		addr string
+	}
+	myId := myRaftId()
+	var addrs []string
+	for _, group := range groups().state.GetGroups() {
+		for _, member := range group.GetMembers() {
+			if member.GetId() != myId {
+				addrs = append(addrs, member.GetAddr())
+			}
+		}
+	}
+	ch := make(chan result, len(addrs))
+	for _, addr := range addrs {
+		go func(addr string) {
+			pool, err := conn.GetPools().Get(addr)
+			if err != nil {
+				ch <- result{err: err, addr: addr}
+				return
+			}
+			resp, err := pb.NewWorkerClient(pool.Get()).RotateEncryptionKey(ctx, req)
+			ch <- result{resp: resp, err: err, addr: addr}
+		}(addr)
+	}
+
+	var res []*pb.RotateKeyResponse
+	var failed []string
+	resp, err := rotateEncryptionKey(req)
+	if err != nil {
+		failed = append(failed, x.WorkerConfig.MyAddr+": "+err.Error())
+	} else {
+		res = append(res, resp)
+	}
+	for range addrs {
+		r := <-ch
+		if r.err != nil {
+			glog.Errorf("Unable to get the encryption key of Alpha %s: %v", r.addr, r.err)
+			failed = append(failed, r.addr+": "+r.err.Error())
+			continue
+		}
+		res = append(res, r.resp)
+	}
+	sort.Strings(failed)
+	sort.Slice(res, func(i, j int) bool { return res[i].Alpha < res[j].Alpha })
+	return res, failed
+}
+
+// manifestKey returns the key to decrypt the files of the backup with: the given key, or else
+// the version of the key the backup was encrypted with in the keyring of this Alpha. The
+// backups taken before the keys had versions are decrypted with the given key.
+func manifestKey(key x.Sensitive, m *Manifest) (x.Sensitive, error) {
+	if !m.Encrypted || m.KeyFingerprint == "" {
+		return key, nil
+	}
+	if key != nil && x.KeyFingerprint(key) == m.KeyFingerprint {
+		return key, nil
+	}
+	if State.Keyring != nil {
+		if k, _, err := State.Keyring.Key(m.KeyFingerprint); err == nil {
+			return k, nil
+		}
+	}
+	return nil, errors.Errorf("backup %s was encrypted with version %d of the encryption key "+
+		"(fingerprint %s), which is neither the given key nor a version in the keyring",
+		m.Path, m.KeyVersion, m.KeyFingerprint)
+}
+
+// archiveKeys returns the keys the WAL archive may have been encrypted with: the given key,
+// followed by the versions of the key in the keyring of this Alpha, newest first.
+func archiveKeys(key x.Sensitive) []x.Sensitive {
+	keys := []x.Sensitive{key}
+	if key == nil || State.Keyring == nil {
+		return keys
+	}
+	versions := State.Keyring.Versions()
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].Fingerprint == x.KeyFingerprint(key) {
+			continue
+		}
+		if k, _, err := State.Keyring.Key(versions[i].Fingerprint); err == nil {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+func TestRotatedKeys(t *testing.T) {
+	k1, k2 := x.Sensitive("0123456789abcdef"), x.Sensitive("fedcba9876543210")
+	kr, err := x.OpenKeyring(t.TempDir(), k1)
+	require.NoError(t, err)
+	defer func(kr *x.Keyring) { State.Keyring = kr }(State.Keyring)
+	State.Keyring = kr
+
+	dir := t.TempDir()
+	h, _ := testFileHandlerForDir(t, dir)
+	require.NoError(t, h.CreateDir(walArchiveDir))
+	segment := func(key x.Sensitive, ts uint64) {
+		require.NoError(t, writeArchiveSegment(h, 1, []*pb.ArchivedTxn{{
+			CommitTs:  ts,
+			Mutations: &pb.Mutations{StartTs: ts - 1, Edges: []*pb.DirectedEdge{walEdge(ts, "name")}},
+		}}, key))
+	}
+	segment(k1, 5)
+	_, err = kr.Rotate(k2)
+	require.NoError(t, err)
+	segment(k2, 7)
+
+	// The segments written before the rotation are read with the retired key.
+	txns, err := readArchivedTxns(h, 1, 0, 10, k2)
+	require.NoError(t, err)
+	require.Len(t, txns, 2)
+
+	m := &Manifest{ManifestBase: ManifestBase{Path: "dgraph.1", Encrypted: true, KeyVersion: 1,
+		KeyFingerprint: x.KeyFingerprint(k1)}}
+	key, err := manifestKey(k2, m)
+	require.NoError(t, err)
+	require.Equal(t, k1, key)
+
+	// Without the keyring, the backup needs its own key.
+	State.Keyring = nil
+	_, err = manifestKey(k2, m)
+	require.ErrorContains(t, err, "encrypted with version 1 of the encryption key")
+	key, err = manifestKey(k1, m)
+	require.NoError(t, err)
+	require.Equal(t, k1, key)
+
+	// The backups taken before the keys had versions use the given key.
+	key, err = manifestKey(k2, &Manifest{ManifestBase: ManifestBase{Encrypted: true}})
+	require.NoError(t, err)
+	require.Equal(t, k2, key)
+}
+
+func TestRotateEncryptionKey(t *testing.T) {
+	k1, k2 := x.Sensitive("0123456789abcdef"), x.Sensitive("fedcba9876543210")
+	defer func(kr *x.Keyring) { State.Keyring = kr }(State.Keyring)
+	State.Keyring = nil
+	_, err := rotateEncryptionKey(&pb.RotateKeyRequest{VersionsOnly: true})
+	require.ErrorContains(t, err, "encryption at rest is not enabled")
+
+	kr, err := x.OpenKeyring(t.TempDir(), k1)
+	require.NoError(t, err)
+	State.Keyring = kr
+	keyFile := filepath.Join(t.TempDir(), "enc_key")
+	require.NoError(t, os.WriteFile(keyFile, k2, 0600))
+
+	// A dry run only checks that the key can be read and rotated to.
+	resp, err := rotateEncryptionKey(&pb.RotateKeyRequest{KeyFile: keyFile, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Current.Version)
+	require.Equal(t, k1, EncryptionKey())
+	_, err = rotateEncryptionKey(&pb.RotateKeyRequest{KeyFile: keyFile + ".missing",
+		DryRun: true})
+	require.ErrorContains(t, err, "while reading the encryption key")
+
+	resp, err = rotateEncryptionKey(&pb.RotateKeyRequest{KeyFile: keyFile})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Current.Version)
+	require.Len(t, resp.Versions, 2)
+	require.Equal(t, k2, EncryptionKey())
+
+	resp, err = rotateEncryptionKey(&pb.RotateKeyRequest{VersionsOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, x.KeyFingerprint(k2), resp.Current.Fingerprint)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/dgraph-io/dgraph/v25/schema"
 	"github.com/dgraph-io/dgraph/v25/tok/hnsw"
 	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/types/facets"
 	"github.com/dgraph-io/dgraph/v25/x"
 	"github.com/dgraph-io/ristretto/v2/z"
 )
@@ -70,6 +71,9 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	if isDeletePredicateEdge(edge) {
 		return errors.New("We should never reach here")
 	}
+	if edge.Op == pb.DirectedEdge_EXPIRE {
+		return runExpireMutation(ctx, edge, &su, txn)
+	}
 
 	// Once mutation comes via raft we do best effort conversion
 	// Type check is done before proposing mutation, in case schema is not
@@ -118,6 +122,47 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	return plist.AddMutationWithIndex(ctx, edge, txn)
 }
 
+// runExpireMutation sets the expiry time of the edge on all the values of the predicate of the
+// node, by setting them again along with their facets. The values that have their own TTL keep
+// it, if they expire earlier.
+func runExpireMutation(ctx context.Context, edge *pb.DirectedEdge, su *pb.SchemaUpdate,
+	txn *posting.Txn) error {
+	plist, err := txn.Get(x.DataKey(edge.Attr, edge.Entity))
+	if err != nil {
+		return err
+	}
+	var edges []*pb.DirectedEdge
+	err = plist.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
+		e := &pb.DirectedEdge{
+			Entity:    edge.Entity,
+			Attr:      edge.Attr,
+			Value:     p.Value,
+			ValueType: p.ValType,
+			Lang:      string(p.LangTag),
+			Facets:    p.Facets,
+			Namespace: edge.Namespace,
+			ExpiresAt: edge.ExpiresAt,
+		}
+		if p.PostingType == pb.Posting_REF {
+			e.ValueId, e.Value, e.ValueType = p.Uid, nil, pb.Posting_UID
+		}
+		if hasTTL(su) && p.ExpiresAt != 0 && (e.ExpiresAt == 0 || p.ExpiresAt < e.ExpiresAt) {
+			e.ExpiresAt = p.ExpiresAt
+		}
+		edges = append(edges, e)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, e := range edges {
+		if err := runMutation(ctx, e, txn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func undoSchemaUpdate(predicate string) {
 	maxRetries := 10
 	loadErr := x.RetryUntilSuccess(maxRetries, 10*time.Millisecond, func() error {
@@ -502,11 +547,66 @@ func validateSchemaForUnique(prevSchema pb.SchemaUpdate, currentSchema *pb.Schem
 	return errors.Errorf("@unique directive not supported on [%v] type predicate", currentSchema.ValueType.String())
 }
 
+// convertFacets converts the facets of the edge to the types declared in the schema. Facets that
+// aren't declared in the schema are left as they are.
+func convertFacets(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
+	if len(su.GetFacets()) == 0 {
+		return nil
+	}
+	for i, f := range edge.Facets {
+		for _, fs := range su.Facets {
+			if f.Key != fs.Name {
+				continue
+			}
+			converted, err := facets.ConvertTo(f, types.TypeID(fs.ValueType))
+			if err != nil {
+				return errors.Wrapf(err, "invalid value for facet %s of predicate %s",
+					f.Key, x.ParseAttr(edge.Attr))
+			}
+			edge.Facets[i] = converted
+			break
+		}
+	}
+	return nil
+}
+
+// setExpiry stamps the edge with the time it expires at, if its predicate has a TTL. It's called
+// before the mutation is proposed, so that all the replicas store the same expiry time.
+func setExpiry(edge *pb.DirectedEdge, su *pb.SchemaUpdate, now time.Time) error {
+	if edge.Op == pb.DirectedEdge_DEL || edge.Op == pb.DirectedEdge_EXPIRE {
+		return nil
+	}
+	// The expiry time of the node, if its type has a TTL, is kept if it's earlier.
+	nodeExpiry := edge.ExpiresAt
+	defer func() {
+		if nodeExpiry != 0 && (edge.ExpiresAt == 0 || nodeExpiry < edge.ExpiresAt) {
+			edge.ExpiresAt = nodeExpiry
+		}
+	}()
+	switch {
+	case su.GetTtlFromValue():
+		val, err := types.Convert(types.Val{Tid: types.TypeID(edge.ValueType), Value: edge.Value},
+			types.DateTimeID)
+		if err != nil {
+			return errors.Wrapf(err, "while reading the expiry time of predicate %s",
+				x.ParseAttr(edge.Attr))
+		}
+		edge.ExpiresAt = val.Value.(time.Time).Unix()
+	case su.GetTtl() > 0:
+		edge.ExpiresAt = now.Unix() + su.Ttl
+	}
+	return nil
+}
+
+func hasTTL(su *pb.SchemaUpdate) bool {
+	return su.GetTtl() > 0 || su.GetTtlFromValue()
+}
+
 // ValidateAndConvert checks compatibility or converts to the schema type if the storage type is
 // specified. If no storage type is specified then it converts to the schema type.
 func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 
-	if isDeletePredicateEdge(edge) {
+	if isDeletePredicateEdge(edge) || edge.Op == pb.DirectedEdge_EXPIRE {
 		return nil
 	}
 	if types.TypeID(edge.ValueType) == types.DefaultID && isStarAll(edge.Value) {
@@ -516,6 +616,9 @@ func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 	if strings.Contains(su.Predicate, hnsw.VecKeyword) {
 		return errors.Errorf("Not allowed to insert mutations in vector index keys, edge: [%v]", edge)
 	}
+	if err := convertFacets(edge, su); err != nil {
+		return err
+	}
 
 	storageType := posting.TypeID(edge)
 	schemaType := types.TypeID(su.ValueType)
@@ -10,10 +10,12 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/dgraph-io/dgraph/v25/posting"
 	"github.com/dgraph-io/dgraph/v25/protos/pb"
 	"github.com/dgraph-io/dgraph/v25/schema"
@@ -118,6 +120,55 @@ func TestReverseEdgeSetDel(t *testing.T) {
 	require.Equal(t, 2, c)
 }
 
+func TestExpireEdge(t *testing.T) {
+	dir, err := os.MkdirTemp("", "storetest_")
+	x.Check(err)
+	defer os.RemoveAll(dir)
+
+	opt := badger.DefaultOptions(dir)
+	ps, err := badger.OpenManaged(opt)
+	x.Check(err)
+	pstore = ps
+	// Not using posting list cache
+	posting.Init(ps, 0, false)
+	Init(ps)
+	err = schema.ParseBytes([]byte("link: [uid] @reverse ."), 1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	txn := posting.Oracle().RegisterStartTs(5)
+	attr := x.AttrInRootNamespace("link")
+	facet := &api.Facet{Key: "weight", Value: []byte("1"), ValType: api.Facet_STRING}
+	x.Check(runMutation(ctx, &pb.DirectedEdge{ValueId: 2, Attr: attr, Entity: 1,
+		Facets: []*api.Facet{facet}}, txn))
+	x.Check(runMutation(ctx, &pb.DirectedEdge{ValueId: 3, Attr: attr, Entity: 1}, txn))
+
+	// The values are stamped with the expiry time, and keep their facets.
+	expiresAt := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	x.Check(runMutation(ctx, &pb.DirectedEdge{Attr: attr, Entity: 1,
+		Op: pb.DirectedEdge_EXPIRE, ExpiresAt: expiresAt}, txn))
+	pl, err := txn.Get(x.DataKey(attr, 1))
+	require.NoError(t, err)
+	found, p, err := pl.FindPosting(5, 2)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, expiresAt, p.ExpiresAt)
+	require.Equal(t, []*api.Facet{facet}, p.Facets)
+	rev, err := txn.Get(x.ReverseKey(attr, 3))
+	require.NoError(t, err)
+	found, p, err = rev.FindPosting(5, 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, expiresAt, p.ExpiresAt)
+
+	// The values expire once their expiry time is past.
+	x.Check(runMutation(ctx, &pb.DirectedEdge{Attr: attr, Entity: 1,
+		Op: pb.DirectedEdge_EXPIRE, ExpiresAt: 1000}, txn))
+	found, _, err = pl.FindPosting(5, 2)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
 func TestConvertEdgeType(t *testing.T) {
 	var testEdges = []struct {
 		input     *pb.DirectedEdge
@@ -199,6 +250,43 @@ func TestValidateEdgeTypeError(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSetExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	edge := &pb.DirectedEdge{
+		Value: []byte("abc"),
+		Attr:  x.AttrInRootNamespace("session"),
+	}
+	require.NoError(t, setExpiry(edge, &pb.SchemaUpdate{Ttl: 3600}, now))
+	require.Equal(t, now.Unix()+3600, edge.ExpiresAt)
+
+	// Deletions don't expire.
+	edge = &pb.DirectedEdge{Attr: x.AttrInRootNamespace("session"), Op: pb.DirectedEdge_DEL}
+	require.NoError(t, setExpiry(edge, &pb.SchemaUpdate{Ttl: 3600}, now))
+	require.Zero(t, edge.ExpiresAt)
+
+	// The datetime values of the predicate are their own expiry time.
+	su := &pb.SchemaUpdate{ValueType: pb.Posting_DATETIME, TtlFromValue: true}
+	edge = &pb.DirectedEdge{
+		Value: []byte("2026-02-01T00:00:00Z"),
+		Attr:  x.AttrInRootNamespace("expiresAt"),
+	}
+	require.NoError(t, ValidateAndConvert(edge, su))
+	require.NoError(t, setExpiry(edge, su, now))
+	require.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC).Unix(), edge.ExpiresAt)
+
+	// The expiry time of the node is kept if it's earlier than the one of the predicate.
+	edge = &pb.DirectedEdge{
+		Value:     []byte("abc"),
+		Attr:      x.AttrInRootNamespace("session"),
+		ExpiresAt: now.Unix() + 60,
+	}
+	require.NoError(t, setExpiry(edge, &pb.SchemaUpdate{Ttl: 3600}, now))
+	require.Equal(t, now.Unix()+60, edge.ExpiresAt)
+	edge.ExpiresAt = now.Unix() + 7200
+	require.NoError(t, setExpiry(edge, &pb.SchemaUpdate{Ttl: 3600}, now))
+	require.Equal(t, now.Unix()+3600, edge.ExpiresAt)
+}
+
 func TestTypeSanityCheck(t *testing.T) {
 	// Empty field name check.
 	typeDef := &pb.TypeUpdate{
@@ -102,12 +102,6 @@ func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest, wg *sync
 		SessionToken: req.SessionToken,
 		Anonymous:    req.Anonymous,
 	}
-	if err := VerifyBackup(req, &creds, currentGroups); err != nil {
-		return errors.Wrapf(err, "failed to verify backup")
-	}
-	if err := FillRestoreCredentials(req.Location, req); err != nil {
-		return errors.Wrapf(err, "cannot fill restore proposal with the right credentials")
-	}
 
 	// This check if any restore operation running on the node.
 	// Operation initiated on other nodes doesn't have record in the record tracker.
@@ -130,7 +124,27 @@ func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest, wg *sync
 			"Please retry later.")
 	}
 
+	// A selective restore only restores some predicates into the running cluster, whose
+	// groups don't need to match the ones of the backup.
+	if req.Selective != nil {
+		return processSelectiveRestore(ctx, req, &creds, memState, wg)
+	}
+	if err := resolveRestoreToTs(req, currentGroups); err != nil {
+		return errors.Wrapf(err, "invalid point-in-time restore")
+	}
+	if err := VerifyBackup(req, &creds, currentGroups); err != nil {
+		return errors.Wrapf(err, "failed to verify backup")
+	}
+	if err := FillRestoreCredentials(req.Location, req); err != nil {
+		return errors.Wrapf(err, "cannot fill restore proposal with the right credentials")
+	}
+
 	req.RestoreTs = State.GetTimestamp(false)
+	// The archived commits are replayed after the restore, at timestamps leased after RestoreTs.
+	replayTs, err := leaseReplayTs(ctx, req, currentGroups)
+	if err != nil {
+		return errors.Wrapf(err, "cannot prepare the replay of the archived commits")
+	}
 
 	// TODO: prevent partial restores when proposeRestoreOrSend only sends the restore
 	// request to a subset of groups.
@@ -138,6 +152,7 @@ func ProcessRestoreRequest(ctx context.Context, req *pb.RestoreRequest, wg *sync
 	for _, gid := range currentGroups {
 		reqCopy := proto.Clone(req).(*pb.RestoreRequest)
 		reqCopy.GroupId = gid
+		reqCopy.ReplayTs = replayTs[gid]
 		wg.Add(1)
 		go func() {
 			errCh <- proposeRestoreOrSend(ctx, reqCopy)
@@ -210,6 +225,9 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest, pidx uin
 	if req.IncrementalFrom == 1 {
 		req.IncrementalFrom = 0
 	}
+	if req.Selective != nil {
+		return handleSelectiveRestore(ctx, req, pidx)
+	}
 
 	// Clean up the cluster if it is a full backup restore.
 	if req.IncrementalFrom == 0 {
@@ -427,6 +445,21 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest, pidx uin
 	groups().applyInitialSchema()
 	groups().applyInitialTypes()
 
+	// Replay the archived commits of a point-in-time restore on top of the restored backups.
+	if req.RestoreToTs > 0 {
+		key, err := getRestoreEncKey(req)
+		if err != nil {
+			return err
+		}
+		replayRes, err := replayArchivedTxns(ctx, req, handler, lastManifest.ValidReadTs(), key)
+		if err != nil {
+			return errors.Wrap(err, "cannot replay archived commits")
+		}
+		if err := bumpLease(ctx, replayRes); err != nil {
+			return errors.Wrap(err, "While bumping the leases after replaying archived commits")
+		}
+	}
+
 	// Reset gql schema only when the restore is not partial, so that after this restore
 	// the cluster can be in non-draining mode and hence gqlSchema can be lazy loaded.
 	glog.Info("reseting local gql schema store")
@@ -434,8 +467,18 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest, pidx uin
 		ResetGQLSchemaStore()
 	}
 
-	// Propose a snapshot immediately after all the work is done to prevent the restore
-	// from being replayed.
+	proposeSnapshotAfterRestore(pidx)
+
+	// Update the membership state to re-compute the group checksums.
+	if err := UpdateMembershipState(ctx); err != nil {
+		return errors.Wrapf(err, "cannot update membership state after restore")
+	}
+	return nil
+}
+
+// proposeSnapshotAfterRestore proposes a snapshot once the restore proposal at the given index
+// is applied, so that the restore isn't replayed.
+func proposeSnapshotAfterRestore(pidx uint64) {
 	go func(idx uint64) {
 		n := groups().Node
 		if !n.AmLeader() {
@@ -451,12 +494,6 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest, pidx uin
 			glog.Errorf("cannot propose snapshot after processing restore proposal %+v", err)
 		}
 	}(pidx)
-
-	// Update the membership state to re-compute the group checksums.
-	if err := UpdateMembershipState(ctx); err != nil {
-		return errors.Wrapf(err, "cannot update membership state after restore")
-	}
-	return nil
 }
 
 func bumpLease(ctx context.Context, mr *mapResult) error {
@@ -50,6 +50,10 @@ func populateKeyValues(ctx context.Context, kvs []*bpb.KV) error {
 	if err := writer.Flush(); err != nil {
 		return err
 	}
+	// The keys may be cached already, when they repair inconsistent keys.
+	for _, kv := range kvs {
+		posting.RemoveCacheFor(kv.Key)
+	}
 	pk, err := x.Parse(kvs[0].Key)
 	if err != nil {
 		return errors.Errorf("while parsing KV: %+v, got error: %v", kvs[0], err)
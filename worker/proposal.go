@@ -164,6 +164,7 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 	// be persisted, we do best effort schema check while writing
 	ctx = schema.GetWriteContext(ctx)
 	if proposal.Mutations != nil {
+		now := time.Now()
 		for _, edge := range proposal.Mutations.Edges {
 			if err := checkTablet(edge.Attr); err != nil {
 				return err
@@ -183,6 +184,10 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 				continue
 			} else if err := ValidateAndConvert(edge, &su); err != nil {
 				return err
+			} else if err := setExpiry(edge, &su, now); err != nil {
+				return err
+			} else if err := EncryptEdge(edge, &su); err != nil {
+				return err
 			}
 		}
 
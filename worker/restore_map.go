@@ -111,6 +111,10 @@ type loadBackupInput struct {
 
 	fromNamespace           uint64
 	isNamespaceAwareRestore bool
+
+	// renames maps the predicates of a selective restore to the predicates they're restored
+	// as. It's nil for the other restores.
+	renames map[string]string
 }
 
 type listReq struct {
@@ -346,6 +350,11 @@ func (m *mapper) processReqCh(ctx context.Context) error {
 		if !in.keepSchema && (parsedKey.IsSchema() || parsedKey.IsType()) {
 			return nil
 		}
+		// The types of a selective restore are updated like any other type, once their
+		// predicates are restored.
+		if in.renames != nil && parsedKey.IsType() {
+			return nil
+		}
 		if _, ok := in.preds[parsedKey.Attr]; !parsedKey.IsType() && !ok {
 			return nil
 		}
@@ -358,6 +367,11 @@ func (m *mapper) processReqCh(ctx context.Context) error {
 			l := parsedKey.ToBackupKey()
 			restoreKey = x.FromBackupKey(l)
 		}
+		if in.renames != nil {
+			// i.e 1-name => 2-restored_name
+			parsedKey.Attr = in.renames[parsedKey.Attr]
+			restoreKey = x.FromBackupKey(parsedKey.ToBackupKey())
+		}
 
 		switch kv.GetUserMeta()[0] {
 		case posting.BitEmptyPosting, posting.BitCompletePosting, posting.BitDeltaPosting:
@@ -551,6 +565,9 @@ func (m *mapper) processReqCh(ctx context.Context) error {
 				if strings.HasSuffix(update.Predicate, "dgraph.xid") && !update.Unique && schema.IsUniqueDgraphXid {
 					update.Unique = true
 				}
+				if in.renames != nil {
+					update.Predicate = parsedKey.Attr
+				}
 
 				kv.Value, err = proto.Marshal(&update)
 				if err != nil {
@@ -791,6 +808,7 @@ func RunMapper(req *pb.RestoreRequest, mapDir string) (*mapResult, error) {
 		mapper.closer.SignalAndWait()
 	}()
 
+	renames := selectiveRenames(req)
 	dropAll := false
 	dropAttr := make(map[string]struct{})
 	dropNs := make(map[uint64]struct{})
@@ -811,28 +829,40 @@ func RunMapper(req *pb.RestoreRequest, mapDir string) (*mapResult, error) {
 			continue
 		}
 		for gid := range manifest.Groups {
-			if gid != req.GroupId {
+			// A selective restore reads the predicates it restores from the files of the groups
+			// which held them at the time of each backup.
+			if renames == nil && gid != req.GroupId {
 				// LoadBackup will try to call the backup function for every group.
 				// Exit here if the group is not the one indicated by the request.
 				continue
 			}
 
-			// Only restore the predicates that were assigned to this group at the time
-			// of the last backup.
-			file := filepath.Join(manifest.Path, backupName(manifest.ValidReadTs(), gid))
-			br := readerFrom(h, file).WithEncryption(keys.EncKey).WithCompression(manifest.Compression)
-			if br.err != nil {
-				return nil, errors.Wrap(br.err, "newBackupReader")
-			}
-			defer br.Close()
-
 			// Only map the predicates which haven't been dropped yet.
 			predSet := manifest.getPredsInGroup(gid)
 			for p := range predSet {
 				if _, ok := dropAttr[p]; ok {
 					delete(predSet, p)
 				}
+				if _, ok := renames[p]; renames != nil && !ok {
+					delete(predSet, p)
+				}
+			}
+			if renames != nil && len(predSet) == 0 {
+				continue
 			}
+
+			// Only restore the predicates that were assigned to this group at the time
+			// of the last backup.
+			file := filepath.Join(manifest.Path, backupName(manifest.ValidReadTs(), gid))
+			key, err := manifestKey(keys.EncKey, manifest)
+			if err != nil {
+				return nil, err
+			}
+			br := readerFrom(h, file).WithEncryption(key).WithCompression(manifest.Compression)
+			if br.err != nil {
+				return nil, errors.Wrap(br.err, "newBackupReader")
+			}
+			defer br.Close()
 			localDropNs := make(map[uint64]struct{})
 			for ns := range dropNs {
 				localDropNs[ns] = struct{}{}
@@ -847,6 +877,7 @@ func RunMapper(req *pb.RestoreRequest, mapDir string) (*mapResult, error) {
 				compression:             manifest.Compression,
 				fromNamespace:           req.FromNamespace,
 				isNamespaceAwareRestore: req.IsNamespaceAwareRestore,
+				renames:                 renames,
 			}
 
 			// This would stream the backups from the source, and map them in
@@ -0,0 +1,263 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/v25/conn"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/raftwal"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// Stages through which a running request goes.
+const (
+	StageParsing     = "parsing"
+	StageAuthorizing = "authorizing"
+	StageProcessing  = "processing"
+	StageMutating    = "mutating"
+)
+
+// ErrRequestNotFound is returned when cancelling a request that isn't running (anymore).
+var ErrRequestNotFound = errors.New("no running request with that ID")
+
+// RunningRequests keeps track of the queries and mutations being served by this Alpha, so that
+// they can be listed and cancelled via the admin API.
+var RunningRequests = newRequestRegistry()
+
+type runningRequest struct {
+	info   *pb.RunningRequest
+	cancel context.CancelFunc
+}
+
+type requestRegistry struct {
+	sync.Mutex
+	counter  uint32
+	requests map[uint64]*runningRequest
+}
+
+func newRequestRegistry() *requestRegistry {
+	return &requestRegistry{requests: make(map[uint64]*runningRequest)}
+}
+
+func myRaftId() uint64 {
+	if State.WALstore == nil {
+		return 0
+	}
+	return State.WALstore.Uint(raftwal.RaftId)
+}
+
+// RequestHandle is used by the request being served to report its progress.
+type RequestHandle struct {
+	id uint64
+	r  *requestRegistry
+}
+
+// ID returns the ID of the request.
+func (h *RequestHandle) ID() uint64 {
+	return h.id
+}
+
+// SetStage records the stage the request is in.
+func (h *RequestHandle) SetStage(stage string) {
+	h.r.Lock()
+	defer h.r.Unlock()
+	if req, ok := h.r.requests[h.id]; ok {
+		req.info.Stage = stage
+	}
+}
+
+// Done removes the request from the registry. It must be called once the request is served.
+func (h *RequestHandle) Done() {
+	h.r.Lock()
+	req, ok := h.r.requests[h.id]
+	delete(h.r.requests, h.id)
+	h.r.Unlock()
+	if ok {
+		req.cancel()
+	}
+}
+
+// Start registers a request and returns a context that is cancelled when the request is
+// cancelled via CancelRequest.
+func (r *requestRegistry) Start(ctx context.Context, info *pb.RunningRequest) (
+	context.Context, *RequestHandle) {
+	ctx, cancel := context.WithCancel(ctx)
+	prefix := myRaftId() << 32
+
+	r.Lock()
+	defer r.Unlock()
+	var id uint64
+	for {
+		r.counter++
+		id = prefix | uint64(r.counter)
+		// Skip zero and IDs of requests that are still running after the counter wrapped.
+		if _, ok := r.requests[id]; !ok && r.counter != 0 {
+			break
+		}
+	}
+	info.Id = id
+	if info.StartedAt == 0 {
+		info.StartedAt = time.Now().UnixNano()
+	}
+	if info.Alpha == "" {
+		info.Alpha = x.WorkerConfig.MyAddr
+	}
+	r.requests[id] = &runningRequest{info: info, cancel: cancel}
+	return ctx, &RequestHandle{id: id, r: r}
+}
+
+// List returns the requests that are running in the given namespace, or in all namespaces if
+// allNamespaces is set. The oldest requests are returned first.
+func (r *requestRegistry) List(namespace uint64, allNamespaces bool) []*pb.RunningRequest {
+	r.Lock()
+	defer r.Unlock()
+	res := make([]*pb.RunningRequest, 0, len(r.requests))
+	for _, req := range r.requests {
+		if !allNamespaces && req.info.Namespace != namespace {
+			continue
+		}
+		info := req.info
+		res = append(res, &pb.RunningRequest{
+			Id:        info.Id,
+			Namespace: info.Namespace,
+			User:      info.User,
+			StartedAt: info.StartedAt,
+			QueryHash: info.QueryHash,
+			Stage:     info.Stage,
+			Kind:      info.Kind,
+			Alpha:     info.Alpha,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].StartedAt < res[j].StartedAt })
+	return res
+}
+
+// Cancel cancels the context of the request with the given ID.
+func (r *requestRegistry) Cancel(id, namespace uint64, allNamespaces bool) error {
+	r.Lock()
+	req, ok := r.requests[id]
+	r.Unlock()
+	// Don't reveal the existence of requests in other namespaces.
+	if !ok || (!allNamespaces && req.info.Namespace != namespace) {
+		return ErrRequestNotFound
+	}
+	req.cancel()
+	glog.Infof("Cancelled request %#x in namespace %#x", id, req.info.Namespace)
+	return nil
+}
+
+// RunningRequests returns the requests being served by this Alpha.
+func (w *grpcWorker) RunningRequests(ctx context.Context, req *pb.RunningRequestsRequest) (
+	*pb.RunningRequestsResponse, error) {
+	return &pb.RunningRequestsResponse{
+		Requests: RunningRequests.List(req.GetNamespace(), req.GetAllNamespaces()),
+	}, nil
+}
+
+// CancelRequest cancels a request being served by this Alpha.
+func (w *grpcWorker) CancelRequest(ctx context.Context, req *pb.CancelRequestRequest) (
+	*pb.Status, error) {
+	if err := RunningRequests.Cancel(req.GetId(), req.GetNamespace(),
+		req.GetAllNamespaces()); err != nil {
+		return nil, err
+	}
+	return &pb.Status{}, nil
+}
+
+// RunningRequestsOverNetwork collects the running requests from all the Alphas in the cluster.
+// Alphas that can't be reached are skipped.
+func RunningRequestsOverNetwork(ctx context.Context, req *pb.RunningRequestsRequest) (
+	[]*pb.RunningRequest, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	myId := myRaftId()
+	res := RunningRequests.List(req.GetNamespace(), req.GetAllNamespaces())
+
+	type result struct {
+		requests []*pb.RunningRequest
+		err      error
+		addr     string
+	}
+	var addrs []string
+	for _, group := range groups().state.GetGroups() {
+		for _, member := range group.GetMembers() {
+			if member.GetId() != myId {
+				addrs = append(addrs, member.GetAddr())
+			}
+		}
+	}
+	ch := make(chan result, len(addrs))
+	for _, addr := range addrs {
+		go func(addr string) {
+			pool, err := conn.GetPools().Get(addr)
+			if err != nil {
+				ch <- result{err: err, addr: addr}
+				return
+			}
+			resp, err := pb.NewWorkerClient(pool.Get()).RunningRequests(ctx, req)
+			ch <- result{requests: resp.GetRequests(), err: err, addr: addr}
+		}(addr)
+	}
+	for range addrs {
+		r := <-ch
+		if r.err != nil {
+			glog.Warningf("Unable to get running requests from Alpha %s: %v", r.addr, r.err)
+			continue
+		}
+		res = append(res, r.requests...)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].StartedAt < res[j].StartedAt })
+	return res, nil
+}
+
+// CancelRequestOverNetwork cancels the request on the Alpha that is serving it.
+func CancelRequestOverNetwork(ctx context.Context, req *pb.CancelRequestRequest) error {
+	id := req.GetId()
+	if id == 0 {
+		return fmt.Errorf("invalid request ID: %#x", id)
+	}
+	raftId := id >> 32
+
+	// Skip the network call if the required Alpha is me.
+	if raftId == myRaftId() {
+		_, err := (*grpcWorker)(nil).CancelRequest(ctx, req)
+		return err
+	}
+
+	var addr string
+	for _, group := range groups().state.GetGroups() {
+		for _, member := range group.GetMembers() {
+			if member.GetId() == raftId {
+				addr = member.GetAddr()
+			}
+		}
+	}
+	if addr == "" {
+		return fmt.Errorf("the Alpha that serves that request is not available")
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	pool, err := conn.GetPools().Get(addr)
+	if err != nil {
+		return errors.Wrapf(err, "unable to reach the Alpha that serves that request")
+	}
+	_, err = pb.NewWorkerClient(pool.Get()).CancelRequest(ctx, req)
+	return err
+}
@@ -0,0 +1,46 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+)
+
+func TestRequestRegistry(t *testing.T) {
+	r := newRequestRegistry()
+
+	ctx1, h1 := r.Start(context.Background(), &pb.RunningRequest{Namespace: 0, Kind: "query"})
+	ctx2, h2 := r.Start(context.Background(), &pb.RunningRequest{Namespace: 1, Kind: "mutation"})
+	require.NotEqual(t, h1.ID(), h2.ID())
+	require.NotZero(t, h1.ID())
+
+	h1.SetStage(StageProcessing)
+	list := r.List(0, false)
+	require.Len(t, list, 1)
+	require.Equal(t, h1.ID(), list[0].Id)
+	require.Equal(t, StageProcessing, list[0].Stage)
+	require.NotZero(t, list[0].StartedAt)
+	require.Len(t, r.List(0, true), 2)
+
+	// A request can't be cancelled from another namespace.
+	require.ErrorIs(t, r.Cancel(h2.ID(), 0, false), ErrRequestNotFound)
+	require.NoError(t, ctx2.Err())
+	require.NoError(t, r.Cancel(h2.ID(), 1, false))
+	require.ErrorIs(t, ctx2.Err(), context.Canceled)
+
+	require.NoError(t, r.Cancel(h1.ID(), 1, true))
+	require.ErrorIs(t, ctx1.Err(), context.Canceled)
+
+	h1.Done()
+	h2.Done()
+	require.Empty(t, r.List(0, true))
+	require.ErrorIs(t, r.Cancel(h1.ID(), 0, true), ErrRequestNotFound)
+}
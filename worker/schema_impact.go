@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v25/conn"
+	"github.com/dgraph-io/dgraph/v25/posting"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/types"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// SchemaImpactOverNetwork reports what applying the schema updates would do, without applying
+// them. Every update is evaluated by the group that serves its predicate. Predicates that aren't
+// served by any group are new.
+func SchemaImpactOverNetwork(ctx context.Context, updates []*pb.SchemaUpdate, readTs uint64) (
+	[]*pb.SchemaImpact, error) {
+	return schemaImpactOverNetwork(ctx, updates, readTs, false)
+}
+
+// CheckSchemaOverNetwork returns an error if applying the schema updates would fail because of
+// the config of this Alpha or the data of their predicates, which the groups serving them check.
+// The alter checks it before proposing the updates, whose application doesn't depend on either.
+func CheckSchemaOverNetwork(ctx context.Context, updates []*pb.SchemaUpdate) error {
+	for _, su := range updates {
+		if err := checkEncryptionKey(su); err != nil {
+			return err
+		}
+	}
+	impacts, err := schemaImpactOverNetwork(ctx, updates, 0, true)
+	if err != nil {
+		return err
+	}
+	for _, impact := range impacts {
+		if impact.Error != "" {
+			return errors.New(impact.Error)
+		}
+	}
+	return nil
+}
+
+func schemaImpactOverNetwork(ctx context.Context, updates []*pb.SchemaUpdate, readTs uint64,
+	checkOnly bool) ([]*pb.SchemaImpact, error) {
+	if readTs == 0 {
+		ts, err := Timestamps(ctx, &pb.Num{ReadOnly: true})
+		if err != nil {
+			return nil, err
+		}
+		readTs = ts.ReadOnly
+	}
+
+	var impacts []*pb.SchemaImpact
+	byGroup := make(map[uint32][]*pb.SchemaUpdate)
+	for _, su := range updates {
+		gid, err := groups().BelongsToReadOnly(su.Predicate, 0)
+		if err != nil {
+			return nil, err
+		}
+		if gid == 0 {
+			impacts = append(impacts, newPredicateImpact(su))
+			continue
+		}
+		byGroup[gid] = append(byGroup[gid], su)
+	}
+
+	for gid, updates := range byGroup {
+		req := &pb.SchemaImpactRequest{Schema: updates, ReadTs: readTs, CheckOnly: checkOnly}
+		var resp *pb.SchemaImpactResponse
+		var err error
+		if groups().ServesGroup(gid) {
+			resp, err = schemaImpact(ctx, req)
+		} else {
+			pl := groups().Leader(gid)
+			if pl == nil {
+				return nil, conn.ErrNoConnection
+			}
+			resp, err = pb.NewWorkerClient(pl.Get()).SchemaImpact(ctx, req)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "while evaluating the schema change in group %d", gid)
+		}
+		impacts = append(impacts, resp.GetImpacts()...)
+	}
+	return impacts, nil
+}
+
+// SchemaImpact evaluates the schema updates of the request on this server.
+func (w *grpcWorker) SchemaImpact(ctx context.Context,
+	req *pb.SchemaImpactRequest) (*pb.SchemaImpactResponse, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return schemaImpact(ctx, req)
+}
+
+func schemaImpact(ctx context.Context, req *pb.SchemaImpactRequest) (
+	*pb.SchemaImpactResponse, error) {
+	if err := posting.Oracle().WaitForTs(ctx, req.ReadTs); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SchemaImpactResponse{}
+	for _, su := range req.Schema {
+		err := checkSchema(su)
+		if err == nil {
+			err = checkEncryption(ctx, su, req.ReadTs)
+		}
+		if err != nil {
+			impact := newPredicateImpact(su)
+			impact.NewPredicate = false
+			impact.Error = err.Error()
+			resp.Impacts = append(resp.Impacts, impact)
+			continue
+		}
+		if req.CheckOnly {
+			continue
+		}
+
+		old, ok := schema.State().Get(ctx, su.Predicate)
+		if !ok {
+			resp.Impacts = append(resp.Impacts, newPredicateImpact(su))
+			continue
+		}
+		rebuild := posting.IndexRebuild{
+			Attr:          su.Predicate,
+			StartTs:       req.ReadTs,
+			OldSchema:     &old,
+			CurrentSchema: su,
+		}
+		impact, err := rebuild.Impact(ctx, req.ReadTs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while evaluating the schema change of %s",
+				x.ParseAttr(su.Predicate))
+		}
+		resp.Impacts = append(resp.Impacts, impact)
+	}
+	return resp, nil
+}
+
+func newPredicateImpact(su *pb.SchemaUpdate) *pb.SchemaImpact {
+	typ := types.TypeID(su.ValueType).Name()
+	if su.List {
+		typ = "[" + typ + "]"
+	}
+	return &pb.SchemaImpact{Predicate: su.Predicate, NewPredicate: true, NewType: typ}
+}
@@ -0,0 +1,477 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgraph/v25/posting"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/schema"
+	"github.com/dgraph-io/dgraph/v25/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+// vectorSuffixes are the suffixes of the supporting predicates of a vector index, which are
+// restored along with the vector predicate.
+var vectorSuffixes = []string{hnsw.VecEntry, hnsw.VecKeyword, hnsw.VecDead}
+
+func isVectorSupportingPred(pred string) bool {
+	for _, suffix := range vectorSuffixes {
+		if strings.HasSuffix(pred, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSelectiveRestore checks the options of a selective restore that don't depend on the
+// backup.
+func validateSelectiveRestore(req *pb.RestoreRequest) error {
+	sel := req.Selective
+	if len(sel.Predicates) == 0 && len(sel.Types) == 0 {
+		return errors.Errorf("no predicate or type to restore")
+	}
+	if req.IsNamespaceAwareRestore {
+		return errors.Errorf("a selective restore can't be a tenant restore, " +
+			"use fromNamespace and toNamespace instead")
+	}
+	if req.IncrementalFrom > 1 || req.RestoreToTs > 0 || req.RestoreToTime != "" {
+		return errors.Errorf("a selective restore can't be incremental or point-in-time")
+	}
+
+	checkNames := func(kind string, names []string, renames map[string]string) error {
+		for _, name := range names {
+			if name == "" {
+				return errors.Errorf("empty %s name", kind)
+			}
+			if isReservedRestoreName(kind, name) {
+				return errors.Errorf("%s %s is reserved and can't be restored selectively",
+					kind, name)
+			}
+		}
+		renamedFrom := make(map[string]string, len(renames))
+		for from, to := range renames {
+			if to == "" {
+				return errors.Errorf("empty new name for %s %s", kind, from)
+			}
+			if isReservedRestoreName(kind, to) {
+				return errors.Errorf("%s %s can't be restored as reserved %s", kind, from, to)
+			}
+			if prev, ok := renamedFrom[to]; ok {
+				return errors.Errorf("%ss %s and %s are both restored as %s", kind, prev, from, to)
+			}
+			renamedFrom[to] = from
+		}
+		return nil
+	}
+	if err := checkNames("predicate", sel.Predicates, sel.RenamePredicates); err != nil {
+		return err
+	}
+	return checkNames("type", sel.Types, sel.RenameTypes)
+}
+
+func isReservedRestoreName(kind, name string) bool {
+	if kind == "type" {
+		return x.IsReservedType(x.AttrInRootNamespace(name))
+	}
+	return x.IsReservedPredicate(x.AttrInRootNamespace(name))
+}
+
+// planSelectiveRestore returns the predicates restored by a selective restore, and the types
+// updated once they're restored. The predicates of the restored types are restored with them,
+// and the predicates and types are renamed into the target namespace.
+func planSelectiveRestore(sel *pb.SelectiveRestore, fromNs uint64, manifest *Manifest,
+	backupTypes map[string]*pb.TypeUpdate) ([]*pb.RestoredPredicate, []*pb.TypeUpdate, error) {
+
+	backupPreds := make(map[string]struct{})
+	for _, preds := range manifest.Groups {
+		for _, pred := range preds {
+			backupPreds[pred] = struct{}{}
+		}
+	}
+	target := func(attr string) string {
+		if to, ok := sel.RenamePredicates[attr]; ok {
+			attr = to
+		}
+		return x.NamespaceAttr(sel.ToNamespace, attr)
+	}
+
+	restored := make(map[string]string)
+	addPred := func(attr string) error {
+		source := x.NamespaceAttr(fromNs, attr)
+		if _, ok := backupPreds[source]; !ok {
+			return errors.Errorf("predicate %s is not in backup %s of series %s in namespace %d",
+				attr, manifest.Path, manifest.BackupId, fromNs)
+		}
+		restored[source] = target(attr)
+		for _, suffix := range vectorSuffixes {
+			if _, ok := backupPreds[source+suffix]; ok {
+				restored[source+suffix] = target(attr) + suffix
+			}
+		}
+		return nil
+	}
+	for _, attr := range sel.Predicates {
+		if err := addPred(attr); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var typeUpdates []*pb.TypeUpdate
+	for _, name := range sel.Types {
+		backupType, ok := backupTypes[x.NamespaceAttr(fromNs, name)]
+		if !ok {
+			return nil, nil, errors.Errorf("type %s is not in backup %s of series %s "+
+				"in namespace %d", name, manifest.Path, manifest.BackupId, fromNs)
+		}
+		update := proto.Clone(backupType).(*pb.TypeUpdate)
+		if to, ok := sel.RenameTypes[name]; ok {
+			name = to
+		}
+		update.TypeName = x.NamespaceAttr(sel.ToNamespace, name)
+		for _, field := range update.Fields {
+			_, attr := x.ParseNamespaceAttr(field.Predicate)
+			reverse := strings.HasPrefix(attr, "~")
+			attr = strings.TrimPrefix(attr, "~")
+			if err := addPred(attr); err != nil {
+				return nil, nil, errors.Wrapf(err, "while restoring type %s", name)
+			}
+			field.Predicate = target(attr)
+			if reverse {
+				_, attr = x.ParseNamespaceAttr(field.Predicate)
+				field.Predicate = x.NamespaceAttr(sel.ToNamespace, "~"+attr)
+			}
+		}
+		typeUpdates = append(typeUpdates, update)
+	}
+
+	preds := make([]*pb.RestoredPredicate, 0, len(restored))
+	targets := make(map[string]string, len(restored))
+	for source, target := range restored {
+		if prev, ok := targets[target]; ok {
+			return nil, nil, errors.Errorf("predicates %s and %s are both restored as %s",
+				prev, source, target)
+		}
+		targets[target] = source
+		preds = append(preds, &pb.RestoredPredicate{Source: source, Target: target})
+	}
+	sort.Slice(preds, func(i, j int) bool { return preds[i].Target < preds[j].Target })
+	return preds, typeUpdates, nil
+}
+
+// readBackupTypes returns the types in a backup, by their name with namespace. The types are
+// written in the file of every group, after its data, so the file of a single group is read.
+func readBackupTypes(h UriHandler, manifest *Manifest, key x.Sensitive) (
+	map[string]*pb.TypeUpdate, error) {
+
+	gids := make([]uint32, 0, len(manifest.Groups))
+	for gid := range manifest.Groups {
+		gids = append(gids, gid)
+	}
+	if len(gids) == 0 {
+		return nil, errors.Errorf("backup %s has no group", manifest.Path)
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+	file := filepath.Join(manifest.Path, backupName(manifest.ValidReadTs(), gids[0]))
+	key, err := manifestKey(key, manifest)
+	if err != nil {
+		return nil, err
+	}
+	br := readerFrom(h, file).WithEncryption(key).WithCompression(manifest.Compression)
+	if br.err != nil {
+		return nil, errors.Wrap(br.err, "newBackupReader")
+	}
+	defer br.Close()
+
+	types := make(map[string]*pb.TypeUpdate)
+	r := bufio.NewReaderSize(br, 16<<10)
+	for {
+		var sz uint64
+		err := binary.Read(r, binary.LittleEndian, &sz)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading %s", file)
+		}
+		buf := make([]byte, sz)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, errors.Wrapf(err, "while reading %s", file)
+		}
+		list := &bpb.KVList{}
+		if err := proto.Unmarshal(buf, list); err != nil {
+			return nil, errors.Wrapf(err, "while reading %s", file)
+		}
+		for _, kv := range list.Kv {
+			restoreKey, _, err := fromBackupKey(kv.Key)
+			if err != nil {
+				return nil, err
+			}
+			pk, err := x.Parse(restoreKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not parse key in %s", file)
+			}
+			if !pk.IsType() {
+				continue
+			}
+			update, err := decodeBackupType(kv.Value, manifest.Version)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while reading type %s", pk.Attr)
+			}
+			types[update.TypeName] = update
+		}
+	}
+	return types, nil
+}
+
+// decodeBackupType reads a type written in a backup with the given manifest version, in the
+// format of the current version.
+func decodeBackupType(value []byte, version int) (*pb.TypeUpdate, error) {
+	var update pb.TypeUpdate
+	if err := proto.Unmarshal(value, &update); err != nil {
+		return nil, err
+	}
+	switch version {
+	case 0:
+		update.TypeName = x.AttrInRootNamespace(update.TypeName)
+		for _, field := range update.Fields {
+			field.Predicate = x.AttrInRootNamespace(field.Predicate)
+		}
+	case 2103:
+		var err error
+		if update.TypeName, err = x.AttrFrom2103(update.TypeName); err != nil {
+			return nil, err
+		}
+		for _, field := range update.Fields {
+			if field.Predicate, err = x.AttrFrom2103(field.Predicate); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &update, nil
+}
+
+// processSelectiveRestore sends each group the predicates of a selective restore that it
+// serves. A restored predicate is restored by the group serving it, or by the group which held
+// it at the time of the backup if no group serves it. The types are updated once all the
+// predicates are restored. It returns once the restore is done, with the error of any group or
+// of the type updates.
+func processSelectiveRestore(ctx context.Context, req *pb.RestoreRequest,
+	creds *x.MinioCredentials, memState *pb.MembershipState, wg *sync.WaitGroup) error {
+
+	if err := validateSelectiveRestore(req); err != nil {
+		return err
+	}
+	uri, err := url.Parse(req.Location)
+	if err != nil {
+		return err
+	}
+	h, err := NewUriHandler(uri, creds)
+	if err != nil {
+		return errors.Wrap(err, "processSelectiveRestore")
+	}
+	manifests, err := getManifestsToRestore(h, uri, req)
+	if err != nil {
+		return errors.Wrapf(err, "while retrieving manifests")
+	}
+	if len(manifests) == 0 {
+		return errors.Errorf("No backups with the specified backup ID %s", req.GetBackupId())
+	}
+	lastManifest := manifests[0]
+
+	var backupTypes map[string]*pb.TypeUpdate
+	if len(req.Selective.Types) > 0 {
+		key, err := getRestoreEncKey(req)
+		if err != nil {
+			return err
+		}
+		if backupTypes, err = readBackupTypes(h, lastManifest, key); err != nil {
+			return errors.Wrapf(err, "cannot read the types of the backup")
+		}
+	}
+	preds, typeUpdates, err := planSelectiveRestore(req.Selective, req.FromNamespace,
+		lastManifest, backupTypes)
+	if err != nil {
+		return err
+	}
+	if err := FillRestoreCredentials(req.Location, req); err != nil {
+		return errors.Wrapf(err, "cannot fill restore proposal with the right credentials")
+	}
+
+	servedBy := make(map[string]uint32)
+	for gid, group := range memState.GetGroups() {
+		for pred := range group.GetTablets() {
+			servedBy[pred] = gid
+		}
+	}
+	backupGroup := make(map[string]uint32)
+	for gid, backupPreds := range lastManifest.Groups {
+		for _, pred := range backupPreds {
+			backupGroup[pred] = gid
+		}
+	}
+	assigned := make(map[uint32][]*pb.RestoredPredicate)
+	for _, p := range preds {
+		gid, ok := servedBy[p.Target]
+		if !ok && isVectorSupportingPred(p.Target) {
+			// The supporting predicates of a vector index have no tablet, and they're
+			// restored by the group restoring the vector predicate.
+			base := p.Target
+			for _, suffix := range vectorSuffixes {
+				base = strings.TrimSuffix(base, suffix)
+			}
+			gid, ok = servedBy[base]
+		}
+		if !ok {
+			gid = backupGroup[p.Source]
+			if _, exists := memState.GetGroups()[gid]; !exists {
+				gid = groups().groupId()
+			}
+		}
+		assigned[gid] = append(assigned[gid], p)
+	}
+
+	req.RestoreTs = State.GetTimestamp(false)
+	glog.Infof("Selective restore of predicates %+v from backup %s at ts %d",
+		preds, lastManifest.Path, req.RestoreTs)
+
+	// The restore is waited for, for a failure of any group or of the type updates to fail it.
+	wg.Add(1)
+	defer wg.Done()
+	errCh := make(chan error, len(assigned))
+	for gid, groupPreds := range assigned {
+		reqCopy := proto.Clone(req).(*pb.RestoreRequest)
+		reqCopy.GroupId = gid
+		reqCopy.Selective.Assigned = groupPreds
+		go func() {
+			errCh <- errors.Wrapf(proposeRestoreOrSend(ctx, reqCopy), "group %d", gid)
+		}()
+	}
+	var firstErr error
+	for range assigned {
+		if err := <-errCh; err != nil {
+			glog.Errorf("Error while restoring %v", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	if firstErr != nil {
+		return errors.Wrapf(firstErr, "while restoring the predicates")
+	}
+	if len(typeUpdates) == 0 {
+		return nil
+	}
+	m := &pb.Mutations{StartTs: State.GetTimestamp(false), Types: typeUpdates}
+	if _, err := MutateOverNetwork(ctx, m); err != nil {
+		return errors.Wrapf(err, "while updating the restored types")
+	}
+	return nil
+}
+
+// selectiveRenames returns the predicates restored by the group receiving a selective restore
+// request, mapped to the predicates they're restored as. It returns nil for the other restores.
+func selectiveRenames(req *pb.RestoreRequest) map[string]string {
+	if req.Selective == nil {
+		return nil
+	}
+	renames := make(map[string]string, len(req.Selective.Assigned))
+	for _, p := range req.Selective.Assigned {
+		renames[p.Source] = p.Target
+	}
+	return renames
+}
+
+// handleSelectiveRestore restores the predicates assigned to this group by a selective restore.
+// The restored predicates are dropped and written again from the backup at the restore ts,
+// while the rest of the data of the group is left untouched.
+func handleSelectiveRestore(ctx context.Context, req *pb.RestoreRequest, pidx uint64) error {
+	targets := make([]string, 0, len(req.Selective.Assigned))
+	for _, p := range req.Selective.Assigned {
+		targets = append(targets, p.Target)
+	}
+	for _, pred := range targets {
+		if err := detectPendingTxns(pred); err != nil {
+			return errors.Wrapf(err, "cannot restore predicate %s", pred)
+		}
+	}
+	prefixes := make([][]byte, 0, 2*len(targets))
+	for _, pred := range targets {
+		// The supporting predicates of a vector index aren't tablets, like in a full restore.
+		if !isVectorSupportingPred(pred) {
+			tablet, err := groups().ForceTablet(pred)
+			if err != nil {
+				return errors.Wrapf(err, "cannot create tablet for restored predicate %s", pred)
+			}
+			if tablet.GetGroupId() != req.GroupId {
+				return errors.Errorf("cannot assign tablet for pred %s to group %d",
+					pred, req.GroupId)
+			}
+		}
+		prefixes = append(prefixes, x.PredicatePrefix(pred), x.SchemaKey(pred))
+	}
+	// The data and schema of the predicates are dropped, whether the schema exists or not as
+	// after a DropAttr. The schema in memory is replaced by the restored one below.
+	posting.ResetCache()
+	if err := pstore.DropPrefix(prefixes...); err != nil {
+		return errors.Wrap(err, "cannot drop the predicates before restoring them")
+	}
+
+	mapDir, err := os.MkdirTemp(x.WorkerConfig.TmpDir, "restore-map")
+	x.Check(err)
+	defer func() {
+		if err := os.RemoveAll(mapDir); err != nil {
+			glog.Warningf("Error removing temp restore-map dir: %v", err)
+		}
+	}()
+	mapRes, err := RunMapper(req, mapDir)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to map the backup files")
+	}
+	glog.Infof("Backup map phase is complete. Map result is: %+v\n", mapRes)
+
+	// The stream writer can't be used on a live DB, so the restored keys are written in a
+	// batch. They're versioned at the restore ts, above every version of the dropped predicates.
+	wb := pstore.NewManagedWriteBatch()
+	defer wb.Cancel()
+	if err := RunReducer(wb, mapDir); err != nil {
+		return errors.Wrap(err, "failed to reduce restore map")
+	}
+	if err := wb.Flush(); err != nil {
+		return errors.Wrap(err, "while write batch flush")
+	}
+
+	if err := bumpLease(ctx, mapRes); err != nil {
+		return errors.Wrap(err, "While bumping the leases after restore")
+	}
+	for _, pred := range targets {
+		if err := schema.Load(pred); err != nil {
+			return errors.Wrapf(err, "cannot load schema of restored predicate %s", pred)
+		}
+	}
+	posting.ResetCache()
+
+	proposeSnapshotAfterRestore(pidx)
+	if err := UpdateMembershipState(ctx); err != nil {
+		return errors.Wrapf(err, "cannot update membership state after restore")
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgraph/v25/posting"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/x"
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+func TestValidateSelectiveRestore(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.RestoreRequest
+		err  string
+	}{
+		{
+			name: "predicates",
+			req: &pb.RestoreRequest{Selective: &pb.SelectiveRestore{Predicates: []string{"name"},
+				RenamePredicates: map[string]string{"name": "old_name"}}},
+		},
+		{
+			name: "nothing to restore",
+			req:  &pb.RestoreRequest{Selective: &pb.SelectiveRestore{}},
+			err:  "no predicate or type",
+		},
+		{
+			name: "point in time",
+			req: &pb.RestoreRequest{RestoreToTs: 10,
+				Selective: &pb.SelectiveRestore{Types: []string{"Person"}}},
+			err: "point-in-time",
+		},
+		{
+			name: "reserved predicate",
+			req: &pb.RestoreRequest{Selective: &pb.SelectiveRestore{
+				Predicates: []string{"dgraph.password"}}},
+			err: "reserved",
+		},
+		{
+			name: "renamed as reserved type",
+			req: &pb.RestoreRequest{Selective: &pb.SelectiveRestore{Types: []string{"Person"},
+				RenameTypes: map[string]string{"Person": "dgraph.type.User"}}},
+			err: "reserved",
+		},
+		{
+			name: "renamed twice",
+			req: &pb.RestoreRequest{Selective: &pb.SelectiveRestore{
+				Predicates:       []string{"name", "nick"},
+				RenamePredicates: map[string]string{"name": "alias", "nick": "alias"}}},
+			err: "both restored as alias",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSelectiveRestore(tc.req)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestPlanSelectiveRestore(t *testing.T) {
+	manifest := &Manifest{
+		ManifestBase: ManifestBase{Path: "dgraph.20260101.000000.000", BackupId: "id1"},
+		Groups: map[uint32][]string{
+			1: {"0-name", "0-age", "0-embedding", "0-embedding__vector_entry"},
+			2: {"0-friend", "1-name"},
+		},
+	}
+	backupTypes := map[string]*pb.TypeUpdate{
+		"0-Person": {TypeName: "0-Person", Fields: []*pb.SchemaUpdate{
+			{Predicate: "0-name"}, {Predicate: "0-~friend"}}},
+	}
+
+	sel := &pb.SelectiveRestore{
+		Predicates:       []string{"embedding"},
+		Types:            []string{"Person"},
+		RenamePredicates: map[string]string{"friend": "old_friend"},
+		RenameTypes:      map[string]string{"Person": "OldPerson"},
+		ToNamespace:      2,
+	}
+	preds, types, err := planSelectiveRestore(sel, 0, manifest, backupTypes)
+	require.NoError(t, err)
+	require.Equal(t, []*pb.RestoredPredicate{
+		{Source: "0-embedding", Target: "2-embedding"},
+		{Source: "0-embedding__vector_entry", Target: "2-embedding__vector_entry"},
+		{Source: "0-name", Target: "2-name"},
+		{Source: "0-friend", Target: "2-old_friend"},
+	}, preds)
+	require.Len(t, types, 1)
+	require.Equal(t, "2-OldPerson", types[0].TypeName)
+	require.Equal(t, "2-name", types[0].Fields[0].Predicate)
+	require.Equal(t, "2-~old_friend", types[0].Fields[1].Predicate)
+	// The type of the backup is left as is.
+	require.Equal(t, "0-~friend", backupTypes["0-Person"].Fields[1].Predicate)
+
+	// The predicates are read from the given namespace.
+	preds, _, err = planSelectiveRestore(&pb.SelectiveRestore{Predicates: []string{"name"}},
+		1, manifest, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*pb.RestoredPredicate{{Source: "1-name", Target: "0-name"}}, preds)
+
+	_, _, err = planSelectiveRestore(&pb.SelectiveRestore{Predicates: []string{"email"}},
+		0, manifest, nil)
+	require.ErrorContains(t, err, "predicate email is not in backup")
+	_, _, err = planSelectiveRestore(&pb.SelectiveRestore{Types: []string{"Person"}},
+		1, manifest, backupTypes)
+	require.ErrorContains(t, err, "type Person is not in backup")
+	_, _, err = planSelectiveRestore(&pb.SelectiveRestore{Predicates: []string{"name", "age"},
+		RenamePredicates: map[string]string{"age": "name"}}, 0, manifest, nil)
+	require.ErrorContains(t, err, "both restored as 0-name")
+}
+
+// kvCollector is a restore reducer writer keeping the written KVs.
+type kvCollector struct {
+	kvs map[string]*bpb.KV
+}
+
+func (c *kvCollector) Write(buf *z.Buffer) error {
+	return buf.SliceIterate(func(s []byte) error {
+		kv := &bpb.KV{}
+		if err := proto.Unmarshal(s, kv); err != nil {
+			return err
+		}
+		pk, err := x.Parse(kv.Key)
+		if err != nil {
+			return err
+		}
+		c.kvs[pk.String()] = kv
+		return nil
+	})
+}
+
+func TestSelectiveRestoreMap(t *testing.T) {
+	dir := t.TempDir()
+	m := &Manifest{
+		ManifestBase: ManifestBase{
+			Type: "full", BackupId: "id1", BackupNum: 1, Path: "dgraph.20260101.000000.000",
+			ReadTs: 100, Version: x.ManifestVersion, Compression: "snappy",
+		},
+		Groups: map[uint32][]string{1: {"0-name", "0-age"}, 2: {"0-friend"}},
+	}
+	postingKV := func(key []byte, uids ...uint64) *bpb.KV {
+		kv := testBackupKV(t, key, 50)
+		var err error
+		kv.Value, err = proto.Marshal(&pb.BackupPostingList{Uids: uids})
+		require.NoError(t, err)
+		kv.UserMeta = []byte{posting.BitCompletePosting}
+		return kv
+	}
+	schemaKV := func(pred string) *bpb.KV {
+		kv := testBackupKV(t, x.SchemaKey(pred), 1)
+		var err error
+		kv.Value, err = proto.Marshal(&pb.SchemaUpdate{Predicate: pred,
+			ValueType: pb.Posting_UID})
+		require.NoError(t, err)
+		kv.UserMeta = []byte{posting.BitSchemaPosting}
+		return kv
+	}
+	typeKV := testBackupKV(t, x.TypeKey("0-Person"), 1)
+	typeKV.Value, _ = proto.Marshal(&pb.TypeUpdate{TypeName: "0-Person"})
+	typeKV.UserMeta = []byte{posting.BitSchemaPosting}
+	writeTestBackupFile(t, dir, m, 1, nil,
+		[]*bpb.KV{postingKV(x.DataKey("0-name", 1), 7), postingKV(x.DataKey("0-age", 1), 8)},
+		[]*bpb.KV{schemaKV("0-age"), schemaKV("0-name")},
+		[]*bpb.KV{typeKV})
+	writeTestBackupFile(t, dir, m, 2, nil,
+		[]*bpb.KV{postingKV(x.DataKey("0-friend", 2), 1)},
+		[]*bpb.KV{schemaKV("0-friend")},
+		[]*bpb.KV{typeKV})
+	writeMasterManifestToDir(t, dir, []*Manifest{m})
+
+	req := &pb.RestoreRequest{
+		GroupId:   1,
+		RestoreTs: 200,
+		Location:  (&url.URL{Scheme: "file", Path: dir}).String(),
+		Selective: &pb.SelectiveRestore{Assigned: []*pb.RestoredPredicate{
+			{Source: "0-name", Target: "3-old_name"},
+			{Source: "0-friend", Target: "0-friend"},
+		}},
+	}
+	mapDir := filepath.Join(t.TempDir(), "map")
+	_, err := RunMapper(req, mapDir)
+	require.NoError(t, err)
+	c := &kvCollector{kvs: make(map[string]*bpb.KV)}
+	require.NoError(t, RunReducer(c, mapDir))
+
+	keyString := func(key []byte) string {
+		pk, err := x.Parse(key)
+		require.NoError(t, err)
+		return pk.String()
+	}
+	var keys []string
+	for k, kv := range c.kvs {
+		keys = append(keys, k)
+		require.Equal(t, uint64(200), kv.Version)
+	}
+	expected := []string{
+		keyString(x.DataKey("0-friend", 2)),
+		keyString(x.DataKey("3-old_name", 1)),
+		keyString(x.SchemaKey("0-friend")),
+		keyString(x.SchemaKey("3-old_name")),
+	}
+	require.ElementsMatch(t, expected, keys)
+
+	var update pb.SchemaUpdate
+	restored := c.kvs[keyString(x.SchemaKey("3-old_name"))]
+	require.NoError(t, proto.Unmarshal(restored.Value, &update))
+	require.Equal(t, "3-old_name", update.Predicate)
+
+	h, err := NewUriHandler(&url.URL{Path: dir}, nil)
+	require.NoError(t, err)
+	types, err := readBackupTypes(h, m, nil)
+	require.NoError(t, err)
+	require.Contains(t, types, "0-Person")
+}
@@ -28,21 +28,26 @@ const (
 	//       For easy readability, keep the options without default values (if any) at the end of
 	//       the *Defaults string. Also, since these strings are printed in --help text, avoid line
 	//       breaks.
-	AuditDefaults  = `compress=false; days=10; size=100; dir=; output=; encrypt-file=;`
+	AuditDefaults = `compress=false; days=10; size=100; reads=false; dir=; output=; ` +
+		`encrypt-file=; syslog=; webhook=;`
 	BadgerDefaults = `compression=snappy; numgoroutines=8;`
 	RaftDefaults   = `learner=false; snapshot-after-entries=10000; ` +
 		`snapshot-after-duration=30m; pending-proposals=256; idx=; group=;`
 	SecurityDefaults = `token=; whitelist=;`
 	CDCDefaults      = `file=; kafka=; sasl_user=; sasl_password=; ca_cert=; client_cert=; ` +
 		`client_key=; sasl-mechanism=PLAIN; tls=false;`
-	LimitDefaults = `mutations=allow; query-edge=1000000; normalize-node=10000; ` +
+	WALArchiveDefaults = `destination=; interval=1m;`
+	LimitDefaults      = `mutations=allow; query-edge=1000000; normalize-node=10000; ` +
 		`mutations-nquad=1000000; disallow-drop=false; query-timeout=0ms; txn-abort-after=5m; ` +
-		`max-retries=10; max-pending-queries=10000; shared-instance=false; type-filter-uid-limit=10`
+		`max-retries=10; max-pending-queries=10000; shared-instance=false; type-filter-uid-limit=10; ` +
+		`namespace-quotas=false;`
 	ZeroLimitsDefaults = `uid-lease=0; refill-interval=30s; disable-admin-http=false;`
 	GraphQLDefaults    = `introspection=true; debug=false; extensions=true; poll-interval=1s; ` +
 		`lambda-url=;`
 	CacheDefaults        = `size-mb=4096; percentage=40,40,20; remove-on-update=false`
-	FeatureFlagsDefaults = `normalize-compatibility-mode=; enable-detailed-metrics=false; log-slow-query-threshold=0`
+	FeatureFlagsDefaults = `normalize-compatibility-mode=; enable-detailed-metrics=false; log-slow-query-threshold=0; uid-bitmaps=false`
+	HistoryDefaults      = `retention=0s; predicates=;`
+	StoredQueryDefaults  = `enabled=false; only=false;`
 )
 
 // ServerState holds the state of the Dgraph server.
@@ -52,6 +57,8 @@ type ServerState struct {
 	Pstore   *badger.DB
 	WALstore *raftwal.DiskStorage
 	gcCloser *z.Closer // closer for valueLogGC
+	// Keyring holds the versions of the encryption key, if encryption is enabled.
+	Keyring *x.Keyring
 
 	needTs chan tsReq
 }
@@ -77,10 +84,10 @@ func InitServerState() {
 	x.WorkerConfig.ProposedGroupId = groupId
 }
 
-func setBadgerOptions(opt badger.Options) badger.Options {
+func setBadgerOptions(opt badger.Options, key x.Sensitive) badger.Options {
 	opt = opt.WithSyncWrites(false).
 		WithLogger(&x.ToGlog{}).
-		WithEncryptionKey(x.WorkerConfig.EncryptionKey)
+		WithEncryptionKey(key)
 
 	// Disable conflict detection in badger. Alpha runs in managed mode and
 	// perform its own conflict detection so we don't need badger's conflict
@@ -94,27 +101,36 @@ func setBadgerOptions(opt badger.Options) badger.Options {
 
 func (s *ServerState) InitStorage() {
 	var err error
+	// The stores are encrypted with the storage key, which the encryption key encrypts, so that
+	// rotating the encryption key doesn't need to re-encrypt them.
+	var storageKey x.Sensitive
 
+	x.Checkf(os.MkdirAll(Config.PostingDir, 0700), "Error while creating postings dir.")
+	x.Checkf(os.MkdirAll(Config.WALDir, 0700), "Error while creating WAL dir.")
 	if x.WorkerConfig.EncryptionKey != nil {
 		glog.Infof("Encryption feature enabled.")
+
+		s.Keyring, err = x.OpenKeyring(Config.PostingDir, x.WorkerConfig.EncryptionKey)
+		x.Checkf(err, "Error while opening the encryption keyring")
+		storageKey, err = s.Keyring.OpenStorage(Config.PostingDir, Config.WALDir)
+		x.Checkf(err, "Error while opening the storage key")
+		glog.Infof("Using encryption key version %d.", s.Keyring.Current().Version)
 	}
 
 	{
 		// Write Ahead Log directory
-		x.Checkf(os.MkdirAll(Config.WALDir, 0700), "Error while creating WAL dir.")
-		s.WALstore, err = raftwal.InitEncrypted(Config.WALDir, x.WorkerConfig.EncryptionKey)
+		s.WALstore, err = raftwal.InitEncrypted(Config.WALDir, storageKey)
 		x.Check(err)
 	}
 	{
 		// Postings directory
 		// All the writes to posting store should be synchronous. We use batched writers
 		// for posting lists, so the cost of sync writes is amortized.
-		x.Check(os.MkdirAll(Config.PostingDir, 0700))
 		opt := x.WorkerConfig.Badger.
 			WithDir(Config.PostingDir).WithValueDir(Config.PostingDir).
 			WithNumVersionsToKeep(math.MaxInt32).
 			WithNamespaceOffset(x.NamespaceOffset)
-		opt = setBadgerOptions(opt)
+		opt = setBadgerOptions(opt, storageKey)
 
 		// Print the options w/o exposing key.
 		// TODO: Build a stringify interface in Badger options, which is used to print nicely here.
@@ -132,8 +148,9 @@ func (s *ServerState) InitStorage() {
 	// Temp directory
 	x.Check(os.MkdirAll(x.WorkerConfig.TmpDir, 0700))
 
-	s.gcCloser = z.NewCloser(3)
+	s.gcCloser = z.NewCloser(4)
 	go x.RunVlogGC(s.Pstore, s.gcCloser)
+	go tsHist.run(s.gcCloser)
 	// Commenting this out because Badger is doing its own cache checks.
 	go x.MonitorCacheHealth(s.Pstore, s.gcCloser)
 	go x.MonitorDiskMetrics("postings_fs", Config.PostingDir, s.gcCloser)
@@ -513,6 +513,9 @@ func processSort(ctx context.Context, ts *pb.SortMessage) (*pb.SortResult, error
 				"Try flipping order and return first few elements instead.",
 			x.ParseAttr(ts.Order[0].Attr), ts.Count)
 	}
+	if err := checkEncryptedOrder(ctx, ts.Order); err != nil {
+		return nil, err
+	}
 	// TODO (pawan) - Why check only the first attribute, what if other attributes are of list type?
 	if schema.State().IsList(ts.Order[0].Attr) {
 		return nil, errors.Errorf("Sorting not supported on attr: %s of type: [scalar]",
@@ -26,6 +26,9 @@ type stringFilter struct {
 	match    matchFunc
 	eqVals   []types.Val
 	tokName  string
+	// tokenizer is the tokenizer of the predicate if it analyzes the text with an analyzer
+	// declared in the schema, else tokName names the tokenizer.
+	tokenizer tok.Tokenizer
 }
 
 func matchStrings(uids *pb.List, values [][]types.Val, filter *stringFilter) *pb.List {
@@ -90,9 +93,13 @@ func ineqMatch(value types.Val, filter *stringFilter) bool {
 }
 
 func tokenizeValue(value types.Val, filter *stringFilter) []string {
-	tokenizer, found := tok.GetTokenizer(filter.tokName)
-	// tokenizer was used in previous stages of query processing, it has to be available
-	x.AssertTrue(found)
+	tokenizer := filter.tokenizer
+	if tokenizer == nil {
+		var found bool
+		tokenizer, found = tok.GetTokenizer(filter.tokName)
+		// tokenizer was used in previous stages of query processing, it has to be available
+		x.AssertTrue(found)
+	}
 
 	tokens, err := tok.BuildTokens(value.Value, tok.GetTokenizerForLang(tokenizer, filter.lang))
 	if err != nil {
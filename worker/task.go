@@ -8,6 +8,7 @@ package worker
 import (
 	"context"
 	"fmt"
+	"maps"
 	"math"
 	"sort"
 	"strconv"
@@ -18,6 +19,7 @@ import (
 	cindex "github.com/google/codesearch/index"
 	cregexp "github.com/google/codesearch/regexp"
 	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -224,6 +226,8 @@ const (
 	customIndexFn
 	matchFn
 	similarToFn
+	facetFn
+	knnGeoFn
 	standardFn = 100
 )
 
@@ -270,6 +274,10 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return customIndexFn, f
 	case "match":
 		return matchFn, f
+	case "facet":
+		return facetFn, f
+	case "knn_geo":
+		return knnGeoFn, f
 	default:
 		if types.IsGeoFunc(f) {
 			return geoFn, f
@@ -290,7 +298,7 @@ func needsIndex(fnType FuncType, uidList *pb.List) bool {
 		return true
 	case geoFn, fullTextSearchFn, standardFn, matchFn:
 		return true
-	case similarToFn:
+	case similarToFn, knnGeoFn:
 		return true
 	}
 	return false
@@ -314,14 +322,15 @@ type funcArgs struct {
 // The function tells us whether we want to fetch value posting lists or uid posting lists.
 func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error) {
 	switch srcFn.fnType {
-	case aggregatorFn, passwordFn, similarToFn:
+	case aggregatorFn, passwordFn, similarToFn, knnGeoFn:
 		return true, nil
 	case compareAttrFn:
 		if len(srcFn.tokens) > 0 {
 			return false, nil
 		}
 		return true, nil
-	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn, ngramFn:
+	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn, ngramFn,
+		facetFn:
 		// All of these require an index, hence would require fetching uid postings.
 		return false, nil
 	case uidInFn, compareScalarFn:
@@ -351,11 +360,15 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 		attribute.String("srcFn", x.SafeUTF8(fmt.Sprintf("%+v", args.srcFn)))))
 
 	switch srcFn.fnType {
-	case notAFunction, aggregatorFn, passwordFn, compareAttrFn, similarToFn:
+	case notAFunction, aggregatorFn, passwordFn, compareAttrFn, similarToFn, knnGeoFn:
 	default:
 		return errors.Errorf("Unhandled function in handleValuePostings: %s", srcFn.fname)
 	}
 
+	if srcFn.fnType == knnGeoFn {
+		return qs.handleKnnGeoFunction(ctx, args)
+	}
+
 	if srcFn.fnType == similarToFn {
 		numNeighbors, err := strconv.ParseInt(q.SrcFunc.Args[0], 10, 32)
 		if err != nil {
@@ -848,7 +861,7 @@ func (qs *queryState) handleUidPostings(
 					key = x.DataKey(q.Attr, q.UidList.Uids[i])
 				}
 			case geoFn, regexFn, fullTextSearchFn, standardFn, customIndexFn, matchFn, ngramFn,
-				compareAttrFn:
+				compareAttrFn, facetFn:
 				key = x.IndexKey(q.Attr, srcFn.tokens[i])
 			default:
 				return errors.Errorf("Unhandled function in handleUidPostings: %s", srcFn.fname)
@@ -1181,6 +1194,13 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 		}
 	}
 
+	if srcFn.fnType == facetFn {
+		span.AddEvent("handleFacetFunction")
+		if err := qs.handleFacetFunction(ctx, args); err != nil {
+			return nil, err
+		}
+	}
+
 	// If geo filter, do value check for correctness.
 	if srcFn.geoQuery != nil {
 		span.AddEvent("handleGeoFunction")
@@ -1620,6 +1640,131 @@ func (qs *queryState) handleMatchFunction(ctx context.Context, arg funcArgs) err
 	return nil
 }
 
+const (
+	// knnGeoMinDistance is the radius in meters of the first disc searched by knn_geo.
+	knnGeoMinDistance = 100
+	// knnGeoMaxDistance is the largest radius searched by knn_geo, close to half the circumference
+	// of the earth, as the loop of a disc degenerates when it reaches the antipode of its center.
+	knnGeoMaxDistance = 0.99 * math.Pi * types.EarthRadiusMeters
+)
+
+// handleKnnGeoFunction finds the k geometries closest to the point of knn_geo. It looks up the
+// index cells covering a disc around the point, and doubles the radius of the disc until k
+// geometries lie within it: a geometry found in a cell but outside of the disc may be farther than
+// one in the cells not looked up yet.
+func (qs *queryState) handleKnnGeoFunction(ctx context.Context, arg funcArgs) error {
+	span := trace.SpanFromContext(ctx)
+	stop := x.SpanTimer(span, "handleKnnGeoFunction")
+	defer stop()
+
+	q := arg.q
+	srcFn := arg.srcFn
+	opts := posting.ListOptions{ReadTs: q.ReadTs}
+	if q.UidList != nil && len(q.UidList.Uids) > 0 {
+		// knn_geo is used as a filter.
+		opts.Intersect = q.UidList
+	}
+
+	seen := make(map[string]struct{})
+	dists := make(map[uint64]float64)
+	for radius := float64(knnGeoMinDistance); ; radius *= 2 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		radius = math.Min(radius, knnGeoMaxDistance)
+		tokens, err := types.GeoNearTokens(srcFn.geoPoint, radius)
+		if err != nil {
+			return err
+		}
+		tok.EncodeGeoTokens(tokens)
+		for _, token := range tokens {
+			if _, ok := seen[token]; ok {
+				continue
+			}
+			seen[token] = struct{}{}
+			pl, err := qs.cache.GetUids(x.IndexKey(q.Attr, token))
+			if err != nil {
+				return err
+			}
+			uids, err := pl.Uids(opts)
+			if err != nil {
+				return err
+			}
+			for _, uid := range uids.Uids {
+				if _, ok := dists[uid]; ok {
+					continue
+				}
+				if dists[uid], err = qs.geoDistance(q, uid, srcFn.geoPoint); err != nil {
+					return err
+				}
+			}
+		}
+
+		within := 0
+		for _, d := range dists {
+			if d <= radius {
+				within++
+			}
+		}
+		if within >= srcFn.numNeighbors || radius >= knnGeoMaxDistance {
+			break
+		}
+	}
+
+	nearest := make([]uint64, 0, len(dists))
+	for uid, d := range dists {
+		if !math.IsInf(d, 1) {
+			nearest = append(nearest, uid)
+		}
+	}
+	sort.Slice(nearest, func(i, j int) bool {
+		di, dj := dists[nearest[i]], dists[nearest[j]]
+		if di != dj {
+			return di < dj
+		}
+		return nearest[i] < nearest[j]
+	})
+	if len(nearest) > srcFn.numNeighbors {
+		nearest = nearest[:srcFn.numNeighbors]
+	}
+	sort.Slice(nearest, func(i, j int) bool { return nearest[i] < nearest[j] })
+	if q.GeoDistances {
+		arg.out.GeoDistances = make(map[uint64]float64, len(nearest))
+		for _, uid := range nearest {
+			arg.out.GeoDistances[uid] = dists[uid]
+		}
+	}
+	span.AddEvent("knn_geo result", trace.WithAttributes(
+		attribute.Int("candidates", len(dists)),
+		attribute.Int("result_count", len(nearest))))
+	arg.out.UidMatrix = append(arg.out.UidMatrix, &pb.List{Uids: nearest})
+	return nil
+}
+
+// geoDistance returns the distance in meters from the point to the closest geo value of the uid,
+// or +Inf if it has none.
+func (qs *queryState) geoDistance(q *pb.Query, uid uint64, point *geom.Point) (float64, error) {
+	pl, err := qs.cache.Get(x.DataKey(q.Attr, uid))
+	if err != nil {
+		return 0, err
+	}
+	dist := math.Inf(1)
+	err = pl.Iterate(q.ReadTs, 0, func(p *pb.Posting) error {
+		if types.TypeID(p.ValType) != types.GeoID {
+			return nil
+		}
+		val, err := types.Convert(types.Val{Tid: types.BinaryID, Value: p.Value}, types.GeoID)
+		if err != nil {
+			return nil
+		}
+		if d, err := types.GeoDistance(val.Value.(geom.T), point); err == nil {
+			dist = math.Min(dist, d)
+		}
+		return nil
+	})
+	return dist, err
+}
+
 func (qs *queryState) filterGeoFunction(ctx context.Context, arg funcArgs) error {
 	span := trace.SpanFromContext(ctx)
 	stop := x.SpanTimer(span, "filterGeoFunction")
@@ -1633,11 +1778,16 @@ func (qs *queryState) filterGeoFunction(ctx context.Context, arg funcArgs) error
 		attribute.Int("num_go", numGo),
 		attribute.Int("width", width)))
 
+	// The distances of a near function are computed while matching the values, if asked for.
+	withDistances := arg.q.GeoDistances && arg.srcFn.fname == "near"
 	eg, egCtx := errgroup.WithContext(ctx)
 	filtered := make([]*pb.List, numGo)
+	distances := make([]map[uint64]float64, numGo)
 	filter := func(idx, start, end int) error {
 		filtered[idx] = &pb.List{}
 		out := filtered[idx]
+		dists := make(map[uint64]float64)
+		distances[idx] = dists
 		for i := start; i < end; i++ {
 			uid := uids.Uids[i]
 			if i%100 == 0 {
@@ -1655,9 +1805,23 @@ func (qs *queryState) filterGeoFunction(ctx context.Context, arg funcArgs) error
 			err = pl.Iterate(arg.q.ReadTs, 0, func(p *pb.Posting) error {
 				tv.ValType = p.ValType
 				tv.Val = p.Value
-				if types.MatchGeo(&tv, arg.srcFn.geoQuery) {
+				if !withDistances {
+					if types.MatchGeo(&tv, arg.srcFn.geoQuery) {
+						out.Uids = append(out.Uids, uid)
+						return posting.ErrStopIteration
+					}
+					return nil
+				}
+				// The distance of a uid with several values is the one of the closest value.
+				d, ok := types.MatchGeoDistance(&tv, arg.srcFn.geoQuery)
+				if !ok {
+					return nil
+				}
+				if prev, seen := dists[uid]; !seen {
 					out.Uids = append(out.Uids, uid)
-					return posting.ErrStopIteration
+					dists[uid] = d
+				} else {
+					dists[uid] = math.Min(prev, d)
 				}
 				return nil
 			})
@@ -1691,6 +1855,12 @@ func (qs *queryState) filterGeoFunction(ctx context.Context, arg funcArgs) error
 	for i := range arg.out.UidMatrix {
 		algo.IntersectWith(arg.out.UidMatrix[i], final, arg.out.UidMatrix[i])
 	}
+	if withDistances {
+		arg.out.GeoDistances = make(map[uint64]float64, len(final.Uids))
+		for _, dists := range distances {
+			maps.Copy(arg.out.GeoDistances, dists)
+		}
+	}
 	return nil
 }
 
@@ -1756,11 +1926,13 @@ func (qs *queryState) filterStringFunction(arg funcArgs) error {
 		filter.tokens = arg.srcFn.tokens
 		filter.match = defaultMatch
 		filter.tokName = "fulltext"
+		filter.tokenizer = arg.srcFn.tokenizer
 		filtered = matchStrings(filtered, values, &filter)
 	case standardFn:
 		filter.tokens = arg.srcFn.tokens
 		filter.match = defaultMatch
 		filter.tokName = "term"
+		filter.tokenizer = arg.srcFn.tokenizer
 		filtered = matchStrings(filtered, values, &filter)
 	case customIndexFn:
 		filter.tokens = arg.srcFn.tokens
@@ -1831,10 +2003,20 @@ type functionContext struct {
 	isStringFn     bool
 	atype          types.TypeID
 	vectorInfo     []float32
-	vectorUid      uint64
+	// facet, facetOp and facetLossy are used by facet functions.
+	facet      *pb.FacetSchema
+	facetOp    string
+	facetLossy bool
+	vectorUid  uint64
 	// Optional vector search options parsed from a 3rd arg on similar_to
 	vsEfOverride        int
 	vsDistanceThreshold *float64
+	// tokenizer is the fulltext or term tokenizer of the predicate if it analyzes the text with
+	// an analyzer declared in the schema.
+	tokenizer tok.Tokenizer
+	// geoPoint and numNeighbors are used by knn_geo.
+	geoPoint     *geom.Point
+	numNeighbors int
 }
 
 const (
@@ -1926,6 +2108,10 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 	isIndexedAttr := schema.State().IsIndexed(ctx, attr)
 	var err error
 
+	if err := encryptFuncArgs(ctx, q, fnType, f); err != nil {
+		return nil, err
+	}
+
 	t, err := schema.State().TypeOf(attr)
 	if err == nil && fnType != notAFunction && t.Name() == types.StringID.Name() {
 		fc.isStringFn = true
@@ -2068,6 +2254,10 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		}
 		fc.threshold = thresholds
 		checkRoot(q, fc)
+	case facetFn:
+		if err := parseFacetFn(ctx, q, fc); err != nil {
+			return nil, err
+		}
 	case geoFn:
 		// For geo functions, we get extra information used for filtering.
 		fc.tokens, fc.geoQuery, err = types.GetGeoTokens(q.SrcFunc)
@@ -2092,7 +2282,9 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 			return nil, errors.Errorf("Attribute %s is not indexed with type %s", x.ParseAttr(attr),
 				required)
 		}
-		if fc.tokens, err = getStringTokens(q.SrcFunc.Args, langForFunc(q.Langs), fnType, true); err != nil {
+		fc.tokenizer, _ = stringTokenizer(ctx, attr, fnType)
+		if fc.tokens, err = getStringTokens(fc.tokenizer, q.SrcFunc.Args, langForFunc(q.Langs),
+			fnType, true); err != nil {
 			return nil, err
 		}
 		fc.intersectDest = needsIntersect(f)
@@ -2183,6 +2375,31 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 				return nil, err
 			}
 		}
+	case knnGeoFn:
+		// knn_geo(pred, point, k) or knn_geo(pred, point, k, unit), the unit being the one of the
+		// distances read with geodist.
+		if len(q.SrcFunc.Args) != 2 && len(q.SrcFunc.Args) != 3 {
+			return nil, errors.Errorf("Function '%s' requires 2 or 3 arguments, but got %d (%v)",
+				q.SrcFunc.Name, len(q.SrcFunc.Args), q.SrcFunc.Args)
+		}
+		if len(q.SrcFunc.Args) == 3 {
+			if _, err := types.DistanceUnit(q.SrcFunc.Args[2]); err != nil {
+				return nil, err
+			}
+		}
+		if t != types.GeoID {
+			return nil, errors.Errorf("Function '%s' requires a predicate of type geo, but %s is "+
+				"of type %s", q.SrcFunc.Name, x.ParseAttr(attr), t.Name())
+		}
+		if fc.geoPoint, err = types.ParseGeoPoint(q.SrcFunc.Args[0]); err != nil {
+			return nil, err
+		}
+		k, err := strconv.Atoi(q.SrcFunc.Args[1])
+		if err != nil || k <= 0 {
+			return nil, errors.Errorf("Invalid number of neighbors %q in %s, expected a positive "+
+				"integer", q.SrcFunc.Args[1], q.SrcFunc.Name)
+		}
+		fc.numNeighbors = k
 	case uidInFn:
 		for _, arg := range q.SrcFunc.Args {
 			uidParsed, err := strconv.ParseUint(arg, 0, 64)
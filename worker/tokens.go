@@ -58,10 +58,16 @@ func verifyCustomIndex(ctx context.Context, attr string, tokenizerName string) b
 
 // Return string tokens from function arguments. It maps function type to correct tokenizer.
 // Note: regexp functions require regexp compilation of argument, not tokenization.
-func getStringTokens(funcArgs []string, lang string, funcType FuncType, query bool) ([]string, error) {
+// getStringTokens returns the tokens of the function arguments. The tokenizer analyzes the text
+// with an analyzer declared in the schema, or is nil for the built-in analysis.
+func getStringTokens(tokenizer tok.Tokenizer, funcArgs []string, lang string, funcType FuncType,
+	query bool) ([]string, error) {
 	if lang == "." {
 		lang = "en"
 	}
+	if tokenizer != nil {
+		return tok.BuildTokens(funcArgs[0], tok.GetTokenizerForLang(tokenizer, lang))
+	}
 	if funcType == fullTextSearchFn {
 		return tok.GetFullTextTokens(funcArgs, lang)
 	}
@@ -75,6 +81,27 @@ func getStringTokens(funcArgs []string, lang string, funcType FuncType, query bo
 	return tok.GetTermTokens(funcArgs)
 }
 
+// stringTokenizer returns the fulltext or term tokenizer of the predicate for the function, if the
+// tokenizer analyzes the text with an analyzer declared in the schema. The tokens of the query
+// must then be built by the same analyzer as the tokens of the index.
+func stringTokenizer(ctx context.Context, attr string, funcType FuncType) (tok.Tokenizer, bool) {
+	var id byte
+	switch funcType {
+	case fullTextSearchFn:
+		id = tok.IdentFullText
+	case standardFn:
+		id = tok.IdentTerm
+	default:
+		return nil, false
+	}
+	for _, t := range schema.State().Tokenizer(ctx, attr) {
+		if t.Identifier() == id && tok.HasAnalyzer(t) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
 func pickTokenizer(ctx context.Context, attr string, f string) (tok.Tokenizer, error) {
 	// Get the tokenizers and choose the corresponding one.
 	if !schema.State().IsIndexed(ctx, attr) {
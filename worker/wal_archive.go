@@ -0,0 +1,754 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/klauspost/compress/s2"
+	"github.com/pkg/errors"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/dgraph/v25/enc"
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/x"
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+const (
+	// walArchiveDir is the directory of the backup location where the commits are archived.
+	walArchiveDir = "wal"
+
+	// walArchiveNameFmt defines the name of the archive segments. The first parameter is the group
+	// ID, the other two are the lowest and the highest commit timestamps in the segment.
+	walArchiveNameFmt = `g%d-c%d-c%d.wal`
+
+	// walArchiveTmpSuffix is appended to the name of a segment while it's being written, so that
+	// restores never read a partial segment.
+	walArchiveTmpSuffix = ".tmp"
+)
+
+// walArchiver archives the commits of the group to a backup location, so that a restore can
+// replay them on top of a backup to recover the state of the cluster at any point in time. Like
+// CDC, it reads the Raft WAL on the leader: the mutations of a transaction are kept in memory
+// until its commit or abort shows up in an Oracle delta, and the commits are written every
+// interval in a segment of the archive.
+type walArchiver struct {
+	sync.Mutex
+	handler  UriHandler
+	interval time.Duration
+	closer   *z.Closer
+
+	// pendingTxns are the mutations of the transactions that haven't been committed or aborted
+	// yet, by start ts.
+	pendingTxns map[uint64]*pb.Mutations
+	// commitTimes are the unix times in nanoseconds at which the commits were applied, by commit
+	// ts. They're recorded on every node, so that a new leader archives the commits that the
+	// previous one didn't with the time they were applied at.
+	commitTimes map[uint64]int64
+	// archivedTs is the max commit ts that the group has archived. It's read from the backup
+	// location when the node becomes the leader, so that the commits archived by the previous
+	// leader are skipped.
+	archivedTs uint64
+	loaded     bool
+
+	// seenIndex is the Raft index until which the commits have been archived. Use atomics.
+	seenIndex uint64
+}
+
+func newWALArchiver() *walArchiver {
+	if Config.WALArchiveConf == "" || Config.WALArchiveConf == WALArchiveDefaults {
+		return nil
+	}
+	flag := z.NewSuperFlag(Config.WALArchiveConf).MergeAndCheckDefault(WALArchiveDefaults)
+	dest := flag.GetString("destination")
+	if dest == "" {
+		return nil
+	}
+	uri, err := url.Parse(dest)
+	x.Check(err)
+	handler, err := NewUriHandler(uri, nil)
+	x.Check(err)
+	x.Check(handler.CreateDir(walArchiveDir))
+
+	interval := flag.GetDuration("interval")
+	x.AssertTruef(interval > 0, "interval of the WAL archive must be positive")
+	return &walArchiver{
+		handler:     handler,
+		interval:    interval,
+		closer:      z.NewCloser(1),
+		pendingTxns: make(map[uint64]*pb.Mutations),
+		commitTimes: make(map[uint64]int64),
+	}
+}
+
+// recordApplied records the time at which the commits of the proposal are applied, which is
+// archived as their commit time. The entries applied again after a restart get the time of the
+// restart, if they weren't archived before it.
+func (wa *walArchiver) recordApplied(proposal *pb.Proposal) {
+	if wa == nil {
+		return
+	}
+	now := time.Now().UnixNano()
+	wa.Lock()
+	defer wa.Unlock()
+	if m := proposal.Mutations; m != nil && appliedWithoutCommit(m) {
+		wa.commitTimes[m.StartTs] = now
+	}
+	if proposal.Delta != nil {
+		for _, status := range proposal.Delta.Txns {
+			if status.CommitTs > 0 {
+				wa.commitTimes[status.CommitTs] = now
+			}
+		}
+	}
+}
+
+// pruneCommitTimes drops the times of the commits at or below the read ts of a snapshot, which
+// have been archived before the snapshot was taken.
+func (wa *walArchiver) pruneCommitTimes(readTs uint64) {
+	if wa == nil {
+		return
+	}
+	wa.Lock()
+	defer wa.Unlock()
+	for ts := range wa.commitTimes {
+		if ts <= readTs {
+			delete(wa.commitTimes, ts)
+		}
+	}
+}
+
+// getSeenIndex returns the Raft index until which the entries can be discarded by a snapshot.
+func (wa *walArchiver) getSeenIndex() uint64 {
+	if wa == nil {
+		return math.MaxUint64
+	}
+	return atomic.LoadUint64(&wa.seenIndex)
+}
+
+// getTs returns the min start ts of the pending transactions, whose mutations must be kept in
+// the Raft WAL until they are archived.
+func (wa *walArchiver) getTs() uint64 {
+	if wa == nil {
+		return math.MaxUint64
+	}
+	wa.Lock()
+	defer wa.Unlock()
+	min := uint64(math.MaxUint64)
+	for startTs := range wa.pendingTxns {
+		min = x.Min(min, startTs)
+	}
+	return min
+}
+
+// reset forgets the state of the archiver, which reads the WAL again from its first index the
+// next time it runs as the leader.
+func (wa *walArchiver) reset() {
+	wa.Lock()
+	defer wa.Unlock()
+	wa.pendingTxns = make(map[uint64]*pb.Mutations)
+	wa.archivedTs = 0
+	wa.loaded = false
+	atomic.StoreUint64(&wa.seenIndex, 0)
+}
+
+func (wa *walArchiver) Close() {
+	if wa == nil {
+		return
+	}
+	glog.Infof("closing WAL archiver...")
+	wa.closer.SignalAndWait()
+}
+
+func (wa *walArchiver) run() {
+	if wa == nil {
+		return
+	}
+	defer wa.closer.Done()
+
+	ticker := time.NewTicker(wa.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-wa.closer.HasBeenClosed():
+			return
+		case <-ticker.C:
+			if !groups().Node.AmLeader() {
+				if atomic.LoadUint64(&wa.seenIndex) > 0 {
+					wa.reset()
+				}
+				continue
+			}
+			if err := wa.archive(); err != nil {
+				glog.Errorf("WAL archive: %v", err)
+				wa.reset()
+			}
+		}
+	}
+}
+
+// archive writes the commits of the entries applied since the last run to a new segment.
+func (wa *walArchiver) archive() error {
+	n := groups().Node
+	gid := groups().groupId()
+	if !wa.loaded {
+		wa.archivedTs, wa.loaded = latestArchivedTs(wa.handler, gid), true
+	}
+
+	first, err := n.Store.FirstIndex()
+	if err != nil {
+		return err
+	}
+	idx := x.Max(atomic.LoadUint64(&wa.seenIndex)+1, first)
+	last := n.Applied.DoneUntil()
+	if idx > last {
+		return nil
+	}
+
+	var txns []*pb.ArchivedTxn
+	for batchFirst := idx; batchFirst <= last; {
+		entries, err := n.Store.Entries(batchFirst, last+1, 256<<20)
+		if err != nil {
+			return errors.Wrapf(err, "failed to retrieve entries from Raft. Start: %d End: %d",
+				batchFirst, last+1)
+		}
+		if len(entries) == 0 {
+			break
+		}
+		batchFirst = entries[len(entries)-1].Index + 1
+		for _, entry := range entries {
+			txns = wa.handleEntry(entry, txns)
+		}
+	}
+
+	if len(txns) > 0 {
+		if err := writeArchiveSegment(wa.handler, gid, txns, EncryptionKey()); err != nil {
+			return err
+		}
+		wa.setArchived(txns)
+		glog.V(2).Infof("WAL archive: archived %d commits of group %d until ts %d",
+			len(txns), gid, wa.archivedTs)
+	}
+	atomic.StoreUint64(&wa.seenIndex, last)
+	return nil
+}
+
+// setArchived records the commits of a segment once it's written. Their times are kept until
+// then, for them to be archived with the same times again if writing the segment fails.
+func (wa *walArchiver) setArchived(txns []*pb.ArchivedTxn) {
+	wa.Lock()
+	defer wa.Unlock()
+	for _, txn := range txns {
+		wa.archivedTs = x.Max(wa.archivedTs, txn.CommitTs)
+		delete(wa.commitTimes, txn.CommitTs)
+	}
+}
+
+// handleEntry appends the commits of the Raft entry to txns. The drop operations, the schema
+// updates and the predicate deletions are applied at their start ts without a commit, so they
+// are archived right away.
+func (wa *walArchiver) handleEntry(entry raftpb.Entry, txns []*pb.ArchivedTxn) []*pb.ArchivedTxn {
+	if entry.Type != raftpb.EntryNormal || len(entry.Data) < 8 {
+		return txns
+	}
+	var proposal pb.Proposal
+	if err := proto.Unmarshal(entry.Data[8:], &proposal); err != nil {
+		glog.Warningf("WAL archive: unmarshal failed with error %v. Ignoring.", err)
+		return txns
+	}
+
+	wa.Lock()
+	defer wa.Unlock()
+	add := func(commitTs uint64, m *pb.Mutations) {
+		// The commits that the group has already archived are read again after a change of leader.
+		if commitTs <= wa.archivedTs {
+			return
+		}
+		commitTime, ok := wa.commitTimes[commitTs]
+		if !ok {
+			// Only read up to the applied index, the entry has a time unless a snapshot at a
+			// later ts pruned it.
+			commitTime = time.Now().UnixNano()
+		}
+		txns = append(txns, &pb.ArchivedTxn{
+			CommitTs:   commitTs,
+			CommitTime: commitTime,
+			Mutations:  m,
+		})
+	}
+
+	if m := proposal.Mutations; m != nil {
+		switch {
+		case m.DropOp != pb.Mutations_NONE:
+			switch m.DropOp {
+			case pb.Mutations_ALL:
+				// A drop all cancels all the pending transactions.
+				wa.pendingTxns = make(map[uint64]*pb.Mutations)
+			case pb.Mutations_DATA, pb.Mutations_ALL_IN_NS:
+				if ns, err := strconv.ParseUint(m.DropValue, 0, 64); err == nil {
+					wa.dropPendingForNs(ns)
+				}
+			}
+			add(m.StartTs, m)
+		case isDropPredicate(m):
+			// The deletion of a predicate fails if a transaction on it is pending.
+			if !wa.hasPending(m.Edges[0].Attr) {
+				add(m.StartTs, m)
+			}
+		case appliedWithoutCommit(m):
+			add(m.StartTs, m)
+		default:
+			if p, ok := wa.pendingTxns[m.StartTs]; ok {
+				p.Edges = append(p.Edges, m.Edges...)
+				for attr, hint := range m.GetMetadata().GetPredHints() {
+					if p.Metadata == nil {
+						p.Metadata = &pb.Metadata{}
+					}
+					if p.Metadata.PredHints == nil {
+						p.Metadata.PredHints = make(map[string]pb.Metadata_HintType)
+					}
+					p.Metadata.PredHints[attr] = hint
+				}
+			} else {
+				wa.pendingTxns[m.StartTs] = m
+			}
+		}
+	}
+
+	if proposal.Delta != nil {
+		for _, status := range proposal.Delta.Txns {
+			m, ok := wa.pendingTxns[status.StartTs]
+			if !ok {
+				continue
+			}
+			delete(wa.pendingTxns, status.StartTs)
+			if status.CommitTs > 0 {
+				add(status.CommitTs, m)
+			}
+		}
+	}
+	return txns
+}
+
+func isDropPredicate(m *pb.Mutations) bool {
+	return len(m.Edges) == 1 && m.Edges[0].Entity == 0 &&
+		bytes.Equal(m.Edges[0].Value, []byte(x.Star))
+}
+
+// appliedWithoutCommit returns true for the mutations that are applied at their start ts without
+// being committed: the drop operations, the schema updates and the predicate deletions.
+func appliedWithoutCommit(m *pb.Mutations) bool {
+	return m.DropOp != pb.Mutations_NONE || len(m.Schema) > 0 || len(m.Types) > 0 ||
+		isDropPredicate(m)
+}
+
+func (wa *walArchiver) hasPending(attr string) bool {
+	for _, m := range wa.pendingTxns {
+		for _, edge := range m.Edges {
+			if edge.Attr == attr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (wa *walArchiver) dropPendingForNs(ns uint64) {
+	for ts, m := range wa.pendingTxns {
+		if len(m.Edges) > 0 && x.ParseNamespace(m.Edges[0].Attr) == ns {
+			delete(wa.pendingTxns, ts)
+		}
+	}
+}
+
+func walArchiveName(gid uint32, firstTs, lastTs uint64) string {
+	return filepath.Join(walArchiveDir, fmt.Sprintf(walArchiveNameFmt, gid, firstTs, lastTs))
+}
+
+// writeArchiveSegment writes the commits to a new segment of the archive, encrypted and
+// compressed like the backups.
+func writeArchiveSegment(h UriHandler, gid uint32, txns []*pb.ArchivedTxn, key x.Sensitive) error {
+	firstTs, lastTs := uint64(math.MaxUint64), uint64(0)
+	for _, txn := range txns {
+		firstTs = x.Min(firstTs, txn.CommitTs)
+		lastTs = x.Max(lastTs, txn.CommitTs)
+	}
+	name := walArchiveName(gid, firstTs, lastTs)
+	tmp := name + walArchiveTmpSuffix
+
+	w, err := h.CreateFile(tmp)
+	if err != nil {
+		return errors.Wrap(err, "while creating WAL archive segment")
+	}
+	eWriter, err := enc.GetWriter(key, w)
+	if err != nil {
+		return err
+	}
+	cWriter := s2.NewWriter(eWriter)
+	for _, txn := range txns {
+		if err := writeArchivedTxn(txn, cWriter); err != nil {
+			return errors.Wrap(err, "while writing WAL archive segment")
+		}
+	}
+	if err := cWriter.Close(); err != nil {
+		return errors.Wrap(err, "while writing WAL archive segment")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, "while writing WAL archive segment")
+	}
+	return errors.Wrap(h.Rename(tmp, name), "while writing WAL archive segment")
+}
+
+func writeArchivedTxn(txn *pb.ArchivedTxn, w io.Writer) error {
+	buf, err := proto.Marshal(txn)
+	if err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint64(len(buf))); err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
+
+type archiveSegment struct {
+	path    string
+	gid     uint32
+	firstTs uint64
+	lastTs  uint64
+}
+
+// archiveSegments returns the segments of the group in the archive, sorted by their commits.
+func archiveSegments(h UriHandler, gid uint32) []archiveSegment {
+	if !h.DirExists(walArchiveDir) {
+		return nil
+	}
+	var segments []archiveSegment
+	for _, p := range h.ListPaths(walArchiveDir) {
+		name := filepath.Base(p)
+		if strings.HasSuffix(name, walArchiveTmpSuffix) {
+			continue
+		}
+		var s archiveSegment
+		if _, err := fmt.Sscanf(name, walArchiveNameFmt, &s.gid, &s.firstTs, &s.lastTs); err != nil ||
+			s.gid != gid {
+			continue
+		}
+		s.path = walArchiveName(s.gid, s.firstTs, s.lastTs)
+		segments = append(segments, s)
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		if segments[i].firstTs != segments[j].firstTs {
+			return segments[i].firstTs < segments[j].firstTs
+		}
+		return segments[i].lastTs < segments[j].lastTs
+	})
+	return segments
+}
+
+// latestArchivedTs returns the max commit ts archived by the group.
+func latestArchivedTs(h UriHandler, gid uint32) uint64 {
+	var ts uint64
+	for _, s := range archiveSegments(h, gid) {
+		ts = x.Max(ts, s.lastTs)
+	}
+	return ts
+}
+
+// readArchivedTxns returns the commits of the group archived with a commit ts in
+// (sinceTs, toTs], in the order in which the group applied them.
+func readArchivedTxns(h UriHandler, gid uint32, sinceTs, toTs uint64,
+	key x.Sensitive) ([]*pb.ArchivedTxn, error) {
+
+	var txns []*pb.ArchivedTxn
+	for _, s := range archiveSegments(h, gid) {
+		if s.lastTs <= sinceTs || s.firstTs > toTs {
+			continue
+		}
+		err := readArchiveSegment(h, s.path, key, func(txn *pb.ArchivedTxn) {
+			if txn.CommitTs > sinceTs && txn.CommitTs <= toTs {
+				txns = append(txns, txn)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return txns, nil
+}
+
+// readArchiveSegment calls fn for the commits of the segment. The segments written before the
+// encryption key was rotated are decrypted with the retired versions of the key.
+func readArchiveSegment(h UriHandler, path string, key x.Sensitive,
+	fn func(txn *pb.ArchivedTxn)) error {
+
+	var err error
+	for _, k := range archiveKeys(key) {
+		var txns []*pb.ArchivedTxn
+		if err = readArchiveSegmentWithKey(h, path, k, func(txn *pb.ArchivedTxn) {
+			txns = append(txns, txn)
+		}); err == nil {
+			for _, txn := range txns {
+				fn(txn)
+			}
+			return nil
+		}
+	}
+	return err
+}
+
+func readArchiveSegmentWithKey(h UriHandler, path string, key x.Sensitive,
+	fn func(txn *pb.ArchivedTxn)) error {
+
+	br := readerFrom(h, path).WithEncryption(key).WithCompression("snappy")
+	if br.err != nil {
+		return errors.Wrapf(br.err, "while reading WAL archive segment %s", path)
+	}
+	defer br.Close()
+
+	r := bufio.NewReader(br)
+	for {
+		var sz uint64
+		err := binary.Read(r, binary.LittleEndian, &sz)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "while reading WAL archive segment %s", path)
+		}
+		buf := make([]byte, sz)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return errors.Wrapf(err, "while reading WAL archive segment %s", path)
+		}
+		txn := &pb.ArchivedTxn{}
+		if err := proto.Unmarshal(buf, txn); err != nil {
+			return errors.Wrapf(err, "while reading WAL archive segment %s", path)
+		}
+		fn(txn)
+	}
+}
+
+// archivedTsAt returns the max commit ts archived by any of the groups at or before the given
+// time. The time of a commit is the time at which the group applied it.
+func archivedTsAt(h UriHandler, groups []uint32, t time.Time, key x.Sensitive) (uint64, error) {
+	var ts uint64
+	for _, gid := range groups {
+		for _, s := range archiveSegments(h, gid) {
+			if s.lastTs <= ts {
+				continue
+			}
+			err := readArchiveSegment(h, s.path, key, func(txn *pb.ArchivedTxn) {
+				if txn.CommitTime <= t.UnixNano() {
+					ts = x.Max(ts, txn.CommitTs)
+				}
+			})
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	return ts, nil
+}
+
+// archiveHandler returns the handler of the backup location of a point-in-time restore, and the
+// key to decrypt the archived commits.
+func archiveHandler(req *pb.RestoreRequest) (UriHandler, *url.URL, x.Sensitive, error) {
+	uri, err := url.Parse(req.Location)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	h, err := NewUriHandler(uri, getCredentialsFromRestoreRequest(req))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	key, err := getRestoreEncKey(req)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return h, uri, key, nil
+}
+
+// resolveRestoreToTs checks the options of a point-in-time restore, and for a restore to a
+// restore_to_time, sets its restore_to_ts to the latest backup or archived commit at that time.
+func resolveRestoreToTs(req *pb.RestoreRequest, groups []uint32) error {
+	if req.RestoreToTs == 0 && req.RestoreToTime == "" {
+		return nil
+	}
+	switch {
+	case req.RestoreToTs > 0 && req.RestoreToTime != "":
+		return errors.Errorf("restoreToTs and restoreToTime cannot be both set")
+	case req.BackupNum > 0:
+		return errors.Errorf("a point-in-time restore cannot be limited to a backupNum")
+	case req.IsNamespaceAwareRestore:
+		return errors.Errorf("a point-in-time restore cannot restore a single namespace")
+	}
+	if req.RestoreToTime == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, req.RestoreToTime)
+	if err != nil {
+		return errors.Wrapf(err, "invalid restoreToTime %q", req.RestoreToTime)
+	}
+
+	h, uri, key, err := archiveHandler(req)
+	if err != nil {
+		return err
+	}
+	var ts uint64
+	manifest, err := GetManifest(h, uri)
+	if err != nil {
+		return err
+	}
+	for _, m := range manifest.Manifests {
+		if len(req.BackupId) > 0 && m.BackupId != req.BackupId {
+			continue
+		}
+		bt, err := parseBackupTime(m.Path)
+		if err != nil || bt.After(t) {
+			continue
+		}
+		ts = x.Max(ts, m.ValidReadTs())
+	}
+	archivedTs, err := archivedTsAt(h, groups, t, key)
+	if err != nil {
+		return err
+	}
+	ts = x.Max(ts, archivedTs)
+	if ts == 0 {
+		return errors.Errorf("no backup or archived commit at or before %s", req.RestoreToTime)
+	}
+	req.RestoreToTs = ts
+	return nil
+}
+
+// leaseReplayTs leases the timestamps used to replay the archived commits of a point-in-time
+// restore, two for each commit, and returns the first timestamp of each group.
+func leaseReplayTs(ctx context.Context, req *pb.RestoreRequest,
+	groups []uint32) (map[uint32]uint64, error) {
+
+	if req.RestoreToTs == 0 {
+		return nil, nil
+	}
+	h, uri, key, err := archiveHandler(req)
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := getManifestsToRestore(h, uri, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(manifests) == 0 {
+		return nil, errors.Errorf("no backup before ts %d", req.RestoreToTs)
+	}
+	sinceTs := manifests[0].ValidReadTs()
+
+	counts := make(map[uint32]uint64)
+	var total uint64
+	for _, gid := range groups {
+		txns, err := readArchivedTxns(h, gid, sinceTs, req.RestoreToTs, key)
+		if err != nil {
+			return nil, err
+		}
+		counts[gid] = uint64(len(txns))
+		total += counts[gid]
+	}
+	if total == 0 {
+		return nil, nil
+	}
+	ts, err := Timestamps(ctx, &pb.Num{Val: 2 * total})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot lease timestamps to replay archived commits")
+	}
+	replayTs := make(map[uint32]uint64)
+	next := ts.StartId
+	for _, gid := range groups {
+		replayTs[gid] = next
+		next += 2 * counts[gid]
+	}
+	glog.Infof("Replaying %d archived commits after ts %d until ts %d", total, sinceTs,
+		req.RestoreToTs)
+	return replayTs, nil
+}
+
+// replayArchivedTxns applies the commits of the group archived after sinceTs and up to the
+// restore_to_ts of the request on top of the restored backups. Each commit is applied at a new
+// start ts and committed at the next one, both leased for this restore starting at replay_ts.
+func replayArchivedTxns(ctx context.Context, req *pb.RestoreRequest, h UriHandler, sinceTs uint64,
+	key x.Sensitive) (*mapResult, error) {
+
+	txns, err := readArchivedTxns(h, req.GroupId, sinceTs, req.RestoreToTs, key)
+	if err != nil {
+		return nil, err
+	}
+	res := &mapResult{}
+	if len(txns) == 0 {
+		return res, nil
+	}
+	if req.ReplayTs == 0 {
+		return nil, errors.Errorf("no timestamps leased to replay %d archived commits", len(txns))
+	}
+
+	n := groups().Node
+	for i, txn := range txns {
+		startTs := req.ReplayTs + 2*uint64(i)
+		m := txn.Mutations
+		m.StartTs = startTs
+		m.GroupId = req.GroupId
+		for _, edge := range m.Edges {
+			res.maxUid = x.Max(res.maxUid, x.Max(edge.Entity, edge.ValueId))
+			res.maxNs = x.Max(res.maxNs, x.ParseNamespace(edge.Attr))
+		}
+
+		// The operations that fail when they're first applied, like the deletion of a predicate
+		// with pending transactions, aren't archived. So a commit that fails here fails the
+		// restore, which would miss it otherwise.
+		if err := n.applyMutations(ctx, &pb.Proposal{Mutations: m, StartTs: startTs}); err != nil {
+			return nil, errors.Wrapf(err, "while applying archived commit at ts %d", txn.CommitTs)
+		}
+		if appliedWithoutCommit(m) {
+			continue
+		}
+		err := n.commitOrAbort(0, &pb.OracleDelta{
+			Txns: []*pb.TxnStatus{{StartTs: startTs, CommitTs: startTs + 1}},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "while committing archived commit at ts %d", txn.CommitTs)
+		}
+	}
+	glog.Infof("Replayed %d archived commits of group %d until ts %d", len(txns), req.GroupId,
+		req.RestoreToTs)
+	return res, nil
+}
+
+// getRestoreEncKey returns the key to decrypt the backups of the restore request.
+func getRestoreEncKey(req *pb.RestoreRequest) (x.Sensitive, error) {
+	cfg, err := getEncConfig(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get encryption config")
+	}
+	keys, err := x.GetEncAclKeys(cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get encryption keys")
+	}
+	return keys.EncKey, nil
+}
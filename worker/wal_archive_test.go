@@ -0,0 +1,226 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/raft/v3/raftpb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+	"github.com/dgraph-io/dgraph/v25/x"
+)
+
+func walEntry(t *testing.T, index uint64, proposal *pb.Proposal) raftpb.Entry {
+	data, err := proto.Marshal(proposal)
+	require.NoError(t, err)
+	return raftpb.Entry{Index: index, Type: raftpb.EntryNormal, Data: append(make([]byte, 8), data...)}
+}
+
+func walEdge(uid uint64, attr string) *pb.DirectedEdge {
+	return &pb.DirectedEdge{Entity: uid, Attr: x.AttrInRootNamespace(attr), Value: []byte("v")}
+}
+
+func TestWALArchiverHandleEntry(t *testing.T) {
+	wa := &walArchiver{pendingTxns: make(map[uint64]*pb.Mutations)}
+	var txns []*pb.ArchivedTxn
+	entries := []*pb.Proposal{
+		{Mutations: &pb.Mutations{StartTs: 10, Edges: []*pb.DirectedEdge{walEdge(1, "name")}}},
+		{Mutations: &pb.Mutations{StartTs: 10, Edges: []*pb.DirectedEdge{walEdge(2, "name")}}},
+		{Mutations: &pb.Mutations{StartTs: 11, Edges: []*pb.DirectedEdge{walEdge(3, "age")}}},
+		{Mutations: &pb.Mutations{StartTs: 12, Edges: []*pb.DirectedEdge{walEdge(4, "name")}}},
+		{Mutations: &pb.Mutations{StartTs: 13, Schema: []*pb.SchemaUpdate{
+			{Predicate: x.AttrInRootNamespace("email")}}}},
+		{Delta: &pb.OracleDelta{Txns: []*pb.TxnStatus{
+			{StartTs: 10, CommitTs: 14},
+			{StartTs: 11, CommitTs: 0},
+		}}},
+	}
+	for i, p := range entries {
+		txns = wa.handleEntry(walEntry(t, uint64(i+1), p), txns)
+	}
+
+	// The schema update is archived right away, the aborted transaction is dropped and the
+	// transaction at 12 is still pending.
+	require.Len(t, txns, 2)
+	require.Equal(t, uint64(13), txns[0].CommitTs)
+	require.Len(t, txns[0].Mutations.Schema, 1)
+	require.Equal(t, uint64(14), txns[1].CommitTs)
+	require.Len(t, txns[1].Mutations.Edges, 2)
+	require.Equal(t, uint64(12), wa.getTs())
+
+	// The deletion of a predicate with a pending transaction fails, so it isn't archived.
+	drop := &pb.Mutations{StartTs: 15, Edges: []*pb.DirectedEdge{
+		{Attr: x.AttrInRootNamespace("name"), Value: []byte(x.Star)}}}
+	txns = wa.handleEntry(walEntry(t, 7, &pb.Proposal{Mutations: drop}), nil)
+	require.Empty(t, txns)
+
+	// A drop all cancels the pending transactions.
+	dropAll := &pb.Mutations{StartTs: 16, DropOp: pb.Mutations_ALL}
+	txns = wa.handleEntry(walEntry(t, 8, &pb.Proposal{Mutations: dropAll}), nil)
+	require.Len(t, txns, 1)
+	require.Equal(t, uint64(16), txns[0].CommitTs)
+	require.Empty(t, wa.pendingTxns)
+
+	// The commits already archived by the group are skipped.
+	wa.archivedTs = 20
+	txns = wa.handleEntry(walEntry(t, 9, &pb.Proposal{Mutations: &pb.Mutations{
+		StartTs: 17, Edges: []*pb.DirectedEdge{walEdge(5, "name")}}}), nil)
+	txns = wa.handleEntry(walEntry(t, 10, &pb.Proposal{Delta: &pb.OracleDelta{
+		Txns: []*pb.TxnStatus{{StartTs: 17, CommitTs: 18}}}}), txns)
+	require.Empty(t, txns)
+}
+
+func TestWALArchiverCommitTime(t *testing.T) {
+	wa := &walArchiver{
+		pendingTxns: make(map[uint64]*pb.Mutations),
+		commitTimes: make(map[uint64]int64),
+	}
+	mutation := &pb.Proposal{Mutations: &pb.Mutations{
+		StartTs: 10, Edges: []*pb.DirectedEdge{walEdge(1, "name")}}}
+	schema := &pb.Proposal{Mutations: &pb.Mutations{StartTs: 11, Schema: []*pb.SchemaUpdate{
+		{Predicate: x.AttrInRootNamespace("email")}}}}
+	delta := &pb.Proposal{Delta: &pb.OracleDelta{Txns: []*pb.TxnStatus{{StartTs: 10, CommitTs: 12}}}}
+
+	// The commits are archived with the time they were applied at, not the time of the archive.
+	var applied []int64
+	for _, p := range []*pb.Proposal{mutation, schema, delta} {
+		before := time.Now().UnixNano()
+		wa.recordApplied(p)
+		applied = append(applied, before)
+	}
+	require.Len(t, wa.commitTimes, 2)
+	time.Sleep(10 * time.Millisecond)
+	archived := time.Now().UnixNano()
+
+	var txns []*pb.ArchivedTxn
+	for i, p := range []*pb.Proposal{mutation, schema, delta} {
+		txns = wa.handleEntry(walEntry(t, uint64(i+1), p), txns)
+	}
+	require.Len(t, txns, 2)
+	require.Equal(t, uint64(11), txns[0].CommitTs)
+	require.GreaterOrEqual(t, txns[0].CommitTime, applied[1])
+	require.Less(t, txns[0].CommitTime, archived)
+	require.Equal(t, uint64(12), txns[1].CommitTs)
+	require.GreaterOrEqual(t, txns[1].CommitTime, applied[2])
+	require.Less(t, txns[1].CommitTime, archived)
+	// The times are kept until the segment is written.
+	require.Len(t, wa.commitTimes, 2)
+	wa.setArchived(txns)
+	require.Empty(t, wa.commitTimes)
+	require.Equal(t, uint64(12), wa.archivedTs)
+
+	// The times of the commits below the read ts of a snapshot are dropped.
+	wa.recordApplied(&pb.Proposal{Delta: &pb.OracleDelta{Txns: []*pb.TxnStatus{
+		{StartTs: 13, CommitTs: 14}, {StartTs: 15, CommitTs: 16}}}})
+	wa.pruneCommitTimes(14)
+	require.Len(t, wa.commitTimes, 1)
+	require.Contains(t, wa.commitTimes, uint64(16))
+}
+
+func TestWALArchiveSegments(t *testing.T) {
+	for _, key := range []x.Sensitive{nil, x.Sensitive("0123456789abcdef")} {
+		dir := t.TempDir()
+		h, _ := testFileHandlerForDir(t, dir)
+		require.NoError(t, h.CreateDir(walArchiveDir))
+
+		segment := func(gid uint32, commits ...uint64) {
+			var txns []*pb.ArchivedTxn
+			for _, ts := range commits {
+				txns = append(txns, &pb.ArchivedTxn{
+					CommitTs:   ts,
+					CommitTime: int64(ts),
+					Mutations: &pb.Mutations{StartTs: ts - 1,
+						Edges: []*pb.DirectedEdge{walEdge(ts, "name")}},
+				})
+			}
+			require.NoError(t, writeArchiveSegment(h, gid, txns, key))
+		}
+		segment(1, 5, 7)
+		segment(1, 12, 10)
+		segment(2, 6)
+		// A segment being written is ignored.
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dir, walArchiveName(1, 20, 21)+walArchiveTmpSuffix), nil, 0600))
+
+		require.Equal(t, uint64(12), latestArchivedTs(h, 1))
+		require.Equal(t, uint64(6), latestArchivedTs(h, 2))
+		require.Equal(t, uint64(0), latestArchivedTs(h, 3))
+
+		txns, err := readArchivedTxns(h, 1, 5, 10, key)
+		require.NoError(t, err)
+		var commits []uint64
+		for _, txn := range txns {
+			commits = append(commits, txn.CommitTs)
+			require.Len(t, txn.Mutations.Edges, 1)
+		}
+		require.Equal(t, []uint64{7, 10}, commits)
+
+		ts, err := archivedTsAt(h, []uint32{1, 2}, time.Unix(0, 11), key)
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), ts)
+	}
+}
+
+func TestPointInTimeRestoreManifests(t *testing.T) {
+	dir := t.TempDir()
+	manifests := []*Manifest{
+		{
+			ManifestBase: ManifestBase{
+				Type: "full", BackupId: "id1", BackupNum: 1,
+				Path: "dgraph.20260101.000000.000", ReadTs: 100, Version: 2105,
+			},
+			Groups: map[uint32][]string{1: {"name"}},
+		},
+		{
+			ManifestBase: ManifestBase{
+				Type: "incremental", BackupId: "id1", BackupNum: 2,
+				Path: "dgraph.20260102.000000.000", ReadTs: 200, Version: 2105,
+			},
+			Groups: map[uint32][]string{1: {"name"}},
+		},
+	}
+	for _, m := range manifests {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, m.Path), 0755))
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dir, m.Path, backupName(m.ReadTs, 1)), nil, 0600))
+	}
+	writeMasterManifestToDir(t, dir, manifests)
+	h, uri := testFileHandlerForDir(t, dir)
+
+	got, err := getManifestsToRestore(h, uri, &pb.RestoreRequest{RestoreToTs: 150})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, uint64(100), got[0].ReadTs)
+
+	got, err = getManifestsToRestore(h, uri, &pb.RestoreRequest{RestoreToTs: 250})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	// The time is resolved to the latest backup or archived commit before it.
+	require.NoError(t, h.CreateDir(walArchiveDir))
+	at := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, writeArchiveSegment(h, 1, []*pb.ArchivedTxn{
+		{CommitTs: 120, CommitTime: at.Add(-time.Hour).UnixNano(), Mutations: &pb.Mutations{}},
+		{CommitTs: 130, CommitTime: at.Add(time.Hour).UnixNano(), Mutations: &pb.Mutations{}},
+	}, nil))
+	req := &pb.RestoreRequest{Location: dir, RestoreToTime: at.Format(time.RFC3339)}
+	require.NoError(t, resolveRestoreToTs(req, []uint32{1}))
+	require.Equal(t, uint64(120), req.RestoreToTs)
+
+	req = &pb.RestoreRequest{Location: dir, RestoreToTime: "2025-01-01T00:00:00Z"}
+	require.Error(t, resolveRestoreToTs(req, []uint32{1}))
+
+	req = &pb.RestoreRequest{Location: dir, RestoreToTs: 120, BackupNum: 1}
+	require.Error(t, resolveRestoreToTs(req, []uint32{1}))
+	req = &pb.RestoreRequest{Location: dir, RestoreToTime: "yesterday"}
+	require.Error(t, resolveRestoreToTs(req, []uint32{1}))
+}
@@ -132,6 +132,7 @@ func BlockingStop() {
 	workerServer.Stop()
 
 	groups().Node.cdcTracker.Close()
+	groups().Node.walArchiver.Close()
 }
 
 // UpdateCacheMb updates the value of cache_mb and updates the corresponding cache sizes.
@@ -10,6 +10,7 @@ import (
 	"crypto/ed25519"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -31,6 +32,8 @@ type Keys struct {
 	AclAccessTtl      time.Duration
 	AclRefreshTtl     time.Duration
 	EncKey            Sensitive
+	// FieldKeys are the keys of the encrypted predicates, by name.
+	FieldKeys map[string]Sensitive
 }
 
 // GetEncAclKeys returns the ACL and encryption keys as configured by the user
@@ -52,8 +55,15 @@ func GetEncAclKeys(config *viper.Viper) (*Keys, error) {
 			return nil, fmt.Errorf("error reading encryption key from file: %s: %s", encKeyFile, err)
 		}
 	}
-	if l := len(encKey); encKey != nil && l != 16 && l != 32 && l != 64 {
-		return nil, fmt.Errorf("encryption key must have length of 16, 32, or 64 bytes, got %d bytes instead", l)
+	if encKey != nil {
+		if err := CheckEncKeyLength(encKey); err != nil {
+			return nil, err
+		}
+	}
+
+	fieldKeys, err := readFieldKeys(encSuperFlag.GetPath(flagEncFieldKeys))
+	if err != nil {
+		return nil, err
 	}
 
 	aclSecretFile := aclSuperFlag.GetPath(flagAclKeyFile)
@@ -72,6 +82,7 @@ func GetEncAclKeys(config *viper.Viper) (*Keys, error) {
 		AclAccessTtl:      aclSuperFlag.GetDuration(flagAclAccessTtl),
 		AclRefreshTtl:     aclSuperFlag.GetDuration(flagAclRefreshTtl),
 		EncKey:            encKey,
+		FieldKeys:         fieldKeys,
 	}
 
 	if aclKey != nil {
@@ -96,6 +107,41 @@ func GetEncAclKeys(config *viper.Viper) (*Keys, error) {
 	return keys, nil
 }
 
+// readFieldKeys reads the keys of the encrypted predicates from the files of the directory,
+// which are named after the keys.
+func readFieldKeys(dir string) (map[string]Sensitive, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading field encryption keys from %s: %s", dir, err)
+	}
+	keys := make(map[string]Sensitive)
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		key, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading field encryption key %s: %s", entry.Name(), err)
+		}
+		if err := CheckEncKeyLength(key); err != nil {
+			return nil, fmt.Errorf("field encryption key %s: %s", entry.Name(), err)
+		}
+		keys[entry.Name()] = key
+	}
+	return keys, nil
+}
+
+// CheckEncKeyLength returns an error if the encryption key doesn't have a valid length.
+func CheckEncKeyLength(key Sensitive) error {
+	if l := len(key); l != 16 && l != 32 && l != 64 {
+		return fmt.Errorf("encryption key must have length of 16, 32, or 64 bytes, got %d bytes instead", l)
+	}
+	return nil
+}
+
 func parseJWTKey(alg jwt.SigningMethod, key Sensitive) (interface{}, interface{}, error) {
 	switch {
 	case strings.HasPrefix(alg.Alg(), "HS"):
@@ -173,7 +219,11 @@ func registerEncFlag(flag *pflag.FlagSet) {
 	helpText := z.NewSuperFlagHelp(EncDefaults).
 		Head("Encryption At Rest options").
 		Flag("key-file", "The file that stores the symmetric key of length 16, 24, or 32 bytes."+
-			"The key size determines the chosen AES cipher (AES-128, AES-192, and AES-256 respectively).").
+			"The key size determines the chosen AES cipher (AES-128, AES-192, and AES-256 respectively). "+
+			"The key can be rotated with the rotateEncryptionKey admin mutation, after which the "+
+			"Alpha must be started with the new key.").
+		Flag("field-keys", "The directory with the keys of the predicates declared with "+
+			"@encrypted, in files named after the keys. The keys must be the same on all Alphas.").
 		String()
 	flag.String(flagEnc, EncDefaults, helpText)
 }
@@ -0,0 +1,420 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	// azureAPIVersion is the version of the Blob service REST API used by the client.
+	azureAPIVersion = "2021-08-06"
+	// azureBlobHostSuffix is the suffix of the hosts of the Blob service of the storage accounts.
+	azureBlobHostSuffix = ".blob.core.windows.net"
+	// azureStorageResource is the OAuth 2.0 resource of Azure Storage.
+	azureStorageResource = "https://storage.azure.com/"
+)
+
+var (
+	// azureADEndpoint is the Microsoft Entra ID endpoint from which the tokens of a service
+	// principal are requested.
+	azureADEndpoint = "https://login.microsoftonline.com"
+	// azureIMDSEndpoint is the endpoint of the instance metadata service, from which the
+	// tokens of a managed identity are requested.
+	azureIMDSEndpoint = "http://169.254.169.254/metadata/identity/oauth2/token"
+)
+
+// AzureClient is a client of the Blob service of an Azure storage account.
+type AzureClient struct {
+	hc *http.Client
+	// base is the URL of the Blob service, including the account for the path-style URLs of
+	// the Azurite emulator.
+	base    url.URL
+	account string
+
+	// Only one of the authentication methods is set, none for anonymous access.
+	sharedKey []byte
+	sas       url.Values
+	tokens    *tokenSource
+}
+
+// NewAzureClient returns a client of the storage account of the URI, which is one of:
+//
+//	azure://<account>.blob.core.windows.net/<container>/<prefix>
+//	azure:///<container>/<prefix> (the account is given by the credentials)
+//	azure://127.0.0.1:10000/<account>/<container>/<prefix>?secure=false (Azurite)
+//
+// The client is authenticated with the credentials if they're given: the access key is the
+// account name, the secret key is the account key and the session token is a SAS token.
+// Otherwise, unless the instance is shared, it uses the environment: AZURE_STORAGE_ACCOUNT,
+// AZURE_STORAGE_KEY or AZURE_STORAGE_SAS_TOKEN, then the service principal of AZURE_TENANT_ID,
+// AZURE_CLIENT_ID and AZURE_CLIENT_SECRET, and lastly the managed identity of the VM.
+func NewAzureClient(uri *url.URL, creds *MinioCredentials) (*AzureClient, error) {
+	c := &AzureClient{hc: newBlobHTTPClient()}
+	c.base.Scheme = "https"
+	if uri.Query().Get("secure") == "false" {
+		c.base.Scheme = "http"
+	}
+
+	useEnv := !Config.SharedInstance
+	if creds != nil && creds.AccessKey != "" {
+		c.account = creds.AccessKey
+	} else if useEnv {
+		c.account = os.Getenv("AZURE_STORAGE_ACCOUNT")
+	}
+	switch {
+	case uri.Host == "":
+		if c.account == "" {
+			return nil, errors.Errorf("Azure handler requires a storage account")
+		}
+		c.base.Host = c.account + azureBlobHostSuffix
+	case strings.HasSuffix(uri.Host, azureBlobHostSuffix) || !isPathStyleHost(uri.Host):
+		c.base.Host = uri.Host
+		c.account = strings.SplitN(uri.Host, ".", 2)[0]
+	default:
+		// The emulator has the account as the first segment of the path.
+		c.base.Host = uri.Host
+		c.account, _, _ = strings.Cut(strings.TrimPrefix(uri.Path, "/"), "/")
+		c.base.Path = "/" + c.account
+	}
+	if c.account == "" {
+		return nil, errors.Errorf("Invalid Azure location: %s", uri.Redacted())
+	}
+
+	if creds.isAnonymous() {
+		return c, nil
+	}
+	var key, sas string
+	if creds != nil {
+		key, sas = string(creds.SecretKey), string(creds.SessionToken)
+	}
+	if key == "" && sas == "" && useEnv {
+		key, sas = os.Getenv("AZURE_STORAGE_KEY"), os.Getenv("AZURE_STORAGE_SAS_TOKEN")
+	}
+	switch {
+	case sas != "":
+		values, err := url.ParseQuery(strings.TrimPrefix(sas, "?"))
+		if err != nil {
+			return nil, errors.Wrap(err, "while parsing the SAS token")
+		}
+		c.sas = values
+	case key != "":
+		sharedKey, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "while decoding the account key")
+		}
+		c.sharedKey = sharedKey
+	case !useEnv:
+		return nil, errors.Errorf("Azure handler requires credentials in a shared instance")
+	case os.Getenv("AZURE_CLIENT_SECRET") != "":
+		c.tokens = &tokenSource{fetch: c.servicePrincipalToken(os.Getenv("AZURE_TENANT_ID"),
+			os.Getenv("AZURE_CLIENT_ID"), os.Getenv("AZURE_CLIENT_SECRET"))}
+	default:
+		c.tokens = &tokenSource{fetch: c.managedIdentityToken(os.Getenv("AZURE_CLIENT_ID"))}
+	}
+	glog.V(2).Infof("Azure client using host: %s, account: %s", c.base.Host, c.account)
+	return c, nil
+}
+
+// isPathStyleHost returns whether the host serves the accounts in the path, like the emulator
+// listening on a local address.
+func isPathStyleHost(host string) bool {
+	hostname := strings.Split(host, ":")[0]
+	return hostname == "localhost" || strings.Count(hostname, ".") == 3 ||
+		!strings.Contains(hostname, ".")
+}
+
+func (c *AzureClient) servicePrincipalToken(tenant, clientId, secret string) func(
+	context.Context) (string, time.Duration, error) {
+
+	return func(ctx context.Context) (string, time.Duration, error) {
+		req, err := postForm(ctx, fmt.Sprintf("%s/%s/oauth2/v2.0/token", azureADEndpoint, tenant),
+			url.Values{
+				"grant_type":    {"client_credentials"},
+				"client_id":     {clientId},
+				"client_secret": {secret},
+				"scope":         {azureStorageResource + ".default"},
+			})
+		if err != nil {
+			return "", 0, err
+		}
+		return fetchToken(c.hc, req)
+	}
+}
+
+func (c *AzureClient) managedIdentityToken(clientId string) func(
+	context.Context) (string, time.Duration, error) {
+
+	return func(ctx context.Context) (string, time.Duration, error) {
+		query := url.Values{"api-version": {"2018-02-01"}, "resource": {azureStorageResource}}
+		if clientId != "" {
+			query.Set("client_id", clientId)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			azureIMDSEndpoint+"?"+query.Encode(), nil)
+		if err != nil {
+			return "", 0, err
+		}
+		req.Header.Set("Metadata", "true")
+		return fetchToken(c.hc, req)
+	}
+}
+
+// ParseLocation returns the container and the prefix of the URI.
+func (c *AzureClient) ParseLocation(uri *url.URL) (string, string) {
+	path := strings.TrimPrefix(uri.Path, "/")
+	if c.base.Path != "" {
+		_, path, _ = strings.Cut(path, "/")
+	}
+	container, prefix, _ := strings.Cut(path, "/")
+	return container, strings.Trim(prefix, "/")
+}
+
+func (c *AzureClient) URL(container, name string) string {
+	u := c.base
+	u.Scheme = "azure"
+	u.Path = "/" + blobPath(c.base.Path, container, name)
+	if c.base.Scheme == "http" {
+		u.RawQuery = "secure=false"
+	}
+	return u.String()
+}
+
+// do sends a request to the container, or to the blob if the name isn't empty.
+func (c *AzureClient) do(ctx context.Context, method, container, name string, query url.Values,
+	header http.Header, body []byte) (*http.Response, error) {
+
+	u := c.base
+	u.Path = "/" + blobPath(c.base.Path, container, name)
+	if query == nil {
+		query = url.Values{}
+	}
+	for k, v := range c.sas {
+		query[k] = v
+	}
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.ContentLength = int64(len(body))
+	if body == nil {
+		req.Body = http.NoBody
+	}
+	req.Header.Set("x-ms-version", azureAPIVersion)
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("User-Agent", blobUserAgent())
+	switch {
+	case c.sharedKey != nil:
+		req.Header.Set("Authorization", "SharedKey "+c.account+":"+c.sign(req))
+	case c.tokens != nil:
+		token, err := c.tokens.Token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return c.hc.Do(req)
+}
+
+// sign returns the Shared Key signature of the request.
+// See https://learn.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func (c *AzureClient) sign(req *http.Request) string {
+	length := ""
+	if req.ContentLength > 0 {
+		length = strconv.FormatInt(req.ContentLength, 10)
+	}
+	h := req.Header
+	parts := []string{
+		req.Method,
+		h.Get("Content-Encoding"),
+		h.Get("Content-Language"),
+		length,
+		h.Get("Content-MD5"),
+		h.Get("Content-Type"),
+		"", // Date, replaced by x-ms-date.
+		h.Get("If-Modified-Since"),
+		h.Get("If-Match"),
+		h.Get("If-None-Match"),
+		h.Get("If-Unmodified-Since"),
+		h.Get("Range"),
+	}
+
+	var msHeaders []string
+	for k := range h {
+		if k = strings.ToLower(k); strings.HasPrefix(k, "x-ms-") {
+			msHeaders = append(msHeaders, k)
+		}
+	}
+	sort.Strings(msHeaders)
+	for _, k := range msHeaders {
+		parts = append(parts, k+":"+strings.TrimSpace(h.Get(k)))
+	}
+
+	resource := "/" + c.account + req.URL.EscapedPath()
+	query := req.URL.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+		resource += "\n" + strings.ToLower(k) + ":" + strings.Join(values, ",")
+	}
+	parts = append(parts, resource)
+	return c.signString(strings.Join(parts, "\n"))
+}
+
+func (c *AzureClient) signString(s string) string {
+	mac := hmac.New(sha256.New, c.sharedKey)
+	mac.Write([]byte(s))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (c *AzureClient) Get(ctx context.Context, container, name string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, http.MethodGet, container, name, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, blobError(resp, "Get Blob")
+	}
+	return resp.Body, nil
+}
+
+func (c *AzureClient) Exists(ctx context.Context, container, name string) (bool, error) {
+	resp, err := c.do(ctx, http.MethodHead, container, name, nil, nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+	return false, blobError(resp, "Get Blob Properties")
+}
+
+// Put uploads the blob at once if it fits in a block, or else block by block before committing
+// the list of blocks.
+func (c *AzureClient) Put(ctx context.Context, container, name string, r io.Reader) error {
+	buf := make([]byte, blobChunkSize)
+	n, eof, err := readChunk(r, buf)
+	if err != nil {
+		return err
+	}
+	// A block is staged under its ID until the list is committed, so its upload can be retried.
+	put := func(op string, query url.Values, header http.Header, body []byte) error {
+		_, err := uploadWithRetry(ctx, op, func(ctx context.Context) (*http.Response, error) {
+			return c.do(ctx, http.MethodPut, container, name, query, header, body)
+		}, http.StatusCreated)
+		return err
+	}
+	if eof {
+		header := http.Header{"X-Ms-Blob-Type": {"BlockBlob"}}
+		return put("Put Blob", nil, header, buf[:n])
+	}
+
+	var blockIds []string
+	for {
+		id := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", len(blockIds))))
+		query := url.Values{"comp": {"block"}, "blockid": {id}}
+		if err := put("Put Block", query, nil, buf[:n]); err != nil {
+			return err
+		}
+		blockIds = append(blockIds, id)
+		if eof {
+			break
+		}
+		if n, eof, err = readChunk(r, buf); err != nil {
+			return err
+		}
+		if n == 0 {
+			break
+		}
+	}
+
+	var list bytes.Buffer
+	list.WriteString(`<?xml version="1.0" encoding="utf-8"?><BlockList>`)
+	for _, id := range blockIds {
+		list.WriteString("<Latest>" + id + "</Latest>")
+	}
+	list.WriteString("</BlockList>")
+	query := url.Values{"comp": {"blocklist"}}
+	header := http.Header{"Content-Type": {"application/xml"}}
+	return put("Put Block List", query, header, list.Bytes())
+}
+
+func (c *AzureClient) Delete(ctx context.Context, container, name string) error {
+	resp, err := c.do(ctx, http.MethodDelete, container, name, nil, nil, nil)
+	return expectStatus(resp, err, "Delete Blob", http.StatusAccepted)
+}
+
+func (c *AzureClient) List(ctx context.Context, container, prefix string) ([]string, error) {
+	var names []string
+	marker := ""
+	for {
+		query := url.Values{"restype": {"container"}, "comp": {"list"}}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		resp, err := c.do(ctx, http.MethodGet, container, "", query, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		var res struct {
+			Blobs []struct {
+				Name string `xml:"Name"`
+			} `xml:"Blobs>Blob"`
+			NextMarker string `xml:"NextMarker"`
+		}
+		if resp.StatusCode != http.StatusOK {
+			err = blobError(resp, "List Blobs")
+		} else {
+			err = xml.NewDecoder(resp.Body).Decode(&res)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range res.Blobs {
+			names = append(names, b.Name)
+		}
+		if res.NextMarker == "" {
+			return names, nil
+		}
+		marker = res.NextMarker
+	}
+}
@@ -0,0 +1,255 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	// blobChunkSize is the size of the blocks or chunks in which the objects are uploaded. It's
+	// a multiple of 256 KiB, as required by the resumable uploads of Google Cloud Storage.
+	blobChunkSize = 8 << 20
+	// blobResponseTimeout is how long the clients wait for the headers of a response once the
+	// request is sent.
+	blobResponseTimeout = 2 * time.Minute
+	// blobUploadTimeout is how long the upload of a chunk, or of a small object, may take.
+	blobUploadTimeout = 5 * time.Minute
+)
+
+// blobRetries is how many times an upload is sent before giving up on a throttling or a server
+// error, and blobRetryWait is the wait after its first failure, doubled after each one. They're
+// variables to shorten them in the tests.
+var (
+	blobRetries   = 5
+	blobRetryWait = time.Second
+)
+
+// ErrBlobNotFound is returned by a BlobClient when the object doesn't exist.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobClient is a client of an object store without an S3 API, namely Azure Blob Storage
+// (azure scheme) and Google Cloud Storage (gs scheme).
+type BlobClient interface {
+	// ParseLocation returns the bucket, or container, and the object prefix of the URI.
+	ParseLocation(uri *url.URL) (bucket, prefix string)
+	// URL returns the URI of the object, in the format parsed by ParseLocation.
+	URL(bucket, name string) string
+	// Get streams the object. The returned reader must be closed.
+	Get(ctx context.Context, bucket, name string) (io.ReadCloser, error)
+	// Exists returns whether the object exists.
+	Exists(ctx context.Context, bucket, name string) (bool, error)
+	// Put uploads the object, reading it until EOF.
+	Put(ctx context.Context, bucket, name string, r io.Reader) error
+	// Delete deletes the object. It returns ErrBlobNotFound if the object doesn't exist.
+	Delete(ctx context.Context, bucket, name string) error
+	// List returns the names of the objects starting with the prefix.
+	List(ctx context.Context, bucket, prefix string) ([]string, error)
+}
+
+// IsBlobScheme returns whether the scheme is handled by a BlobClient.
+func IsBlobScheme(scheme string) bool {
+	return scheme == "azure" || scheme == "gs"
+}
+
+// NewBlobClient returns the client of the object store of the URI, authenticated with the given
+// credentials, or else with the credentials of the environment. See NewAzureClient and
+// NewGCSClient for how the credentials are used.
+func NewBlobClient(uri *url.URL, creds *MinioCredentials) (BlobClient, error) {
+	switch uri.Scheme {
+	case "azure":
+		return NewAzureClient(uri, creds)
+	case "gs":
+		return NewGCSClient(uri, creds)
+	}
+	return nil, errors.Errorf("no blob client for scheme %q", uri.Scheme)
+}
+
+// blobPath joins the parts of an object path with slashes, skipping the empty ones.
+func blobPath(parts ...string) string {
+	var nonEmpty []string
+	for _, p := range parts {
+		if p = strings.Trim(p, "/"); p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return strings.Join(nonEmpty, "/")
+}
+
+// readChunk reads up to len(buf) bytes, and returns whether the reader is at EOF.
+func readChunk(r io.Reader, buf []byte) (int, bool, error) {
+	n, err := io.ReadFull(r, buf)
+	switch err {
+	case nil:
+		return n, false, nil
+	case io.EOF, io.ErrUnexpectedEOF:
+		return n, true, nil
+	}
+	return n, false, err
+}
+
+// blobError returns the error of an unsuccessful response, with its body.
+func blobError(resp *http.Response, op string) error {
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrBlobNotFound
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+	return errors.Errorf("%s failed with status %s: %s", op, resp.Status,
+		strings.TrimSpace(string(body)))
+}
+
+// expectStatus checks that the response has one of the given statuses and closes its body.
+func expectStatus(resp *http.Response, err error, op string, statuses ...int) error {
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	for _, status := range statuses {
+		if resp.StatusCode == status {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			return nil
+		}
+	}
+	return blobError(resp, op)
+}
+
+// newBlobHTTPClient returns the HTTP client of the object stores. It has no overall timeout as
+// the objects are downloaded as streams, but the connections, the TLS handshakes and the waits
+// for the responses time out, and each upload has blobUploadTimeout to complete.
+func newBlobHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	transport.TLSHandshakeTimeout = 10 * time.Second
+	transport.ResponseHeaderTimeout = blobResponseTimeout
+	return &http.Client{Transport: transport}
+}
+
+// uploadWithRetry sends the upload until its response has one of the given statuses, and returns
+// the headers of that response. The uploads failing with a network error, a timeout, a
+// throttling (429) or a server error (5xx) are retried with an exponential backoff, so send must
+// be idempotent.
+func uploadWithRetry(ctx context.Context, op string, send func(context.Context) (*http.Response,
+	error), statuses ...int) (http.Header, error) {
+
+	wait := blobRetryWait
+	for attempt := 1; ; attempt++ {
+		header, retry, err := uploadOnce(ctx, op, send, statuses...)
+		if err == nil || !retry || attempt >= blobRetries {
+			return header, err
+		}
+		glog.Warningf("%s failed, retrying in %s: %v", op, wait, err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+// uploadOnce sends the upload with blobUploadTimeout, and returns whether it can be retried if
+// it failed.
+func uploadOnce(ctx context.Context, op string, send func(context.Context) (*http.Response,
+	error), statuses ...int) (http.Header, bool, error) {
+
+	reqCtx, cancel := context.WithTimeout(ctx, blobUploadTimeout)
+	defer cancel()
+	resp, err := send(reqCtx)
+	if err != nil {
+		// The request wasn't sent or timed out, which is retried unless the caller gave up.
+		return nil, ctx.Err() == nil, err
+	}
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	if err := expectStatus(resp, nil, op, statuses...); err != nil {
+		return nil, retry, err
+	}
+	return resp.Header, false, nil
+}
+
+// tokenSource returns OAuth 2.0 access tokens, cached until shortly before they expire.
+type tokenSource struct {
+	fetch func(ctx context.Context) (string, time.Duration, error)
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func (ts *tokenSource) Token(ctx context.Context) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.token != "" && time.Now().Before(ts.expires) {
+		return ts.token, nil
+	}
+	token, ttl, err := ts.fetch(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "while fetching an access token")
+	}
+	ts.token = token
+	ts.expires = time.Now().Add(ttl - time.Minute)
+	return token, nil
+}
+
+// fetchToken sends the token request and reads the access token of the response, in the format
+// shared by the OAuth 2.0 token endpoints and the metadata servers of the clouds.
+func fetchToken(hc *http.Client, req *http.Request) (string, time.Duration, error) {
+	resp, err := hc.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, blobError(resp, "token request")
+	}
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		// The Azure instance metadata service returns the expiry as a string.
+		ExpiresIn json.RawMessage `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return "", 0, errors.Wrap(err, "while reading the token response")
+	}
+	if tok.AccessToken == "" {
+		return "", 0, errors.New("no access token in the token response")
+	}
+	expiresIn, err := strconv.ParseInt(strings.Trim(string(tok.ExpiresIn), `"`), 10, 64)
+	if err != nil {
+		expiresIn = 300
+	}
+	return tok.AccessToken, time.Duration(expiresIn) * time.Second, nil
+}
+
+// postForm returns a form POST request to the URL.
+func postForm(ctx context.Context, u string, form url.Values) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u,
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req, nil
+}
+
+// blobUserAgent is sent with the requests to the object stores.
+func blobUserAgent() string {
+	return fmt.Sprintf("%s/%s", appName, Version())
+}
@@ -0,0 +1,77 @@
+//go:build integration
+
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+)
+
+// azuriteKey is the well-known account key of the devstoreaccount1 account of Azurite.
+const azuriteKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+// roundTrip writes, lists, reads and deletes a large object, which is uploaded in chunks.
+func roundTrip(t *testing.T, c BlobClient, bucket string) {
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("dgraph"), blobChunkSize/3)
+	require.NoError(t, c.Put(ctx, bucket, "it/r1-g1.backup", bytes.NewReader(data)))
+	names, err := c.List(ctx, bucket, "it/")
+	require.NoError(t, err)
+	require.Equal(t, []string{"it/r1-g1.backup"}, names)
+	r, err := c.Get(ctx, bucket, "it/r1-g1.backup")
+	require.NoError(t, err)
+	read, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.True(t, bytes.Equal(data, read))
+	require.NoError(t, c.Delete(ctx, bucket, "it/r1-g1.backup"))
+	require.ErrorIs(t, c.Delete(ctx, bucket, "it/r1-g1.backup"), ErrBlobNotFound)
+}
+
+// TestAzurite runs against the Azurite emulator listening on AZURITE_BLOB_ADDR.
+func TestAzurite(t *testing.T) {
+	addr := os.Getenv("AZURITE_BLOB_ADDR")
+	if addr == "" {
+		t.Skip("AZURITE_BLOB_ADDR is not set")
+	}
+	uri, err := url.Parse(fmt.Sprintf("azure://%s/devstoreaccount1/dgraph?secure=false", addr))
+	require.NoError(t, err)
+	c, err := NewAzureClient(uri, &MinioCredentials{SecretKey: pb.Sensitive(azuriteKey)})
+	require.NoError(t, err)
+	resp, err := c.do(context.Background(), http.MethodPut, "dgraph", "",
+		url.Values{"restype": {"container"}}, nil, nil)
+	require.NoError(t, expectStatus(resp, err, "Create Container", http.StatusCreated,
+		http.StatusConflict))
+	roundTrip(t, c, "dgraph")
+}
+
+// TestFakeGCS runs against the fake-gcs-server emulator of STORAGE_EMULATOR_HOST.
+func TestFakeGCS(t *testing.T) {
+	if os.Getenv("STORAGE_EMULATOR_HOST") == "" {
+		t.Skip("STORAGE_EMULATOR_HOST is not set")
+	}
+	uri, err := url.Parse("gs://dgraph")
+	require.NoError(t, err)
+	c, err := NewGCSClient(uri, nil)
+	require.NoError(t, err)
+	resp, err := c.do(context.Background(), http.MethodPost, c.endpoint+"/storage/v1/b",
+		http.Header{"Content-Type": {"application/json"}}, []byte(`{"name": "dgraph"}`))
+	require.NoError(t, expectStatus(resp, err, "buckets.insert", http.StatusOK,
+		http.StatusConflict))
+	roundTrip(t, c, "dgraph")
+}
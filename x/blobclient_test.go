@@ -0,0 +1,413 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v25/protos/pb"
+)
+
+// blobStore is the in-memory storage of the fake object stores.
+type blobStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	// parts holds the blocks of Azure, or the chunks of a GCS resumable upload.
+	parts map[string][]byte
+	// requests counts the upload requests.
+	requests int
+	// failures are the statuses with which the next upload requests fail.
+	failures []int
+}
+
+func newBlobStore() *blobStore {
+	return &blobStore{objects: make(map[string][]byte), parts: make(map[string][]byte)}
+}
+
+// page returns up to two names with the prefix after the marker, and the next marker.
+func (s *blobStore) page(prefix, marker string) ([]string, string) {
+	var names []string
+	for name := range s.objects {
+		if strings.HasPrefix(name, prefix) && name > marker {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if len(names) > 2 {
+		return names[:2], names[1]
+	}
+	return names, ""
+}
+
+// fail fails the upload request with the next status of the failures, if any.
+func (s *blobStore) fail(w http.ResponseWriter) bool {
+	if len(s.failures) == 0 {
+		return false
+	}
+	s.requests++
+	w.WriteHeader(s.failures[0])
+	s.failures = s.failures[1:]
+	return true
+}
+
+// fakeAzure serves the Blob service of the devstoreaccount1 account, in the path style of the
+// Azurite emulator.
+type fakeAzure struct {
+	*blobStore
+	t *testing.T
+}
+
+func (f *fakeAzure) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey devstoreaccount1:") ||
+		r.Header.Get("x-ms-version") != azureAPIVersion {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/devstoreaccount1/")
+	container, name, _ := strings.Cut(path, "/")
+	require.Equal(f.t, "backups", container)
+	query := r.URL.Query()
+	body, err := io.ReadAll(r.Body)
+	require.NoError(f.t, err)
+	if r.Method == http.MethodPut && f.fail(w) {
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && query.Get("comp") == "list":
+		names, next := f.page(query.Get("prefix"), query.Get("marker"))
+		fmt.Fprint(w, `<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+		for _, n := range names {
+			fmt.Fprintf(w, "<Blob><Name>%s</Name></Blob>", n)
+		}
+		fmt.Fprintf(w, "</Blobs><NextMarker>%s</NextMarker></EnumerationResults>", next)
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		f.requests++
+		f.parts[name+"/"+query.Get("blockid")] = body
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		f.requests++
+		var list struct {
+			Latest []string `xml:"Latest"`
+		}
+		require.NoError(f.t, xml.Unmarshal(body, &list))
+		var data []byte
+		for _, id := range list.Latest {
+			data = append(data, f.parts[name+"/"+id]...)
+		}
+		f.objects[name] = data
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut:
+		f.requests++
+		require.Equal(f.t, "BlockBlob", r.Header.Get("x-ms-blob-type"))
+		f.objects[name] = body
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		data, ok := f.objects[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	case r.Method == http.MethodDelete:
+		if _, ok := f.objects[name]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.objects, name)
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// fakeGCS serves the JSON API of Google Cloud Storage for the backups bucket, and the token
+// endpoint of a service account.
+type fakeGCS struct {
+	*blobStore
+	t      *testing.T
+	url    string
+	pubKey *rsa.PublicKey
+}
+
+func (f *fakeGCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	query := r.URL.Query()
+	body, err := io.ReadAll(r.Body)
+	require.NoError(f.t, err)
+
+	if r.URL.Path == "/token" {
+		form, err := url.ParseQuery(string(body))
+		require.NoError(f.t, err)
+		claims := jwt.MapClaims{}
+		_, err = jwt.ParseWithClaims(form.Get("assertion"), claims,
+			func(*jwt.Token) (interface{}, error) { return f.pubKey, nil })
+		require.NoError(f.t, err)
+		require.Equal(f.t, gcsScope, claims["scope"])
+		require.Equal(f.t, "dgraph@project.iam.gserviceaccount.com", claims["iss"])
+		fmt.Fprint(w, `{"access_token": "token", "expires_in": 3600}`)
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if (r.Method == http.MethodPost || r.Method == http.MethodPut) && f.fail(w) {
+		return
+	}
+
+	const objects = "/storage/v1/b/backups/o"
+	switch {
+	case r.Method == http.MethodPost && query.Get("uploadType") == "media":
+		f.requests++
+		f.objects[query.Get("name")] = body
+	case r.Method == http.MethodPost && query.Get("uploadType") == "resumable":
+		f.requests++
+		w.Header().Set("Location", f.url+"/session?name="+url.QueryEscape(query.Get("name")))
+	case r.Method == http.MethodPut && r.URL.Path == "/session":
+		f.requests++
+		name := query.Get("name")
+		// The range is "bytes first-last/total", where the total is * until the last chunk.
+		contentRange := strings.TrimPrefix(r.Header.Get("Content-Range"), "bytes ")
+		rng, total, _ := strings.Cut(contentRange, "/")
+		if rng != "*" {
+			first, _, _ := strings.Cut(rng, "-")
+			require.Equal(f.t, strconv.Itoa(len(f.parts[name])), first)
+		}
+		f.parts[name] = append(f.parts[name], body...)
+		if total == "*" {
+			w.WriteHeader(http.StatusPermanentRedirect)
+			return
+		}
+		require.Equal(f.t, strconv.Itoa(len(f.parts[name])), total)
+		f.objects[name] = f.parts[name]
+		delete(f.parts, name)
+	case r.Method == http.MethodGet && r.URL.Path == objects:
+		names, next := f.page(query.Get("prefix"), query.Get("pageToken"))
+		items := []map[string]string{}
+		for _, n := range names {
+			items = append(items, map[string]string{"name": n})
+		}
+		require.NoError(f.t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": items, "nextPageToken": next}))
+	case strings.HasPrefix(r.URL.Path, objects+"/"):
+		name := strings.TrimPrefix(r.URL.Path, objects+"/")
+		data, ok := f.objects[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch {
+		case r.Method == http.MethodDelete:
+			delete(f.objects, name)
+			w.WriteHeader(http.StatusNoContent)
+		case query.Get("alt") == "media":
+			_, _ = w.Write(data)
+		default:
+			fmt.Fprintf(w, `{"name": %q}`, name)
+		}
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+// testBlobClient checks the operations of the client on the backups bucket of the store.
+func testBlobClient(t *testing.T, c BlobClient, store *blobStore) {
+	ctx := context.Background()
+	small := []byte("manifest")
+	large := make([]byte, 2*blobChunkSize+100)
+	_, err := rand.Read(large)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Put(ctx, "backups", "dgraph.1/manifest.json", bytes.NewReader(small)))
+	require.Equal(t, 1, store.requests)
+	require.NoError(t, c.Put(ctx, "backups", "dgraph.1/r10-g1.backup", bytes.NewReader(large)))
+	// The large object is uploaded in 3 chunks.
+	require.Equal(t, 5, store.requests)
+	require.NoError(t, c.Put(ctx, "backups", "dgraph.2/r20-g1.backup", bytes.NewReader(nil)))
+	require.NoError(t, c.Put(ctx, "backups", "manifest.json", bytes.NewReader(small)))
+
+	// The uploads are retried on throttling and server errors, but not on other errors.
+	defer func(wait time.Duration) { blobRetryWait = wait }(blobRetryWait)
+	blobRetryWait = time.Millisecond
+	store.requests = 0
+	store.failures = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}
+	require.NoError(t, c.Put(ctx, "backups", "dgraph.2/r20-g2.backup", bytes.NewReader(large)))
+	require.Equal(t, 6, store.requests)
+	store.failures = []int{http.StatusBadRequest}
+	require.ErrorContains(t, c.Put(ctx, "backups", "dgraph.2/r20-g3.backup",
+		bytes.NewReader(small)), "400 Bad Request")
+	require.Equal(t, 7, store.requests)
+	store.failures = []int{500, 500, 500, 500, 500}
+	require.ErrorContains(t, c.Put(ctx, "backups", "dgraph.2/r20-g3.backup",
+		bytes.NewReader(small)), "500 Internal Server Error")
+	require.Equal(t, 12, store.requests)
+	require.NoError(t, c.Delete(ctx, "backups", "dgraph.2/r20-g2.backup"))
+
+	r, err := c.Get(ctx, "backups", "dgraph.1/r10-g1.backup")
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.True(t, bytes.Equal(large, data))
+
+	exists, err := c.Exists(ctx, "backups", "dgraph.1/manifest.json")
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, err = c.Exists(ctx, "backups", "dgraph.3/manifest.json")
+	require.NoError(t, err)
+	require.False(t, exists)
+	_, err = c.Get(ctx, "backups", "dgraph.3/manifest.json")
+	require.ErrorIs(t, err, ErrBlobNotFound)
+
+	// The names are listed over several pages.
+	names, err := c.List(ctx, "backups", "dgraph.")
+	require.NoError(t, err)
+	require.Equal(t, []string{"dgraph.1/manifest.json", "dgraph.1/r10-g1.backup",
+		"dgraph.2/r20-g1.backup"}, names)
+
+	require.NoError(t, c.Delete(ctx, "backups", "dgraph.1/manifest.json"))
+	require.ErrorIs(t, c.Delete(ctx, "backups", "dgraph.1/manifest.json"), ErrBlobNotFound)
+	names, err = c.List(ctx, "backups", "dgraph.1/")
+	require.NoError(t, err)
+	require.Equal(t, []string{"dgraph.1/r10-g1.backup"}, names)
+}
+
+func TestAzureClient(t *testing.T) {
+	store := newBlobStore()
+	srv := httptest.NewServer(&fakeAzure{blobStore: store, t: t})
+	defer srv.Close()
+
+	uri, err := url.Parse("azure://" + srv.Listener.Addr().String() +
+		"/devstoreaccount1/backups/dgraph?secure=false")
+	require.NoError(t, err)
+	key := base64.StdEncoding.EncodeToString([]byte("key"))
+	c, err := NewAzureClient(uri, &MinioCredentials{SecretKey: pb.Sensitive(key)})
+	require.NoError(t, err)
+	require.Equal(t, "devstoreaccount1", c.account)
+	bucket, prefix := c.ParseLocation(uri)
+	require.Equal(t, "backups", bucket)
+	require.Equal(t, "dgraph", prefix)
+	u, err := url.Parse(c.URL("backups", "dgraph/manifest.json"))
+	require.NoError(t, err)
+	bucket, prefix = c.ParseLocation(u)
+	require.Equal(t, "backups", bucket)
+	require.Equal(t, "dgraph/manifest.json", prefix)
+
+	testBlobClient(t, c, store)
+
+	// The account is given by the credentials, or by the host.
+	uri, err = url.Parse("azure:///backups")
+	require.NoError(t, err)
+	c, err = NewAzureClient(uri, &MinioCredentials{AccessKey: "acct", Anonymous: true})
+	require.NoError(t, err)
+	require.Equal(t, "acct.blob.core.windows.net", c.base.Host)
+	uri, err = url.Parse("azure://acct2.blob.core.windows.net/backups/dgraph")
+	require.NoError(t, err)
+	c, err = NewAzureClient(uri, &MinioCredentials{Anonymous: true})
+	require.NoError(t, err)
+	require.Equal(t, "acct2", c.account)
+	bucket, prefix = c.ParseLocation(uri)
+	require.Equal(t, "backups", bucket)
+	require.Equal(t, "dgraph", prefix)
+
+	// A shared instance only uses the credentials of the request.
+	Config.SharedInstance = true
+	defer func() { Config.SharedInstance = false }()
+	_, err = NewAzureClient(uri, nil)
+	require.ErrorContains(t, err, "requires credentials")
+}
+
+func TestAzureSharedKey(t *testing.T) {
+	c := &AzureClient{account: "myaccount", sharedKey: []byte("secret")}
+	req, err := http.NewRequest(http.MethodPut,
+		"https://myaccount.blob.core.windows.net/mycontainer/my%20blob?comp=block&blockid=AA%3D%3D",
+		bytes.NewReader([]byte("data")))
+	require.NoError(t, err)
+	req.Header.Set("x-ms-version", azureAPIVersion)
+	req.Header.Set("x-ms-date", "Mon, 19 Oct 2026 10:00:00 GMT")
+	req.Header.Set("X-Ms-Blob-Type", "BlockBlob")
+
+	toSign := "PUT\n\n\n4\n\n\n\n\n\n\n\n\n" +
+		"x-ms-blob-type:BlockBlob\n" +
+		"x-ms-date:Mon, 19 Oct 2026 10:00:00 GMT\n" +
+		"x-ms-version:" + azureAPIVersion + "\n" +
+		"/myaccount/mycontainer/my%20blob\nblockid:AA==\ncomp:block"
+	expected := &AzureClient{sharedKey: []byte("secret")}
+	require.Equal(t, expected.signString(toSign), c.sign(req))
+}
+
+func TestGCSClient(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	store := newBlobStore()
+	fake := &fakeGCS{blobStore: store, t: t, pubKey: &privKey.PublicKey}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+	fake.url = srv.URL
+
+	defer func(endpoint string) { gcsEndpoint = endpoint }(gcsEndpoint)
+	gcsEndpoint = srv.URL
+	t.Setenv("STORAGE_EMULATOR_HOST", "")
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privKey)})
+	saKey, err := json.Marshal(serviceAccountKey{
+		Type:        "service_account",
+		ClientEmail: "dgraph@project.iam.gserviceaccount.com",
+		PrivateKey:  string(keyPEM),
+		TokenURI:    srv.URL + "/token",
+	})
+	require.NoError(t, err)
+
+	uri, err := url.Parse("gs://backups/dgraph")
+	require.NoError(t, err)
+	c, err := NewGCSClient(uri, &MinioCredentials{SecretKey: pb.Sensitive(saKey)})
+	require.NoError(t, err)
+	bucket, prefix := c.ParseLocation(uri)
+	require.Equal(t, "backups", bucket)
+	require.Equal(t, "dgraph", prefix)
+	require.Equal(t, "gs://backups/dgraph/manifest.json", c.URL("backups", "dgraph/manifest.json"))
+
+	testBlobClient(t, c, store)
+
+	// An access token is used as is.
+	c, err = NewGCSClient(uri, &MinioCredentials{SessionToken: pb.Sensitive("token")})
+	require.NoError(t, err)
+	exists, err := c.Exists(context.Background(), "backups", "manifest.json")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// The emulator doesn't need authentication.
+	t.Setenv("STORAGE_EMULATOR_HOST", "localhost:4443")
+	c, err = NewGCSClient(uri, nil)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:4443", c.endpoint)
+	require.Nil(t, c.tokens)
+}
@@ -32,6 +32,7 @@ type Options struct {
 	// query-timeout duration - Maximum time after which a query execution will fail.
 	// max-retries int64 - maximum number of retries made by dgraph to commit a transaction to disk.
 	// shared-instance bool - if set to true, ACLs will be disabled for non-galaxy users.
+	// namespace-quotas bool - if set to true, quotas can be set for each namespace.
 	Limit                *z.SuperFlag
 	LimitMutationsNquad  int
 	LimitQueryEdge       uint64
@@ -40,6 +41,7 @@ type Options struct {
 	QueryTimeout         time.Duration
 	MaxRetries           int64
 	SharedInstance       bool
+	NamespaceQuotas      bool
 
 	// GraphQL options:
 	//
@@ -124,8 +126,12 @@ type WorkerOptions struct {
 	// whitelist string - comma separated IP addresses
 	// token string - if set, all Admin requests to Dgraph will have this token.
 	Security *z.SuperFlag
-	// EncryptionKey is the key used for encryption at rest, backups, exports.
+	// EncryptionKey is the key the Alpha was started with, used for encryption at rest. The
+	// backups and exports are encrypted with the current version of the key, which changes when
+	// the key is rotated, see worker.EncryptionKey.
 	EncryptionKey Sensitive
+	// FieldKeys are the keys of the predicates declared with @encrypted, by name.
+	FieldKeys map[string]Sensitive
 	// LogDQLRequest indicates whether alpha should log all query/mutation requests coming to it.
 	// Ideally LogDQLRequest should be a bool value. But we are reading it using atomics across
 	// queries hence it has been kept as int32. LogDQLRequest value 1 enables logging of requests
@@ -138,6 +144,11 @@ type WorkerOptions struct {
 	HardSync bool
 	// Audit contains the audit flags that enables the audit.
 	Audit bool
+	// StoredQueries indicates whether named DQL queries can be stored and invoked by name.
+	StoredQueries bool
+	// StoredQueriesOnly rejects ad-hoc DQL requests from users other than guardians, only
+	// stored queries can be run.
+	StoredQueriesOnly bool
 }
 
 // WorkerConfig stores the global instance of the worker package's options.
@@ -158,9 +169,11 @@ func (w WorkerOptions) String() string {
 	return fmt.Sprintf("{TmpDir:%s ExportPath:%s MyAddr:%s ZeroAddr:%v Raft:%v "+
 		"WhiteListedIPRanges:%v StrictMutations:%v AclEnabled:%v AclJwtAlg:%v "+
 		"AclPublicKey:**** AbortOlderThan:%v ProposedGroupId:%d StartTime:%v "+
-		"Security:**** EncryptionKey:**** LogDQLRequest:%d SlowQueryThreshold:%v HardSync:%v Audit:%v}",
+		"Security:**** EncryptionKey:**** LogDQLRequest:%d SlowQueryThreshold:%v HardSync:%v Audit:%v "+
+		"StoredQueries:%v StoredQueriesOnly:%v}",
 		w.TmpDir, w.ExportPath, w.MyAddr, w.ZeroAddr, w.Raft,
 		w.WhiteListedIPRanges, w.StrictMutations, w.AclEnabled, w.AclJwtAlg,
 		w.AbortOlderThan, w.ProposedGroupId, w.StartTime,
-		w.LogDQLRequest, w.SlowQueryLogThreshold, w.HardSync, w.Audit)
+		w.LogDQLRequest, w.SlowQueryLogThreshold, w.HardSync, w.Audit,
+		w.StoredQueries, w.StoredQueriesOnly)
 }
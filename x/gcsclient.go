@@ -0,0 +1,344 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	// gcsScope is the OAuth 2.0 scope needed to read and write the objects.
+	gcsScope = "https://www.googleapis.com/auth/devstorage.read_write"
+	// gcsDefaultTokenURI is the token endpoint of the service accounts that don't give one.
+	gcsDefaultTokenURI = "https://oauth2.googleapis.com/token"
+)
+
+var (
+	// gcsEndpoint is the endpoint of the JSON API of Google Cloud Storage.
+	gcsEndpoint = "https://storage.googleapis.com"
+	// gceMetadataHost is the host of the metadata server of the Compute Engine VMs.
+	gceMetadataHost = "169.254.169.254"
+)
+
+// GCSClient is a client of the JSON API of Google Cloud Storage.
+type GCSClient struct {
+	hc       *http.Client
+	endpoint string
+	// tokens is nil for anonymous access and for the emulator.
+	tokens *tokenSource
+}
+
+// serviceAccountKey holds the fields of the JSON key of a service account used by the client.
+type serviceAccountKey struct {
+	Type        string `json:"type"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// NewGCSClient returns a client of Google Cloud Storage for URIs like gs://<bucket>/<prefix>.
+// The client is authenticated with the credentials if they're given: the session token is an
+// access token and the secret key is the JSON key of a service account. Otherwise, unless the
+// instance is shared, it uses the service account of GOOGLE_APPLICATION_CREDENTIALS, or else
+// the service account of the VM. If STORAGE_EMULATOR_HOST is set, the requests are sent to the
+// emulator without authentication.
+func NewGCSClient(uri *url.URL, creds *MinioCredentials) (*GCSClient, error) {
+	c := &GCSClient{hc: newBlobHTTPClient(), endpoint: gcsEndpoint}
+	if uri.Host == "" {
+		return nil, errors.Errorf("Invalid GCS location, it has no bucket: %s", uri.Redacted())
+	}
+	if host := os.Getenv("STORAGE_EMULATOR_HOST"); host != "" {
+		if !strings.Contains(host, "://") {
+			host = "http://" + host
+		}
+		c.endpoint = strings.TrimSuffix(host, "/")
+		glog.V(2).Infof("GCS client using emulator: %s", c.endpoint)
+		return c, nil
+	}
+	if creds.isAnonymous() {
+		return c, nil
+	}
+
+	useEnv := !Config.SharedInstance
+	switch {
+	case creds != nil && creds.SessionToken != "":
+		token := string(creds.SessionToken)
+		c.tokens = &tokenSource{fetch: func(context.Context) (string, time.Duration, error) {
+			return token, 24 * time.Hour, nil
+		}}
+	case creds != nil && creds.SecretKey != "":
+		if err := c.useServiceAccount([]byte(creds.SecretKey)); err != nil {
+			return nil, err
+		}
+	case !useEnv:
+		return nil, errors.Errorf("GCS handler requires credentials in a shared instance")
+	case os.Getenv("GOOGLE_APPLICATION_CREDENTIALS") != "":
+		data, err := os.ReadFile(os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"))
+		if err != nil {
+			return nil, errors.Wrap(err, "while reading GOOGLE_APPLICATION_CREDENTIALS")
+		}
+		if err := c.useServiceAccount(data); err != nil {
+			return nil, err
+		}
+	default:
+		host := gceMetadataHost
+		if h := os.Getenv("GCE_METADATA_HOST"); h != "" {
+			host = h
+		}
+		c.tokens = &tokenSource{fetch: c.metadataToken(host)}
+	}
+	return c, nil
+}
+
+// useServiceAccount authenticates the client with the JSON key of the service account, whose
+// signed assertions are exchanged for access tokens.
+func (c *GCSClient) useServiceAccount(data []byte) error {
+	var key serviceAccountKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return errors.Wrap(err, "while parsing the service account key")
+	}
+	if key.Type != "service_account" {
+		return errors.Errorf("GCS credentials of type %q are not supported", key.Type)
+	}
+	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(key.PrivateKey))
+	if err != nil {
+		return errors.Wrap(err, "while parsing the private key of the service account")
+	}
+	if key.TokenURI == "" {
+		key.TokenURI = gcsDefaultTokenURI
+	}
+
+	c.tokens = &tokenSource{fetch: func(ctx context.Context) (string, time.Duration, error) {
+		now := time.Now()
+		assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":   key.ClientEmail,
+			"scope": gcsScope,
+			"aud":   key.TokenURI,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		}).SignedString(privateKey)
+		if err != nil {
+			return "", 0, err
+		}
+		req, err := postForm(ctx, key.TokenURI, url.Values{
+			"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+			"assertion":  {assertion},
+		})
+		if err != nil {
+			return "", 0, err
+		}
+		return fetchToken(c.hc, req)
+	}}
+	return nil
+}
+
+func (c *GCSClient) metadataToken(host string) func(context.Context) (string, time.Duration,
+	error) {
+
+	return func(ctx context.Context) (string, time.Duration, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+host+
+			"/computeMetadata/v1/instance/service-accounts/default/token", nil)
+		if err != nil {
+			return "", 0, err
+		}
+		req.Header.Set("Metadata-Flavor", "Google")
+		return fetchToken(c.hc, req)
+	}
+}
+
+// ParseLocation returns the bucket and the prefix of the URI.
+func (c *GCSClient) ParseLocation(uri *url.URL) (string, string) {
+	return uri.Host, strings.Trim(uri.Path, "/")
+}
+
+func (c *GCSClient) URL(bucket, name string) string {
+	return (&url.URL{Scheme: "gs", Host: bucket, Path: "/" + name}).String()
+}
+
+// objectURL returns the URL of the object in the JSON API, where the name is a single escaped
+// segment of the path.
+func (c *GCSClient) objectURL(bucket, name string) string {
+	return fmt.Sprintf("%s/storage/v1/b/%s/o/%s", c.endpoint, url.PathEscape(bucket),
+		url.PathEscape(name))
+}
+
+func (c *GCSClient) do(ctx context.Context, method, u string, header http.Header,
+	body []byte) (*http.Response, error) {
+
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		req.Body = http.NoBody
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("User-Agent", blobUserAgent())
+	if c.tokens != nil {
+		token, err := c.tokens.Token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return c.hc.Do(req)
+}
+
+func (c *GCSClient) Get(ctx context.Context, bucket, name string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, http.MethodGet, c.objectURL(bucket, name)+"?alt=media", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, blobError(resp, "objects.get")
+	}
+	return resp.Body, nil
+}
+
+func (c *GCSClient) Exists(ctx context.Context, bucket, name string) (bool, error) {
+	resp, err := c.do(ctx, http.MethodGet, c.objectURL(bucket, name), nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+	return false, blobError(resp, "objects.get")
+}
+
+// Put uploads the object in a single request if it fits in a chunk, or else with a resumable
+// upload sending a chunk per request.
+func (c *GCSClient) Put(ctx context.Context, bucket, name string, r io.Reader) error {
+	buf := make([]byte, blobChunkSize)
+	n, eof, err := readChunk(r, buf)
+	if err != nil {
+		return err
+	}
+	// The storage ignores the bytes of a chunk it already persisted, so its upload can be retried.
+	put := func(op, method, u string, header http.Header, body []byte,
+		statuses ...int) (http.Header, error) {
+
+		return uploadWithRetry(ctx, op, func(ctx context.Context) (*http.Response, error) {
+			return c.do(ctx, method, u, header, body)
+		}, statuses...)
+	}
+	uploadURL := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?", c.endpoint, url.PathEscape(bucket))
+	if eof {
+		query := url.Values{"uploadType": {"media"}, "name": {name}}
+		header := http.Header{"Content-Type": {"application/octet-stream"}}
+		_, err := put("objects.insert", http.MethodPost, uploadURL+query.Encode(), header,
+			buf[:n], http.StatusOK)
+		return err
+	}
+
+	query := url.Values{"uploadType": {"resumable"}, "name": {name}}
+	header := http.Header{"Content-Type": {"application/json"},
+		"X-Upload-Content-Type": {"application/octet-stream"}}
+	respHeader, err := put("resumable upload", http.MethodPost, uploadURL+query.Encode(),
+		header, []byte("{}"), http.StatusOK)
+	if err != nil {
+		return err
+	}
+	session := respHeader.Get("Location")
+	if session == "" {
+		return errors.Errorf("resumable upload of %s returned no session URI", name)
+	}
+
+	var offset int
+	for {
+		var contentRange string
+		switch {
+		case n == 0:
+			contentRange = fmt.Sprintf("bytes */%d", offset)
+		case eof:
+			contentRange = fmt.Sprintf("bytes %d-%d/%d", offset, offset+n-1, offset+n)
+		default:
+			contentRange = fmt.Sprintf("bytes %d-%d/*", offset, offset+n-1)
+		}
+		header := http.Header{"Content-Range": {contentRange}}
+		if eof {
+			_, err := put("resumable upload", http.MethodPut, session, header, buf[:n],
+				http.StatusOK, http.StatusCreated)
+			return err
+		}
+		// A chunk is acknowledged with the status "308 Resume Incomplete".
+		if _, err := put("resumable upload", http.MethodPut, session, header, buf[:n],
+			http.StatusPermanentRedirect); err != nil {
+			return err
+		}
+		offset += n
+		if n, eof, err = readChunk(r, buf); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *GCSClient) Delete(ctx context.Context, bucket, name string) error {
+	resp, err := c.do(ctx, http.MethodDelete, c.objectURL(bucket, name), nil, nil)
+	return expectStatus(resp, err, "objects.delete", http.StatusNoContent, http.StatusOK)
+}
+
+func (c *GCSClient) List(ctx context.Context, bucket, prefix string) ([]string, error) {
+	var names []string
+	pageToken := ""
+	for {
+		query := url.Values{}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		u := fmt.Sprintf("%s/storage/v1/b/%s/o?%s", c.endpoint, url.PathEscape(bucket),
+			query.Encode())
+		resp, err := c.do(ctx, http.MethodGet, u, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		var res struct {
+			Items []struct {
+				Name string `json:"name"`
+			} `json:"items"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		if resp.StatusCode != http.StatusOK {
+			err = blobError(resp, "objects.list")
+		} else {
+			err = json.NewDecoder(resp.Body).Decode(&res)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range res.Items {
+			names = append(names, item.Name)
+		}
+		if res.NextPageToken == "" {
+			return names, nil
+		}
+		pageToken = res.NextPageToken
+	}
+}
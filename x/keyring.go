@@ -0,0 +1,409 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+const (
+	// KeyringFileName is the name of the file in the postings directory that records the
+	// versions of the encryption key. It only exists once the key has been rotated.
+	KeyringFileName = "KEYRING"
+	keyringTmpName  = "KEYRING.tmp"
+)
+
+// KeyVersion is a version of the encryption key of an Alpha. The first key is version 1, and
+// each rotation adds a version.
+type KeyVersion struct {
+	Version uint64 `json:"version"`
+	// Fingerprint identifies the key without revealing it.
+	Fingerprint string `json:"fingerprint"`
+	CreatedAt   int64  `json:"created_at"`
+	RetiredAt   int64  `json:"retired_at,omitempty"`
+	// Wrapped is the retired key, encrypted with the current key. It's empty for the current
+	// version.
+	Wrapped []byte `json:"wrapped,omitempty"`
+}
+
+type keyringData struct {
+	Versions []KeyVersion `json:"versions"`
+	// StorageKey is the key the Badger and Raft WAL key registries are encrypted with, encrypted
+	// with the current key. It's a random key, so that none of the versions of the encryption key
+	// can read the stores once it's retired.
+	StorageKey []byte `json:"storage_key,omitempty"`
+}
+
+// Keyring holds the versions of the encryption key of an Alpha. The stores are encrypted with a
+// storage key that the current key encrypts, so rotating the key re-wraps the storage key while
+// the stores are open, and the new key encrypts the backups, exports, WAL archives and audit logs
+// right away. The retired keys are kept encrypted with the current key, so that the backups
+// encrypted with them can still be read.
+type Keyring struct {
+	sync.RWMutex
+	dir string
+	// dirs are the directories the keyring is written to, dir and the ones of the stores.
+	dirs []string
+	key  Sensitive
+	data keyringData
+}
+
+// KeyFingerprint returns a short hash identifying the key.
+func KeyFingerprint(key Sensitive) string {
+	sum := sha256.Sum256(append([]byte("dgraph-encryption-key:"), key...))
+	return hex.EncodeToString(sum[:8])
+}
+
+// WrapKey encrypts the key with the key encryption key, using AES-GCM with a hash of the key
+// encryption key, as the encryption keys may be longer than an AES key.
+func WrapKey(kek, key Sensitive) ([]byte, error) {
+	gcm, err := newKeyWrapper(kek)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, key, nil), nil
+}
+
+// UnwrapKey decrypts a key encrypted by WrapKey.
+func UnwrapKey(kek Sensitive, wrapped []byte) (Sensitive, error) {
+	gcm, err := newKeyWrapper(kek)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+	nonce, ct := wrapped[:gcm.NonceSize()], wrapped[gcm.NonceSize():]
+	key, err := gcm.Open(nil, nonce, ct, nil)
+	return key, errors.Wrap(err, "while unwrapping key")
+}
+
+func newKeyWrapper(kek Sensitive) (cipher.AEAD, error) {
+	sum := sha256.Sum256(kek)
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// OpenKeyring reads the keyring of the directory, and checks that the key is its current
+// version. Without a keyring file, the key is version 1.
+func OpenKeyring(dir string, key Sensitive) (*Keyring, error) {
+	kr := &Keyring{dir: dir, dirs: []string{dir}, key: key}
+	data, err := os.ReadFile(filepath.Join(dir, KeyringFileName))
+	if os.IsNotExist(err) {
+		kr.data.Versions = []KeyVersion{{
+			Version:     1,
+			Fingerprint: KeyFingerprint(key),
+			CreatedAt:   time.Now().Unix(),
+		}}
+		return kr, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading the keyring")
+	}
+	if err := json.Unmarshal(data, &kr.data); err != nil {
+		return nil, errors.Wrap(err, "while parsing the keyring")
+	}
+	if len(kr.data.Versions) == 0 {
+		return nil, errors.Errorf("keyring in %s has no key", dir)
+	}
+
+	cur := kr.current()
+	fp := KeyFingerprint(key)
+	if cur.Fingerprint == fp {
+		return kr, nil
+	}
+	for _, v := range kr.data.Versions {
+		if v.Fingerprint == fp {
+			return nil, errors.Errorf("the encryption key is version %d, which was rotated "+
+				"to version %d (fingerprint %s) at %s. Configure the current key",
+				v.Version, cur.Version, cur.Fingerprint, time.Unix(v.RetiredAt, 0).UTC())
+		}
+	}
+	return nil, errors.Errorf("the encryption key doesn't match the current version %d "+
+		"(fingerprint %s) of the keyring in %s", cur.Version, cur.Fingerprint, dir)
+}
+
+func (kr *Keyring) current() *KeyVersion {
+	return &kr.data.Versions[len(kr.data.Versions)-1]
+}
+
+// Current returns the current version of the key.
+func (kr *Keyring) Current() KeyVersion {
+	kr.RLock()
+	defer kr.RUnlock()
+	v := *kr.current()
+	return v
+}
+
+// CurrentKey returns the current version of the key.
+func (kr *Keyring) CurrentKey() Sensitive {
+	kr.RLock()
+	defer kr.RUnlock()
+	return kr.key
+}
+
+// Versions returns all the versions of the key, without the keys.
+func (kr *Keyring) Versions() []KeyVersion {
+	kr.RLock()
+	defer kr.RUnlock()
+	versions := make([]KeyVersion, len(kr.data.Versions))
+	for i, v := range kr.data.Versions {
+		v.Wrapped = nil
+		versions[i] = v
+	}
+	return versions
+}
+
+// Key returns the version of the key with the fingerprint, which may be retired.
+func (kr *Keyring) Key(fingerprint string) (Sensitive, *KeyVersion, error) {
+	kr.RLock()
+	defer kr.RUnlock()
+	for _, v := range kr.data.Versions {
+		if v.Fingerprint != fingerprint {
+			continue
+		}
+		if v.Version == kr.current().Version {
+			return kr.key, &v, nil
+		}
+		key, err := UnwrapKey(kr.key, v.Wrapped)
+		return key, &v, err
+	}
+	return nil, nil, errors.Errorf("no key with fingerprint %s in the keyring", fingerprint)
+}
+
+// CheckRotate returns an error if the keyring can't be rotated to the key, without rotating it.
+func (kr *Keyring) CheckRotate(key Sensitive) error {
+	kr.RLock()
+	defer kr.RUnlock()
+	_, err := kr.checkRotate(key)
+	return err
+}
+
+// checkRotate returns whether the key is the current version, or an error if it can't be the
+// next version.
+func (kr *Keyring) checkRotate(key Sensitive) (bool, error) {
+	if err := CheckEncKeyLength(key); err != nil {
+		return false, err
+	}
+	fp := KeyFingerprint(key)
+	if kr.current().Fingerprint == fp {
+		return true, nil
+	}
+	for _, v := range kr.data.Versions {
+		if v.Fingerprint == fp {
+			return false, errors.Errorf("the key was already used as version %d", v.Version)
+		}
+	}
+	return false, nil
+}
+
+// Rotate makes the key the current version, and returns it. The key can't be a retired version.
+// Rotating to the current key doesn't change anything.
+func (kr *Keyring) Rotate(key Sensitive) (KeyVersion, error) {
+	kr.Lock()
+	defer kr.Unlock()
+	cur := kr.current()
+	same, err := kr.checkRotate(key)
+	if err != nil {
+		return KeyVersion{}, err
+	}
+	if same {
+		return *cur, nil
+	}
+
+	// The registries are encrypted with the current key until the storage key is generated.
+	storageKey := kr.key
+	if kr.data.StorageKey != nil {
+		if storageKey, err = UnwrapKey(kr.key, kr.data.StorageKey); err != nil {
+			return KeyVersion{}, err
+		}
+	}
+	next := keyringData{Versions: make([]KeyVersion, 0, len(kr.data.Versions)+1)}
+	if next.StorageKey, err = WrapKey(key, storageKey); err != nil {
+		return KeyVersion{}, err
+	}
+	now := time.Now().Unix()
+	for _, v := range kr.data.Versions {
+		old := kr.key
+		if v.Version != cur.Version {
+			if old, err = UnwrapKey(kr.key, v.Wrapped); err != nil {
+				return KeyVersion{}, errors.Wrapf(err, "while reading key version %d", v.Version)
+			}
+		} else {
+			v.RetiredAt = now
+		}
+		if v.Wrapped, err = WrapKey(key, old); err != nil {
+			return KeyVersion{}, err
+		}
+		next.Versions = append(next.Versions, v)
+	}
+	next.Versions = append(next.Versions, KeyVersion{
+		Version:     cur.Version + 1,
+		Fingerprint: KeyFingerprint(key),
+		CreatedAt:   now,
+	})
+
+	if err := kr.write(&next); err != nil {
+		return KeyVersion{}, err
+	}
+	kr.data = next
+	kr.key = key
+	return *kr.current(), nil
+}
+
+// OpenStorage returns the storage key to open the stores in the directories with, and writes the
+// keyring to each of them, for the tools that open one of the stores to find it. The storage key
+// is generated the first time, or if it's a version of the encryption key, as the stores were
+// encrypted with the encryption key before, and the key registries of the stores are re-encrypted
+// with it. So it must be called before the stores are opened.
+func (kr *Keyring) OpenStorage(dirs ...string) (Sensitive, error) {
+	kr.Lock()
+	defer kr.Unlock()
+	for _, dir := range dirs {
+		if !slices.Contains(kr.dirs, dir) {
+			kr.dirs = append(kr.dirs, dir)
+		}
+	}
+
+	// The registries may be encrypted with any version of the key, or with the storage key.
+	keys, err := kr.versionKeys()
+	if err != nil {
+		return nil, err
+	}
+	storageKey := kr.key
+	if kr.data.StorageKey != nil {
+		if storageKey, err = UnwrapKey(kr.key, kr.data.StorageKey); err != nil {
+			return nil, errors.Wrap(err, "while reading the storage key")
+		}
+	}
+	next := kr.data
+	if slices.ContainsFunc(keys, func(k Sensitive) bool { return bytes.Equal(k, storageKey) }) {
+		keys = append(keys, storageKey)
+		storageKey = make(Sensitive, 32)
+		if _, err := rand.Read(storageKey); err != nil {
+			return nil, err
+		}
+		if next.StorageKey, err = WrapKey(kr.key, storageKey); err != nil {
+			return nil, err
+		}
+	}
+	// The keyring is written before the registries are re-encrypted, for the storage key not to
+	// be lost if that fails. The registries are re-encrypted at the next start then.
+	if err := kr.write(&next); err != nil {
+		return nil, err
+	}
+	kr.data = next
+	for _, dir := range dirs {
+		if err := rewrapRegistry(dir, storageKey, keys); err != nil {
+			return nil, errors.Wrapf(err, "while re-encrypting the key registry in %s", dir)
+		}
+	}
+	return storageKey, nil
+}
+
+// versionKeys returns all the versions of the key, the current one first.
+func (kr *Keyring) versionKeys() ([]Sensitive, error) {
+	keys := []Sensitive{kr.key}
+	for _, v := range kr.data.Versions {
+		if v.Version == kr.current().Version {
+			continue
+		}
+		key, err := UnwrapKey(kr.key, v.Wrapped)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading key version %d", v.Version)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// rewrapRegistry re-encrypts the Badger key registry in the directory with the key, if it's
+// encrypted with one of the old keys.
+func rewrapRegistry(dir string, key Sensitive, oldKeys []Sensitive) error {
+	if _, err := os.Stat(filepath.Join(dir, badger.KeyRegistryFileName)); os.IsNotExist(err) {
+		return nil
+	}
+	opt := badger.KeyRegistryOptions{Dir: dir, ReadOnly: true, EncryptionKey: key}
+	reg, err := badger.OpenKeyRegistry(opt)
+	if err == nil {
+		return nil
+	}
+	for _, old := range oldKeys {
+		if !errors.Is(err, badger.ErrEncryptionKeyMismatch) {
+			break
+		}
+		opt.EncryptionKey = old
+		if reg, err = badger.OpenKeyRegistry(opt); err == nil {
+			glog.Infof("Re-encrypting the key registry in %s with the storage key", dir)
+			return badger.WriteKeyRegistry(reg, badger.KeyRegistryOptions{Dir: dir,
+				EncryptionKey: key})
+		}
+	}
+	return err
+}
+
+// write writes the keyring to all its directories.
+func (kr *Keyring) write(data *keyringData) error {
+	for _, dir := range kr.dirs {
+		if err := writeKeyring(dir, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeKeyring(dir string, data *keyringData) error {
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(dir, keyringTmpName)
+	if err := WriteFileSync(tmp, buf, 0600); err != nil {
+		return errors.Wrap(err, "while writing the keyring")
+	}
+	return errors.Wrap(os.Rename(tmp, filepath.Join(dir, KeyringFileName)),
+		"while writing the keyring")
+}
+
+// StorageKey returns the key to open the stores of the directory with, given its current
+// encryption key, for the tools that read the stores of an Alpha. The directory is the posting or
+// the WAL directory of the Alpha.
+func StorageKey(dir string, key Sensitive) (Sensitive, error) {
+	if key == nil {
+		return nil, nil
+	}
+	kr, err := OpenKeyring(dir, key)
+	if err != nil {
+		return nil, err
+	}
+	if kr.data.StorageKey == nil {
+		return key, nil
+	}
+	return UnwrapKey(key, kr.data.StorageKey)
+}
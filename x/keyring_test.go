@@ -0,0 +1,171 @@
+/*
+ * SPDX-FileCopyrightText: © 2017-2026 Istari Digital, Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+func testKey(b byte) Sensitive {
+	return bytes.Repeat([]byte{b}, 32)
+}
+
+func TestWrapKey(t *testing.T) {
+	kek, key := testKey(1), testKey(2)
+	wrapped, err := WrapKey(kek, key)
+	require.NoError(t, err)
+	require.NotContains(t, string(wrapped), string(key))
+
+	unwrapped, err := UnwrapKey(kek, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, key, unwrapped)
+
+	_, err = UnwrapKey(testKey(3), wrapped)
+	require.Error(t, err)
+}
+
+func TestKeyringRotate(t *testing.T) {
+	dir := t.TempDir()
+	k1, k2, k3 := testKey(1), testKey(2), testKey(3)
+
+	kr, err := OpenKeyring(dir, k1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), kr.Current().Version)
+	require.Equal(t, KeyFingerprint(k1), kr.Current().Fingerprint)
+	_, err = os.Stat(filepath.Join(dir, KeyringFileName))
+	require.True(t, os.IsNotExist(err))
+
+	// Rotating to the current key changes nothing.
+	v, err := kr.Rotate(k1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v.Version)
+
+	require.NoError(t, kr.CheckRotate(k2))
+	require.Equal(t, k1, kr.CurrentKey())
+	v, err = kr.Rotate(k2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), v.Version)
+	require.Equal(t, k2, kr.CurrentKey())
+	v, err = kr.Rotate(k3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), v.Version)
+
+	_, err = kr.Rotate(k1)
+	require.ErrorContains(t, err, "already used as version 1")
+	require.ErrorContains(t, kr.CheckRotate(k1), "already used as version 1")
+	_, err = kr.Rotate([]byte("short"))
+	require.Error(t, err)
+
+	// The retired keys can be read with the current key only.
+	kr, err = OpenKeyring(dir, k3)
+	require.NoError(t, err)
+	versions := kr.Versions()
+	require.Len(t, versions, 3)
+	require.NotZero(t, versions[0].RetiredAt)
+	require.Zero(t, versions[2].RetiredAt)
+	for i, k := range []Sensitive{k1, k2, k3} {
+		key, v, err := kr.Key(KeyFingerprint(k))
+		require.NoError(t, err)
+		require.Equal(t, k, key)
+		require.Equal(t, uint64(i+1), v.Version)
+	}
+	_, _, err = kr.Key(KeyFingerprint(testKey(4)))
+	require.Error(t, err)
+
+	_, err = OpenKeyring(dir, k2)
+	require.ErrorContains(t, err, "the encryption key is version 2, which was rotated to version 3")
+	_, err = OpenKeyring(dir, testKey(4))
+	require.ErrorContains(t, err, "doesn't match the current version 3")
+}
+
+func TestKeyringOpenStorage(t *testing.T) {
+	dir, walDir := t.TempDir(), t.TempDir()
+	k1, k2, k3 := testKey(1), testKey(2), testKey(3)
+
+	// The stores were encrypted with the first key, and the key was rotated once before.
+	db, err := badger.Open(badger.DefaultOptions(dir).WithEncryptionKey(k1).
+		WithIndexCacheSize(1 << 20).WithLogger(nil))
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte("key"), []byte("value"))
+	}))
+	require.NoError(t, db.Close())
+	reg, err := badger.OpenKeyRegistry(badger.KeyRegistryOptions{Dir: walDir, EncryptionKey: k1})
+	require.NoError(t, err)
+	require.NoError(t, reg.Close())
+	kr, err := OpenKeyring(dir, k1)
+	require.NoError(t, err)
+	_, err = kr.Rotate(k2)
+	require.NoError(t, err)
+
+	storageKey, err := kr.OpenStorage(dir, walDir)
+	require.NoError(t, err)
+	require.Len(t, storageKey, 32)
+	require.NotEqual(t, k1, storageKey)
+	require.NotEqual(t, k2, storageKey)
+	// It's only generated once, and the keyring is written to all the directories.
+	key, err := kr.OpenStorage(dir, walDir)
+	require.NoError(t, err)
+	require.Equal(t, storageKey, key)
+	key, err = StorageKey(walDir, k2)
+	require.NoError(t, err)
+	require.Equal(t, storageKey, key)
+	reg, err = badger.OpenKeyRegistry(badger.KeyRegistryOptions{Dir: walDir, ReadOnly: true,
+		EncryptionKey: storageKey})
+	require.NoError(t, err)
+	require.NoError(t, reg.Close())
+
+	// The key is rotated while the store is open with the storage key.
+	db, err = badger.Open(badger.DefaultOptions(dir).WithEncryptionKey(storageKey).
+		WithIndexCacheSize(1 << 20).WithLogger(nil))
+	require.NoError(t, err)
+	_, err = kr.Rotate(k3)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte("key2"), []byte("value2"))
+	}))
+	require.NoError(t, db.Close())
+
+	for _, d := range []string{dir, walDir} {
+		key, err = StorageKey(d, k3)
+		require.NoError(t, err)
+		require.Equal(t, storageKey, key)
+		_, err = StorageKey(d, k2)
+		require.Error(t, err)
+	}
+	kr, err = OpenKeyring(dir, k3)
+	require.NoError(t, err)
+	key, err = kr.OpenStorage(dir, walDir)
+	require.NoError(t, err)
+	require.Equal(t, storageKey, key)
+
+	db, err = badger.Open(badger.DefaultOptions(dir).WithEncryptionKey(storageKey).
+		WithIndexCacheSize(1 << 20).WithLogger(nil))
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.View(func(txn *badger.Txn) error {
+		for _, k := range []string{"key", "key2"} {
+			item, err := txn.Get([]byte(k))
+			if err != nil {
+				return err
+			}
+			if err := item.Value(func(val []byte) error {
+				require.Equal(t, "value"+k[3:], string(val))
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
@@ -800,6 +800,11 @@ var otherReservedPredicate = map[string]struct{}{
 	"dgraph.graphql.p_query": {},
 	"dgraph.namespace.id":    {},
 	"dgraph.namespace.name":  {},
+	"dgraph.query.name":      {},
+	"dgraph.query.text":      {},
+	"dgraph.query.groups":    {},
+	"dgraph.quota.namespace": {},
+	"dgraph.quota.limits":    {},
 }
 
 // internalPredicateMap stores a set of Dgraph's internal predicate. An internal
@@ -817,6 +822,8 @@ var preDefinedTypeMap = map[string]struct{}{
 	"dgraph.type.Rule":               {},
 	"dgraph.graphql.persisted_query": {},
 	"dgraph.namespace":               {},
+	"dgraph.query":                   {},
+	"dgraph.quota":                   {},
 }
 
 // IsOtherReservedPredicate returns true if it is the predicate is reserved by graphql.
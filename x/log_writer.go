@@ -123,6 +123,18 @@ func (l *LogWriter) Write(p []byte) (int, error) {
 	return n, err
 }
 
+// RotateKey starts a new log file encrypted with the key. The older files keep the key they
+// were written with.
+func (l *LogWriter) RotateKey(key []byte) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.EncryptionKey = key
+	return l.rotate()
+}
+
 func (l *LogWriter) Close() error {
 	if l == nil {
 		return nil
@@ -7,6 +7,7 @@ package x
 
 import (
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
@@ -23,20 +24,35 @@ type LoggerConf struct {
 	Size          int64
 	Days          int64
 	MessageKey    string
+	// Syslog and Webhook are the addresses the entries are also sent to, if set.
+	Syslog  string
+	Webhook string
+	// Reads enables the entries of the predicates and the nodes read by the queries.
+	Reads bool
 }
 
-func InitLogger(conf *LoggerConf, filename string) (*Logger, error) {
+// InitLogger initializes the logger writing to the output of the conf. The entries are also
+// written to the sinks, which are closed with the logger.
+func InitLogger(conf *LoggerConf, filename string, sinks ...io.Writer) (*Logger, error) {
 	config := zap.NewProductionEncoderConfig()
 	config.MessageKey = conf.MessageKey
 	config.LevelKey = zapcore.OmitKey
 	config.EncodeTime = zapcore.ISO8601TimeEncoder
+	newLogger := func(w *LogWriter, out ...io.Writer) *Logger {
+		cores := make([]zapcore.Core, 0, len(out)+len(sinks))
+		for _, o := range append(out, sinks...) {
+			cores = append(cores, zapcore.NewCore(zapcore.NewJSONEncoder(config),
+				zapcore.AddSync(o), zapcore.DebugLevel))
+		}
+		return &Logger{logger: zap.New(zapcore.NewTee(cores...)), writer: w, sinks: sinks}
+	}
 	// if stdout, then init the logger and return
 	if conf.Output == "stdout" {
-		return &Logger{
-			logger: zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(config),
-				zapcore.AddSync(os.Stdout), zapcore.DebugLevel)),
-			writer: nil,
-		}, nil
+		return newLogger(nil, os.Stdout), nil
+	}
+	// The entries may only be sent to the sinks.
+	if conf.Output == "" {
+		return newLogger(nil), nil
 	}
 
 	if err := os.MkdirAll(conf.Output, 0700); err != nil {
@@ -61,16 +77,13 @@ func InitLogger(conf *LoggerConf, filename string) (*Logger, error) {
 		return nil, err
 	}
 
-	return &Logger{
-		logger: zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(config),
-			zapcore.AddSync(w), zap.DebugLevel)),
-		writer: w,
-	}, nil
+	return newLogger(w, w), nil
 }
 
 type Logger struct {
 	logger *zap.Logger
 	writer *LogWriter
+	sinks  []io.Writer
 }
 
 // AuditI logs audit message as info. args are key value pairs with key as string value
@@ -96,12 +109,25 @@ func (l *Logger) AuditE(msg string, args ...interface{}) {
 	l.logger.Error(msg, flds...)
 }
 
+// RotateKey switches the encrypted log to a new file encrypted with the key.
+func (l *Logger) RotateKey(key Sensitive) error {
+	if l == nil || l.writer == nil {
+		return nil
+	}
+	return l.writer.RotateKey(key)
+}
+
 func (l *Logger) Sync() {
 	if l == nil {
 		return
 	}
 	_ = l.logger.Sync()
 	_ = l.writer.Close()
+	for _, s := range l.sinks {
+		if c, ok := s.(io.Closer); ok {
+			_ = c.Close()
+		}
+	}
 }
 
 var slowOperationLogger *zap.Logger
@@ -27,8 +27,9 @@ const (
 	flagAclJwtAlg     = "jwt-alg"
 	flagAclKeyFile    = "secret-file"
 
-	flagEnc        = "encryption"
-	flagEncKeyFile = "key-file"
+	flagEnc          = "encryption"
+	flagEncKeyFile   = "key-file"
+	flagEncFieldKeys = "field-keys"
 
 	flagVault             = "vault"
 	flagVaultAddr         = "addr"
@@ -47,7 +48,7 @@ var (
 		flagAclRefreshTtl, "30d",
 		flagAclJwtAlg, "HS256",
 		flagAclKeyFile, "")
-	EncDefaults = fmt.Sprintf("%s=%s", flagEncKeyFile, "")
+	EncDefaults = fmt.Sprintf("%s=%s; %s=%s", flagEncKeyFile, "", flagEncFieldKeys, "")
 )
 
 func vaultGetKeys(config *viper.Viper) (aclKey, encKey Sensitive) {
@@ -537,6 +537,97 @@ func AttachAccessJwt(ctx context.Context, r *http.Request) context.Context {
 	return ctx
 }
 
+// AttachAsOf adds the point in time a query should be run at into the grpc context metadata.
+func AttachAsOf(ctx context.Context, asOf string) context.Context {
+	if asOf == "" {
+		return ctx
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set("as-of", asOf)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// ExtractAsOf returns the point in time (either a timestamp or an RFC3339 time) at which the query
+// in the incoming gRPC context should be run. It returns an empty string if none was provided.
+func ExtractAsOf(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	asOf := md.Get("as-of")
+	if len(asOf) == 0 {
+		return ""
+	}
+	return asOf[0]
+}
+
+// AttachDryRun marks the alter in the grpc context metadata as a dry run, which reports the impact
+// of the schema change instead of applying it.
+func AttachDryRun(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set("dry-run", "true")
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// IsDryRun returns true if the alter in the incoming gRPC context is a dry run.
+func IsDryRun(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	dryRun := md.Get("dry-run")
+	return len(dryRun) > 0 && (dryRun[0] == "true" || dryRun[0] == "True")
+}
+
+// AttachStoredQuery adds the name of the stored query to run into the grpc context metadata.
+func AttachStoredQuery(ctx context.Context, name string) context.Context {
+	if name == "" {
+		return ctx
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set("stored-query", name)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// ExtractStoredQuery returns the name of the stored query that the request in the incoming gRPC
+// context should run. It returns an empty string for ad-hoc requests.
+func ExtractStoredQuery(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	name := md.Get("stored-query")
+	if len(name) == 0 {
+		return ""
+	}
+	return name[0]
+}
+
+type queryEdgeLimitKey struct{}
+
+// WithQueryEdgeLimit returns a context in which queries can't traverse more than limit edges.
+// The limit only applies if it's lower than the query-edge limit of the Alpha.
+func WithQueryEdgeLimit(ctx context.Context, limit uint64) context.Context {
+	return context.WithValue(ctx, queryEdgeLimitKey{}, limit)
+}
+
+// QueryEdgeLimit returns the maximum number of edges a query running in the context can traverse.
+func QueryEdgeLimit(ctx context.Context) uint64 {
+	if limit, ok := ctx.Value(queryEdgeLimitKey{}).(uint64); ok && limit > 0 {
+		return min(limit, Config.LimitQueryEdge)
+	}
+	return Config.LimitQueryEdge
+}
+
 // AttachRemoteIP adds any incoming IP data into the grpc context metadata
 func AttachRemoteIP(ctx context.Context, r *http.Request) context.Context {
 	if ip, port, err := net.SplitHostPort(r.RemoteAddr); err == nil {